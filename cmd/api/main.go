@@ -1,22 +1,47 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/henriquerocha2004/quem-me-deve-api/core/catalog"
+	gormCatalog "github.com/henriquerocha2004/quem-me-deve-api/core/catalog/gorm"
 	"github.com/henriquerocha2004/quem-me-deve-api/core/client"
 	gormClient "github.com/henriquerocha2004/quem-me-deve-api/core/client/gorm"
 	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
 	gormDebt "github.com/henriquerocha2004/quem-me-deve-api/core/debt/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/notification"
+	gormNotification "github.com/henriquerocha2004/quem-me-deve-api/core/notification/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/plan"
+	gormPlan "github.com/henriquerocha2004/quem-me-deve-api/core/plan/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/receipt"
+	gormReceipt "github.com/henriquerocha2004/quem-me-deve-api/core/receipt/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/reconciliation"
+	gormReconciliation "github.com/henriquerocha2004/quem-me-deve-api/core/reconciliation/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/reminder"
+	gormReminder "github.com/henriquerocha2004/quem-me-deve-api/core/reminder/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/report"
+	gormReport "github.com/henriquerocha2004/quem-me-deve-api/core/report/gorm"
 	gormShared "github.com/henriquerocha2004/quem-me-deve-api/core/shared/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/statement"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	gormUser "github.com/henriquerocha2004/quem-me-deve-api/core/user/gorm"
 	"github.com/henriquerocha2004/quem-me-deve-api/internal/container"
 	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/routes"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/scheduler"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/notify"
 )
 
 func main() {
 	dependencies := fillDependencies()
+	if dependencies == nil {
+		os.Exit(1)
+	}
+	dependencies.Scheduler.Start(context.Background())
 	r := routes.Start(dependencies)
 	http.Handle("/", r)
 	srv := &http.Server{
@@ -51,17 +76,132 @@ func fillDependencies() *container.Dependencies {
 		return nil
 	}
 
+	// plan dependencies
+	// No payment provider is integrated yet: the paid plans are refused and
+	// the renewals declined until one is.
+	planService := plan.NewPlanService(
+		gormPlan.NewGormPlanRepository(gormDB),
+		gormPlan.NewGormSubscriptionRepository(gormDB),
+		gormPlan.NewUsageGormRepository(gormDB),
+		nil,
+	).WithLocker(gormShared.NewAdvisoryLocker(gormDB))
+
+	// notification dependencies
+	notificationService := notification.NewNotificationService(gormNotification.NewGormNotificationRepository(gormDB))
+
+	userRepo := gormUser.NewGormUserRepository(gormDB)
+	clientRepo := gormClient.NewGormClientRepository(gormDB)
+
+	// receipt dependencies
+	receiptService := receipt.NewReceiptService(gormReceipt.NewGormReceiptRepository(gormDB), userRepo, clientRepo)
+
 	// debt dependencies
+	// Dynamic Pix charges are served by the PSP at PIX_LOCATION_URL, written
+	// without the scheme, followed by the txid.
 	debtRepo := gormDebt.NewGormDebtRepository(gormDB)
 	cliRepo := gormClient.NewClientReaderGormRepository(gormDB)
-	debtService := debt.NewDebtService(debtRepo, cliRepo)
+	walletRepo := gormClient.NewGormWalletRepository(gormDB)
+	catalogReader := gormCatalog.NewCatalogReaderGormRepository(gormDB)
+	debtService := debt.NewDebtService(debtRepo, cliRepo, catalogReader, walletRepo).
+		WithAutoCreditSurplus(os.Getenv("AUTO_CREDIT_SURPLUS") == "true").
+		WithPlanLimits(planService).
+		WithEvents(notificationService).
+		WithPix(userRepo, os.Getenv("PIX_LOCATION_URL")).
+		WithBoleto(userRepo, clientRepo, gormDebt.NewGormBoletoNumberRepository(gormDB)).
+		WithReceipts(receiptService)
 
 	// client dependencies
-	clientRepo := gormClient.NewGormClientRepository(gormDB)
-	clientService := client.NewClientService(clientRepo)
+	clientService := client.NewClientService(clientRepo).WithPlanLimits(planService)
+	statementService := statement.NewStatementService(debtRepo, clientRepo)
+
+	// catalog dependencies
+	catalogRepo := gormCatalog.NewGormCatalogRepository(gormDB)
+	catalogService := catalog.NewCatalogService(catalogRepo)
+	stockService := catalog.NewStockService(catalogRepo, gormCatalog.NewGormStockRepository(gormDB))
+
+	// user dependencies
+	tokens, err := user.NewTokenIssuer([]byte(os.Getenv("JWT_SECRET")), 15*time.Minute, 7*24*time.Hour)
+	if err != nil {
+		fmt.Println("Error reading JWT_SECRET:", err)
+		return nil
+	}
+	userService := user.NewUserService(userRepo, tokens)
+
+	// reminder dependencies
+	reminderService := reminder.NewReminderService(
+		gormReminder.NewGormRuleRepository(gormDB),
+		gormReminder.NewGormInstallmentReader(gormDB),
+		gormReminder.NewGormSendLog(gormDB),
+	).
+		WithNotifier(reminder.Email, emailNotifier()).
+		WithNotifier(reminder.SMS, httpNotifier("SMS", notify.NewSMSNotifier)).
+		WithNotifier(reminder.WhatsApp, httpNotifier("WHATSAPP", notify.NewWhatsAppNotifier))
+
+	// reconciliation dependencies
+	reconciliationService := reconciliation.NewReconciliationService(
+		gormReconciliation.NewGormPixPaymentRepository(gormDB),
+		userRepo,
+		debtRepo,
+		debtService,
+	)
+
+	// report dependencies
+	reportService := report.NewReportService(gormReport.NewGormReportRepository(gormDB))
+
+	// background jobs
+	jobs := scheduler.New().
+		WithLocker(gormShared.NewAdvisoryLocker(gormDB)).
+		Every(time.Hour, "renew subscriptions", planService.RenewSubscriptions).
+		Every(time.Hour, "mark overdue installments", debtService.MarkOverdue).
+		Every(time.Hour, "notify installments due soon", debtService.NotifyDueSoon).
+		Every(time.Hour, "send reminders", reminderService.SendReminders)
 
 	return &container.Dependencies{
-		DebtService:   debtService,
-		ClientService: clientService,
+		DebtService:           debtService,
+		ClientService:         clientService,
+		CatalogService:        catalogService,
+		StockService:          stockService,
+		UserService:           userService,
+		PlanService:           planService,
+		PlanAccess:            planService,
+		ReminderService:       reminderService,
+		NotificationService:   notificationService,
+		ReconciliationService: reconciliationService,
+		ReceiptService:        receiptService,
+		StatementService:      statementService,
+		ReportService:         reportService,
+		Tokens:                tokens,
+		PixWebhookSecret:      []byte(os.Getenv("PIX_WEBHOOK_SECRET")),
+		Scheduler:             jobs,
+	}
+}
+
+// emailNotifier sends the reminders through the SMTP server of the
+// environment. Without one, they are only logged.
+func emailNotifier() notify.Notifier {
+	if os.Getenv("SMTP_HOST") == "" {
+		return notify.LogNotifier{Channel: "email"}
+	}
+
+	return notify.NewSMTPNotifier(notify.SMTPConfig{
+		Host:     os.Getenv("SMTP_HOST"),
+		Port:     os.Getenv("SMTP_PORT"),
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     os.Getenv("SMTP_FROM"),
+	})
+}
+
+// httpNotifier builds the notifier of a gateway from its <prefix>_URL and
+// <prefix>_TOKEN variables. Without an URL, the messages are only logged.
+func httpNotifier[N notify.Notifier](prefix string, build func(notify.HTTPConfig) N) notify.Notifier {
+	url := os.Getenv(prefix + "_URL")
+	if url == "" {
+		return notify.LogNotifier{Channel: strings.ToLower(prefix)}
 	}
+
+	return build(notify.HTTPConfig{
+		URL:   url,
+		Token: os.Getenv(prefix + "_TOKEN"),
+	})
 }
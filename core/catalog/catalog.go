@@ -0,0 +1,56 @@
+package catalog
+
+import (
+	"errors"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+)
+
+type Kind string
+
+const (
+	ProductKind Kind = "product"
+	ServiceKind Kind = "service"
+)
+
+const defaultUnit = "un"
+
+// Item is a product or service that can be sold in a debt. DefaultPrice is
+// only a suggestion: the price actually charged is kept on the debt item.
+// LowStockThreshold is the quantity at or below which a product is reported
+// as low on stock; it has no meaning for services.
+type Item struct {
+	Id                ulid.ULID
+	Kind              Kind
+	Name              string
+	SKU               string
+	DefaultPrice      money.Money
+	Unit              string
+	Active            bool
+	LowStockThreshold int
+}
+
+func (i *Item) validate() error {
+	if i.Kind != ProductKind && i.Kind != ServiceKind {
+		return errors.New("the kind informed is invalid")
+	}
+
+	if i.Name == "" {
+		return errors.New("name is required")
+	}
+
+	if i.DefaultPrice.IsNegative() {
+		return errors.New("default price must not be negative")
+	}
+
+	if i.LowStockThreshold < 0 {
+		return errors.New("low stock threshold must not be negative")
+	}
+
+	if i.Unit == "" {
+		i.Unit = defaultUnit
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package catalog
+
+import "github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+
+type ItemDto struct {
+	Id                string      `json:"id,omitempty"`
+	Name              string      `json:"name" validate:"required"`
+	SKU               string      `json:"sku"`
+	DefaultPrice      money.Money `json:"default_price" validate:"gte=0"`
+	Unit              string      `json:"unit"`
+	Active            *bool       `json:"active,omitempty"`
+	LowStockThreshold int         `json:"low_stock_threshold,omitempty" validate:"gte=0"`
+}
+
+type PaginationResult struct {
+	TotalRecords int     `json:"total_records"`
+	Data         []*Item `json:"data"`
+}
+
+type StockMovementDto struct {
+	Id          string `json:"id,omitempty"`
+	Type        string `json:"type" validate:"required,oneof=purchase sale return adjustment"`
+	Quantity    int    `json:"quantity" validate:"required"`
+	Description string `json:"description"`
+	DebtId      string `json:"debt_id,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+}
+
+type StockLevelDto struct {
+	ProductId         string `json:"product_id"`
+	Name              string `json:"name"`
+	Quantity          int    `json:"quantity"`
+	LowStockThreshold int    `json:"low_stock_threshold"`
+	Low               bool   `json:"low"`
+}
+
+type MovementPaginationResult struct {
+	TotalRecords int              `json:"total_records"`
+	Data         []*StockMovement `json:"data"`
+}
@@ -0,0 +1,55 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type Item struct {
+	ID                string      `gorm:"column:id;primaryKey;type:char(26)"`
+	Kind              string      `gorm:"column:kind;type:varchar(20);not null"`
+	Name              string      `gorm:"column:name;type:text;not null"`
+	SKU               string      `gorm:"column:sku;type:varchar(60)"`
+	DefaultPrice      money.Money `gorm:"column:default_price;type:decimal(12,2);not null"`
+	Unit              string      `gorm:"column:unit;type:varchar(20);not null"`
+	Active            bool        `gorm:"column:active;type:boolean;not null"`
+	LowStockThreshold int         `gorm:"column:low_stock_threshold;type:int;not null"`
+	DeletedAt         gorm.DeletedAt
+	TenantID          string `gorm:"column:tenant_id;type:char(26);not null"`
+}
+
+func (d *Item) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == "" {
+		d.ID = ulid.Make().String()
+	}
+	return nil
+}
+
+func (d *Item) TableName() string {
+	return "catalog_items"
+}
+
+type StockMovement struct {
+	ID           string     `gorm:"column:id;primaryKey;type:char(26)"`
+	ProductId    string     `gorm:"column:product_id;type:char(26);not null"`
+	MovementType string     `gorm:"column:movement_type;type:varchar(20);not null"`
+	Quantity     int        `gorm:"column:quantity;type:int;not null"`
+	Description  string     `gorm:"column:description;type:text"`
+	DebtId       *string    `gorm:"column:debt_id;type:char(26)"`
+	CreatedAt    *time.Time `gorm:"column:created_at;type:timestamp"`
+	TenantID     string     `gorm:"column:tenant_id;type:char(26);not null"`
+}
+
+func (d *StockMovement) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == "" {
+		d.ID = ulid.Make().String()
+	}
+	return nil
+}
+
+func (d *StockMovement) TableName() string {
+	return "stock_movements"
+}
@@ -0,0 +1,169 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/catalog"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type GormCatalogRepository struct {
+	db *gorm.DB
+}
+
+func NewGormCatalogRepository(db *gorm.DB) *GormCatalogRepository {
+	return &GormCatalogRepository{db: db}
+}
+
+func (c *GormCatalogRepository) Create(ctx context.Context, item *catalog.Item) error {
+	model := c.convertItemToModel(item)
+	return c.db.WithContext(ctx).Create(&model).Error
+}
+
+func (c *GormCatalogRepository) Update(ctx context.Context, item *catalog.Item) error {
+	model := c.convertItemToModel(item)
+
+	// The columns are selected so that an item can be deactivated: Updates
+	// skips zero values such as active = false.
+	return c.db.WithContext(ctx).Model(&Item{}).
+		Where("id = ? AND kind = ?", model.ID, model.Kind).
+		Select("name", "sku", "default_price", "unit", "active", "low_stock_threshold").
+		Updates(&model).Error
+}
+
+func (c *GormCatalogRepository) Delete(ctx context.Context, kind catalog.Kind, id ulid.ULID) error {
+	return c.db.WithContext(ctx).
+		Where("id = ? AND kind = ?", id.String(), string(kind)).
+		Delete(&Item{}).Error
+}
+
+func (c *GormCatalogRepository) FindById(ctx context.Context, kind catalog.Kind, id ulid.ULID) (*catalog.Item, error) {
+	var model Item
+
+	err := c.db.WithContext(ctx).
+		Where("id = ? AND kind = ?", id.String(), string(kind)).
+		First(&model).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return c.convertModelToItem(model), nil
+}
+
+func (c *GormCatalogRepository) FindBySKU(ctx context.Context, kind catalog.Kind, sku string) (*catalog.Item, error) {
+	var model Item
+
+	err := c.db.WithContext(ctx).
+		Where("sku = ? AND kind = ?", sku, string(kind)).
+		First(&model).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return c.convertModelToItem(model), nil
+}
+
+func (c *GormCatalogRepository) FindAll(ctx context.Context, kind catalog.Kind, criteria paginate.SearchDto) (*catalog.PaginationResult, error) {
+	var models []Item
+	var total int64
+
+	query := c.db.WithContext(ctx).Model(&Item{}).
+		Where("kind = ?", string(kind))
+
+	if criteria.TermSearch != "" {
+		query = query.Where("(name LIKE ? OR sku LIKE ?)",
+			"%"+criteria.TermSearch+"%",
+			"%"+criteria.TermSearch+"%",
+		)
+	}
+
+	if len(criteria.ColumnSearch) >= 1 {
+		for _, value := range criteria.ColumnSearch {
+			query = query.Where(value.ColumnName+" = ?", value.ColumnValue)
+		}
+	}
+
+	err := query.Count(&total).
+		Offset(criteria.Offset()).
+		Limit(criteria.Limit).
+		Order("name ASC").
+		Find(&models).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*catalog.Item
+	for _, model := range models {
+		items = append(items, c.convertModelToItem(model))
+	}
+
+	return &catalog.PaginationResult{
+		TotalRecords: int(total),
+		Data:         items,
+	}, nil
+}
+
+func (c *GormCatalogRepository) convertItemToModel(item *catalog.Item) Item {
+	return Item{
+		ID:                item.Id.String(),
+		Kind:              string(item.Kind),
+		Name:              item.Name,
+		SKU:               item.SKU,
+		DefaultPrice:      item.DefaultPrice,
+		Unit:              item.Unit,
+		Active:            item.Active,
+		LowStockThreshold: item.LowStockThreshold,
+	}
+}
+
+func (c *GormCatalogRepository) convertModelToItem(model Item) *catalog.Item {
+	return &catalog.Item{
+		Id:                ulid.MustParse(model.ID),
+		Kind:              catalog.Kind(model.Kind),
+		Name:              model.Name,
+		SKU:               model.SKU,
+		DefaultPrice:      model.DefaultPrice,
+		Unit:              model.Unit,
+		Active:            model.Active,
+		LowStockThreshold: model.LowStockThreshold,
+	}
+}
+
+type CatalogReaderGormRepository struct {
+	db *gorm.DB
+}
+
+func NewCatalogReaderGormRepository(db *gorm.DB) *CatalogReaderGormRepository {
+	return &CatalogReaderGormRepository{db: db}
+}
+
+func (c *CatalogReaderGormRepository) ActiveProductExists(ctx context.Context, id ulid.ULID) (bool, error) {
+	return c.activeItemExists(ctx, catalog.ProductKind, id)
+}
+
+func (c *CatalogReaderGormRepository) ActiveServiceExists(ctx context.Context, id ulid.ULID) (bool, error) {
+	return c.activeItemExists(ctx, catalog.ServiceKind, id)
+}
+
+func (c *CatalogReaderGormRepository) activeItemExists(ctx context.Context, kind catalog.Kind, id ulid.ULID) (bool, error) {
+	var count int64
+	err := c.db.WithContext(ctx).Model(&Item{}).
+		Where("id = ? AND kind = ? AND active = ?", id.String(), string(kind), true).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
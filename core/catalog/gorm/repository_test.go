@@ -0,0 +1,260 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	setupdbtests "github.com/henriquerocha2004/quem-me-deve-api/config/setupDbTests"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/catalog"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	ormdb "github.com/henriquerocha2004/quem-me-deve-api/core/shared/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/helpers"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/joho/godotenv"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/suite"
+	orm "gorm.io/gorm"
+)
+
+var gormDB *orm.DB = nil
+
+// tenantCtx scopes the tests to a single tenant, as the requests are.
+var tenantCtx = shared.WithTenant(context.Background(), ulid.Make())
+
+func TestMain(m *testing.M) {
+	envPath := helpers.ProjetctRoot() + ".env.testing"
+	err := godotenv.Overload(envPath)
+	if err != nil {
+		log.Println(err)
+		panic("Error loading .env file")
+	}
+
+	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
+		os.Getenv("DB_USER"),
+		os.Getenv("DB_PASSWORD"),
+		os.Getenv("DB_HOST"),
+		os.Getenv("DB_PORT"),
+		os.Getenv("DB_NAME"),
+	)
+
+	gormDB, err = ormdb.NewGorm(dsn)
+	if err != nil {
+		log.Println(err)
+		panic(err)
+	}
+	sql, err := gormDB.DB()
+	if err != nil {
+		log.Println(err)
+		panic(err)
+	}
+
+	sql.SetMaxIdleConns(10)
+	sql.SetMaxOpenConns(100)
+	sql.SetConnMaxLifetime(30 * time.Minute)
+
+	defer sql.Close()
+	m.Run()
+}
+
+type CatalogRepositorySuiteTest struct {
+	suite.Suite
+}
+
+func (s *CatalogRepositorySuiteTest) TearDownTest() {
+	err := setupdbtests.TruncateTables(gormDB)
+	if err != nil {
+		s.Fail("Failed to truncate tables: %v", err)
+	}
+}
+
+func TestCatalogRepositorySuite(t *testing.T) {
+	suite.Run(t, new(CatalogRepositorySuiteTest))
+}
+
+func (s *CatalogRepositorySuiteTest) TestShouldCreateAndFindItem() {
+	repo := NewGormCatalogRepository(gormDB)
+	item := &catalog.Item{
+		Id:           ulid.Make(),
+		Kind:         catalog.ProductKind,
+		Name:         "Camisa",
+		SKU:          "CAM-001",
+		DefaultPrice: money.FromCents(4990),
+		Unit:         "un",
+		Active:       true,
+	}
+
+	err := repo.Create(tenantCtx, item)
+	s.NoError(err)
+
+	found, err := repo.FindById(tenantCtx, catalog.ProductKind, item.Id)
+	s.NoError(err)
+	s.Equal(item, found)
+
+	bySku, err := repo.FindBySKU(tenantCtx, catalog.ProductKind, "CAM-001")
+	s.NoError(err)
+	s.Equal(item.Id, bySku.Id)
+
+	asService, err := repo.FindById(tenantCtx, catalog.ServiceKind, item.Id)
+	s.NoError(err)
+	s.Nil(asService)
+}
+
+func (s *CatalogRepositorySuiteTest) TestShouldDeactivateItem() {
+	repo := NewGormCatalogRepository(gormDB)
+	reader := NewCatalogReaderGormRepository(gormDB)
+	item := &catalog.Item{
+		Id:     ulid.Make(),
+		Kind:   catalog.ServiceKind,
+		Name:   "Ajuste",
+		Unit:   "h",
+		Active: true,
+	}
+
+	err := repo.Create(tenantCtx, item)
+	s.NoError(err)
+
+	exists, err := reader.ActiveServiceExists(tenantCtx, item.Id)
+	s.NoError(err)
+	s.True(exists)
+
+	item.Active = false
+	err = repo.Update(tenantCtx, item)
+	s.NoError(err)
+
+	exists, err = reader.ActiveServiceExists(tenantCtx, item.Id)
+	s.NoError(err)
+	s.False(exists)
+
+	exists, err = reader.ActiveProductExists(tenantCtx, item.Id)
+	s.NoError(err)
+	s.False(exists)
+}
+
+func (s *CatalogRepositorySuiteTest) TestShouldListItemsOfOneKind() {
+	repo := NewGormCatalogRepository(gormDB)
+
+	for _, item := range []*catalog.Item{
+		{Id: ulid.Make(), Kind: catalog.ProductKind, Name: "Camisa", Unit: "un", Active: true},
+		{Id: ulid.Make(), Kind: catalog.ProductKind, Name: "Calca", Unit: "un", Active: true},
+		{Id: ulid.Make(), Kind: catalog.ServiceKind, Name: "Ajuste", Unit: "h", Active: true},
+	} {
+		s.NoError(repo.Create(tenantCtx, item))
+	}
+
+	criteria := paginate.SearchDto{Limit: 10}
+	criteria.SetPage(1)
+
+	result, err := repo.FindAll(tenantCtx, catalog.ProductKind, criteria)
+	s.NoError(err)
+	s.Equal(2, result.TotalRecords)
+	s.Equal("Calca", result.Data[0].Name)
+}
+
+func (s *CatalogRepositorySuiteTest) TestShouldSumStockMovementsAndListLowStock() {
+	repo := NewGormCatalogRepository(gormDB)
+	stockRepo := NewGormStockRepository(gormDB)
+	product := &catalog.Item{
+		Id:                ulid.Make(),
+		Kind:              catalog.ProductKind,
+		Name:              "Camisa",
+		Unit:              "un",
+		Active:            true,
+		LowStockThreshold: 5,
+	}
+	s.NoError(repo.Create(tenantCtx, product))
+
+	now := time.Now()
+	for _, quantity := range []int{10, -4, -2} {
+		err := stockRepo.AddMovement(tenantCtx, &catalog.StockMovement{
+			Id:        ulid.Make(),
+			ProductId: product.Id,
+			Type:      catalog.Adjustment,
+			Quantity:  quantity,
+			CreatedAt: &now,
+		})
+		s.NoError(err)
+	}
+
+	level, err := stockRepo.StockLevel(tenantCtx, product.Id)
+	s.NoError(err)
+	s.Equal(4, level.Quantity)
+	s.True(level.IsLow())
+
+	lowStock, err := stockRepo.LowStock(tenantCtx)
+	s.NoError(err)
+	s.Len(lowStock, 1)
+	s.Equal(product.Id, lowStock[0].ProductId)
+
+	criteria := paginate.SearchDto{Limit: 10}
+	criteria.SetPage(1)
+
+	movements, err := stockRepo.Movements(tenantCtx, product.Id, criteria)
+	s.NoError(err)
+	s.Equal(3, movements.TotalRecords)
+}
+
+func (s *CatalogRepositorySuiteTest) TestShouldKeepItemsAndStockOfTenant() {
+	repo := NewGormCatalogRepository(gormDB)
+	reader := NewCatalogReaderGormRepository(gormDB)
+	stockRepo := NewGormStockRepository(gormDB)
+	otherTenantCtx := shared.WithTenant(context.Background(), ulid.Make())
+
+	product := &catalog.Item{
+		Id:     ulid.Make(),
+		Kind:   catalog.ProductKind,
+		Name:   "Camisa",
+		SKU:    "CAM-001",
+		Unit:   "un",
+		Active: true,
+	}
+	s.NoError(repo.Create(tenantCtx, product))
+
+	now := time.Now()
+	s.NoError(stockRepo.AddMovement(tenantCtx, &catalog.StockMovement{
+		Id:        ulid.Make(),
+		ProductId: product.Id,
+		Type:      catalog.Adjustment,
+		Quantity:  10,
+		CreatedAt: &now,
+	}))
+
+	found, err := repo.FindById(otherTenantCtx, catalog.ProductKind, product.Id)
+	s.NoError(err)
+	s.Nil(found)
+
+	exists, err := reader.ActiveProductExists(otherTenantCtx, product.Id)
+	s.NoError(err)
+	s.False(exists)
+
+	level, err := stockRepo.StockLevel(otherTenantCtx, product.Id)
+	s.NoError(err)
+	s.Nil(level)
+
+	criteria := paginate.SearchDto{Limit: 10}
+	criteria.SetPage(1)
+
+	movements, err := stockRepo.Movements(otherTenantCtx, product.Id, criteria)
+	s.NoError(err)
+	s.Zero(movements.TotalRecords)
+
+	product.Name = "Camisa alterada"
+	s.NoError(repo.Update(otherTenantCtx, product))
+	found, err = repo.FindById(tenantCtx, catalog.ProductKind, product.Id)
+	s.NoError(err)
+	s.Equal("Camisa", found.Name)
+
+	sameSku := &catalog.Item{
+		Id:     ulid.Make(),
+		Kind:   catalog.ProductKind,
+		Name:   "Camisa",
+		SKU:    "CAM-001",
+		Unit:   "un",
+		Active: true,
+	}
+	s.NoError(repo.Create(otherTenantCtx, sameSku))
+}
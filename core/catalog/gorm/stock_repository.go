@@ -0,0 +1,147 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/catalog"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type GormStockRepository struct {
+	db *gorm.DB
+}
+
+func NewGormStockRepository(db *gorm.DB) *GormStockRepository {
+	return &GormStockRepository{db: db}
+}
+
+type stockLevelRow struct {
+	ID                string
+	Name              string
+	LowStockThreshold int
+	Quantity          int
+}
+
+func (g *GormStockRepository) AddMovement(ctx context.Context, movement *catalog.StockMovement) error {
+	model := StockMovement{
+		ID:           movement.Id.String(),
+		ProductId:    movement.ProductId.String(),
+		MovementType: string(movement.Type),
+		Quantity:     movement.Quantity,
+		Description:  movement.Description,
+		CreatedAt:    movement.CreatedAt,
+	}
+
+	if movement.DebtId != nil {
+		debtId := movement.DebtId.String()
+		model.DebtId = &debtId
+	}
+
+	return g.db.WithContext(ctx).Create(&model).Error
+}
+
+func (g *GormStockRepository) StockLevel(ctx context.Context, productId ulid.ULID) (*catalog.StockLevel, error) {
+	var row stockLevelRow
+
+	err := g.stockLevels(ctx).
+		Where("catalog_items.id = ?", productId.String()).
+		Take(&row).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return g.convertRowToStockLevel(row), nil
+}
+
+func (g *GormStockRepository) LowStock(ctx context.Context) ([]*catalog.StockLevel, error) {
+	var rows []stockLevelRow
+
+	err := g.stockLevels(ctx).
+		Where("catalog_items.active = ? AND catalog_items.low_stock_threshold > 0", true).
+		Having("COALESCE(SUM(stock_movements.quantity), 0) <= catalog_items.low_stock_threshold").
+		Order("catalog_items.name ASC").
+		Scan(&rows).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	var levels []*catalog.StockLevel
+	for _, row := range rows {
+		levels = append(levels, g.convertRowToStockLevel(row))
+	}
+
+	return levels, nil
+}
+
+func (g *GormStockRepository) Movements(ctx context.Context, productId ulid.ULID, criteria paginate.SearchDto) (*catalog.MovementPaginationResult, error) {
+	var models []StockMovement
+	var total int64
+
+	err := g.db.WithContext(ctx).Model(&StockMovement{}).
+		Where("product_id = ?", productId.String()).
+		Count(&total).
+		Offset(criteria.Offset()).
+		Limit(criteria.Limit).
+		Order("created_at DESC").
+		Find(&models).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	var movements []*catalog.StockMovement
+	for _, model := range models {
+		movements = append(movements, g.convertModelToMovement(model))
+	}
+
+	return &catalog.MovementPaginationResult{
+		TotalRecords: int(total),
+		Data:         movements,
+	}, nil
+}
+
+// stockLevels sums the ledger of each product. Products without movements
+// have a quantity of zero.
+func (g *GormStockRepository) stockLevels(ctx context.Context) *gorm.DB {
+	return g.db.WithContext(ctx).Model(&Item{}).
+		Select("catalog_items.id, catalog_items.name, catalog_items.low_stock_threshold, "+
+			"COALESCE(SUM(stock_movements.quantity), 0) AS quantity").
+		Joins("LEFT JOIN stock_movements ON stock_movements.product_id = catalog_items.id").
+		Where("catalog_items.kind = ?", string(catalog.ProductKind)).
+		Group("catalog_items.id, catalog_items.name, catalog_items.low_stock_threshold")
+}
+
+func (g *GormStockRepository) convertRowToStockLevel(row stockLevelRow) *catalog.StockLevel {
+	return &catalog.StockLevel{
+		ProductId:         ulid.MustParse(row.ID),
+		Name:              row.Name,
+		Quantity:          row.Quantity,
+		LowStockThreshold: row.LowStockThreshold,
+	}
+}
+
+func (g *GormStockRepository) convertModelToMovement(model StockMovement) *catalog.StockMovement {
+	movement := &catalog.StockMovement{
+		Id:          ulid.MustParse(model.ID),
+		ProductId:   ulid.MustParse(model.ProductId),
+		Type:        catalog.MovementType(model.MovementType),
+		Quantity:    model.Quantity,
+		Description: model.Description,
+		CreatedAt:   model.CreatedAt,
+	}
+
+	if model.DebtId != nil {
+		debtId := ulid.MustParse(*model.DebtId)
+		movement.DebtId = &debtId
+	}
+
+	return movement
+}
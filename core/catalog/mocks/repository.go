@@ -0,0 +1,349 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./core/catalog/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./core/catalog/repository.go -destination=./core/catalog/mocks/repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	catalog "github.com/henriquerocha2004/quem-me-deve-api/core/catalog"
+	paginate "github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	ulid "github.com/oklog/ulid/v2"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReader is a mock of Reader interface.
+type MockReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockReaderMockRecorder is the mock recorder for MockReader.
+type MockReaderMockRecorder struct {
+	mock *MockReader
+}
+
+// NewMockReader creates a new mock instance.
+func NewMockReader(ctrl *gomock.Controller) *MockReader {
+	mock := &MockReader{ctrl: ctrl}
+	mock.recorder = &MockReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReader) EXPECT() *MockReaderMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockReader) FindAll(ctx context.Context, kind catalog.Kind, criteria paginate.SearchDto) (*catalog.PaginationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, kind, criteria)
+	ret0, _ := ret[0].(*catalog.PaginationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockReaderMockRecorder) FindAll(ctx, kind, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockReader)(nil).FindAll), ctx, kind, criteria)
+}
+
+// FindById mocks base method.
+func (m *MockReader) FindById(ctx context.Context, kind catalog.Kind, id ulid.ULID) (*catalog.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, kind, id)
+	ret0, _ := ret[0].(*catalog.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockReaderMockRecorder) FindById(ctx, kind, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockReader)(nil).FindById), ctx, kind, id)
+}
+
+// FindBySKU mocks base method.
+func (m *MockReader) FindBySKU(ctx context.Context, kind catalog.Kind, sku string) (*catalog.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySKU", ctx, kind, sku)
+	ret0, _ := ret[0].(*catalog.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySKU indicates an expected call of FindBySKU.
+func (mr *MockReaderMockRecorder) FindBySKU(ctx, kind, sku any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySKU", reflect.TypeOf((*MockReader)(nil).FindBySKU), ctx, kind, sku)
+}
+
+// MockWriter is a mock of Writer interface.
+type MockWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockWriterMockRecorder
+	isgomock struct{}
+}
+
+// MockWriterMockRecorder is the mock recorder for MockWriter.
+type MockWriterMockRecorder struct {
+	mock *MockWriter
+}
+
+// NewMockWriter creates a new mock instance.
+func NewMockWriter(ctrl *gomock.Controller) *MockWriter {
+	mock := &MockWriter{ctrl: ctrl}
+	mock.recorder = &MockWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWriter) EXPECT() *MockWriterMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWriter) Create(ctx context.Context, item *catalog.Item) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWriterMockRecorder) Create(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWriter)(nil).Create), ctx, item)
+}
+
+// Delete mocks base method.
+func (m *MockWriter) Delete(ctx context.Context, kind catalog.Kind, id ulid.ULID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, kind, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWriterMockRecorder) Delete(ctx, kind, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWriter)(nil).Delete), ctx, kind, id)
+}
+
+// Update mocks base method.
+func (m *MockWriter) Update(ctx context.Context, item *catalog.Item) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWriterMockRecorder) Update(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWriter)(nil).Update), ctx, item)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(ctx context.Context, item *catalog.Item) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, item)
+}
+
+// Delete mocks base method.
+func (m *MockRepository) Delete(ctx context.Context, kind catalog.Kind, id ulid.ULID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, kind, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRepositoryMockRecorder) Delete(ctx, kind, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, kind, id)
+}
+
+// FindAll mocks base method.
+func (m *MockRepository) FindAll(ctx context.Context, kind catalog.Kind, criteria paginate.SearchDto) (*catalog.PaginationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, kind, criteria)
+	ret0, _ := ret[0].(*catalog.PaginationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockRepositoryMockRecorder) FindAll(ctx, kind, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockRepository)(nil).FindAll), ctx, kind, criteria)
+}
+
+// FindById mocks base method.
+func (m *MockRepository) FindById(ctx context.Context, kind catalog.Kind, id ulid.ULID) (*catalog.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, kind, id)
+	ret0, _ := ret[0].(*catalog.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockRepositoryMockRecorder) FindById(ctx, kind, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockRepository)(nil).FindById), ctx, kind, id)
+}
+
+// FindBySKU mocks base method.
+func (m *MockRepository) FindBySKU(ctx context.Context, kind catalog.Kind, sku string) (*catalog.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySKU", ctx, kind, sku)
+	ret0, _ := ret[0].(*catalog.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySKU indicates an expected call of FindBySKU.
+func (mr *MockRepositoryMockRecorder) FindBySKU(ctx, kind, sku any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySKU", reflect.TypeOf((*MockRepository)(nil).FindBySKU), ctx, kind, sku)
+}
+
+// Update mocks base method.
+func (m *MockRepository) Update(ctx context.Context, item *catalog.Item) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRepositoryMockRecorder) Update(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, item)
+}
+
+// MockStockRepository is a mock of StockRepository interface.
+type MockStockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockStockRepositoryMockRecorder is the mock recorder for MockStockRepository.
+type MockStockRepositoryMockRecorder struct {
+	mock *MockStockRepository
+}
+
+// NewMockStockRepository creates a new mock instance.
+func NewMockStockRepository(ctrl *gomock.Controller) *MockStockRepository {
+	mock := &MockStockRepository{ctrl: ctrl}
+	mock.recorder = &MockStockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStockRepository) EXPECT() *MockStockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddMovement mocks base method.
+func (m *MockStockRepository) AddMovement(ctx context.Context, movement *catalog.StockMovement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMovement", ctx, movement)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMovement indicates an expected call of AddMovement.
+func (mr *MockStockRepositoryMockRecorder) AddMovement(ctx, movement any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMovement", reflect.TypeOf((*MockStockRepository)(nil).AddMovement), ctx, movement)
+}
+
+// LowStock mocks base method.
+func (m *MockStockRepository) LowStock(ctx context.Context) ([]*catalog.StockLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LowStock", ctx)
+	ret0, _ := ret[0].([]*catalog.StockLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LowStock indicates an expected call of LowStock.
+func (mr *MockStockRepositoryMockRecorder) LowStock(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LowStock", reflect.TypeOf((*MockStockRepository)(nil).LowStock), ctx)
+}
+
+// Movements mocks base method.
+func (m *MockStockRepository) Movements(ctx context.Context, productId ulid.ULID, criteria paginate.SearchDto) (*catalog.MovementPaginationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Movements", ctx, productId, criteria)
+	ret0, _ := ret[0].(*catalog.MovementPaginationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Movements indicates an expected call of Movements.
+func (mr *MockStockRepositoryMockRecorder) Movements(ctx, productId, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Movements", reflect.TypeOf((*MockStockRepository)(nil).Movements), ctx, productId, criteria)
+}
+
+// StockLevel mocks base method.
+func (m *MockStockRepository) StockLevel(ctx context.Context, productId ulid.ULID) (*catalog.StockLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StockLevel", ctx, productId)
+	ret0, _ := ret[0].(*catalog.StockLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StockLevel indicates an expected call of StockLevel.
+func (mr *MockStockRepositoryMockRecorder) StockLevel(ctx, productId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StockLevel", reflect.TypeOf((*MockStockRepository)(nil).StockLevel), ctx, productId)
+}
@@ -0,0 +1,32 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+)
+
+type Reader interface {
+	FindById(ctx context.Context, kind Kind, id ulid.ULID) (*Item, error)
+	FindBySKU(ctx context.Context, kind Kind, sku string) (*Item, error)
+	FindAll(ctx context.Context, kind Kind, criteria paginate.SearchDto) (*PaginationResult, error)
+}
+
+type Writer interface {
+	Create(ctx context.Context, item *Item) error
+	Update(ctx context.Context, item *Item) error
+	Delete(ctx context.Context, kind Kind, id ulid.ULID) error
+}
+
+type Repository interface {
+	Reader
+	Writer
+}
+
+type StockRepository interface {
+	AddMovement(ctx context.Context, movement *StockMovement) error
+	StockLevel(ctx context.Context, productId ulid.ULID) (*StockLevel, error)
+	LowStock(ctx context.Context) ([]*StockLevel, error)
+	Movements(ctx context.Context, productId ulid.ULID, criteria paginate.SearchDto) (*MovementPaginationResult, error)
+}
@@ -0,0 +1,233 @@
+package catalog
+
+import (
+	"context"
+	"log"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+)
+
+type Service interface {
+	Create(ctx context.Context, kind Kind, dto *ItemDto) shared.ServiceResponse
+	Update(ctx context.Context, kind Kind, id ulid.ULID, dto *ItemDto) shared.ServiceResponse
+	Delete(ctx context.Context, kind Kind, id ulid.ULID) shared.ServiceResponse
+	FindById(ctx context.Context, kind Kind, id ulid.ULID) shared.ServiceResponse
+	FindByCriteria(ctx context.Context, kind Kind, criteria *paginate.PaginateRequest) shared.ServiceResponse
+}
+
+type CatalogService struct {
+	repository Repository
+}
+
+func NewCatalogService(repository Repository) *CatalogService {
+	return &CatalogService{
+		repository: repository,
+	}
+}
+
+func (s *CatalogService) Create(ctx context.Context, kind Kind, dto *ItemDto) shared.ServiceResponse {
+	if response, taken := s.skuTaken(ctx, kind, dto.SKU, ulid.ULID{}); taken {
+		return response
+	}
+
+	item := &Item{
+		Id:                ulid.Make(),
+		Kind:              kind,
+		Name:              dto.Name,
+		SKU:               dto.SKU,
+		DefaultPrice:      dto.DefaultPrice,
+		Unit:              dto.Unit,
+		Active:            dto.Active == nil || *dto.Active,
+		LowStockThreshold: dto.LowStockThreshold,
+	}
+
+	if err := item.validate(); err != nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: err.Error(),
+		}
+	}
+
+	if err := s.repository.Create(ctx, item); err != nil {
+		log.Println("Error creating catalog item:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in create " + string(kind),
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: string(kind) + " created successfully",
+		Data:    s.convertToItemDto(item),
+	}
+}
+
+func (s *CatalogService) Update(ctx context.Context, kind Kind, id ulid.ULID, dto *ItemDto) shared.ServiceResponse {
+	item, err := s.repository.FindById(ctx, kind, id)
+	if err != nil {
+		log.Println("Error finding catalog item:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in update " + string(kind),
+		}
+	}
+
+	if item == nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: string(kind) + " not found",
+		}
+	}
+
+	if response, taken := s.skuTaken(ctx, kind, dto.SKU, id); taken {
+		return response
+	}
+
+	item.Name = dto.Name
+	item.SKU = dto.SKU
+	item.DefaultPrice = dto.DefaultPrice
+	item.Unit = dto.Unit
+	item.LowStockThreshold = dto.LowStockThreshold
+
+	if dto.Active != nil {
+		item.Active = *dto.Active
+	}
+
+	if err = item.validate(); err != nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: err.Error(),
+		}
+	}
+
+	if err = s.repository.Update(ctx, item); err != nil {
+		log.Println("Error updating catalog item:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in update " + string(kind),
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: string(kind) + " updated successfully",
+		Data:    s.convertToItemDto(item),
+	}
+}
+
+func (s *CatalogService) Delete(ctx context.Context, kind Kind, id ulid.ULID) shared.ServiceResponse {
+	if err := s.repository.Delete(ctx, kind, id); err != nil {
+		log.Println("Error deleting catalog item:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in delete " + string(kind),
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: string(kind) + " deleted successfully",
+	}
+}
+
+func (s *CatalogService) FindById(ctx context.Context, kind Kind, id ulid.ULID) shared.ServiceResponse {
+	item, err := s.repository.FindById(ctx, kind, id)
+	if err != nil {
+		log.Println("Error finding catalog item:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in find " + string(kind),
+		}
+	}
+
+	if item == nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: string(kind) + " not found",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: string(kind) + " found successfully",
+		Data:    s.convertToItemDto(item),
+	}
+}
+
+func (s *CatalogService) FindByCriteria(ctx context.Context, kind Kind, criteria *paginate.PaginateRequest) shared.ServiceResponse {
+	pagDto := paginate.SearchDto{
+		Limit:         criteria.Limit,
+		SortField:     criteria.SortField,
+		TermSearch:    criteria.SearchTerm,
+		SortDirection: criteria.SortDirection,
+	}
+
+	pagDto.SetPage(criteria.Page)
+	pagDto.AddColumnSearch(criteria.ColumnSearch)
+
+	result, err := s.repository.FindAll(ctx, kind, pagDto)
+	if err != nil {
+		log.Println("Error finding catalog items:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in find " + string(kind) + "s",
+		}
+	}
+
+	var itemsDto []ItemDto
+	for _, item := range result.Data {
+		itemsDto = append(itemsDto, s.convertToItemDto(item))
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: string(kind) + "s found successfully",
+		Data: paginate.Result{
+			TotalRecords: result.TotalRecords,
+			Data:         itemsDto,
+		},
+	}
+}
+
+// skuTaken reports whether another item of the same kind already uses the
+// sku. Items without sku are never in conflict.
+func (s *CatalogService) skuTaken(ctx context.Context, kind Kind, sku string, id ulid.ULID) (shared.ServiceResponse, bool) {
+	if sku == "" {
+		return shared.ServiceResponse{}, false
+	}
+
+	item, err := s.repository.FindBySKU(ctx, kind, sku)
+	if err != nil {
+		log.Println("Error finding catalog item by sku:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in validate sku",
+		}, true
+	}
+
+	if item != nil && item.Id != id {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: string(kind) + " with this sku already exists",
+		}, true
+	}
+
+	return shared.ServiceResponse{}, false
+}
+
+func (s *CatalogService) convertToItemDto(item *Item) ItemDto {
+	active := item.Active
+
+	return ItemDto{
+		Id:                item.Id.String(),
+		Name:              item.Name,
+		SKU:               item.SKU,
+		DefaultPrice:      item.DefaultPrice,
+		Unit:              item.Unit,
+		Active:            &active,
+		LowStockThreshold: item.LowStockThreshold,
+	}
+}
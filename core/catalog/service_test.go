@@ -0,0 +1,115 @@
+package catalog_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/catalog"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/catalog/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCatalogService(t *testing.T) {
+	t.Run("should create an active product with the default unit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().FindBySKU(gomock.Any(), catalog.ProductKind, "CAM-001").Return(nil, nil)
+		repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, item *catalog.Item) error {
+			assert.Equal(t, catalog.ProductKind, item.Kind)
+			assert.Equal(t, "un", item.Unit)
+			assert.True(t, item.Active)
+			return nil
+		})
+
+		service := catalog.NewCatalogService(repo)
+		result := service.Create(context.Background(), catalog.ProductKind, &catalog.ItemDto{
+			Name:         "Camisa",
+			SKU:          "CAM-001",
+			DefaultPrice: money.FromCents(4990),
+		})
+
+		assert.Equal(t, "success", result.Status)
+		assert.Equal(t, "product created successfully", result.Message)
+	})
+
+	t.Run("should not create a service with a sku already in use", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().FindBySKU(gomock.Any(), catalog.ServiceKind, "AJ-01").Return(&catalog.Item{Id: ulid.Make()}, nil)
+		repo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		service := catalog.NewCatalogService(repo)
+		result := service.Create(context.Background(), catalog.ServiceKind, &catalog.ItemDto{
+			Name: "Ajuste",
+			SKU:  "AJ-01",
+		})
+
+		assert.Equal(t, "error", result.Status)
+		assert.Equal(t, "service with this sku already exists", result.Message)
+	})
+
+	t.Run("should not create an item with negative default price", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		service := catalog.NewCatalogService(repo)
+		result := service.Create(context.Background(), catalog.ProductKind, &catalog.ItemDto{
+			Name:         "Camisa",
+			DefaultPrice: money.FromCents(-1),
+		})
+
+		assert.Equal(t, "error", result.Status)
+		assert.Equal(t, "default price must not be negative", result.Message)
+	})
+
+	t.Run("should deactivate a product keeping its sku", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		id := ulid.Make()
+		existing := &catalog.Item{Id: id, Kind: catalog.ProductKind, Name: "Camisa", SKU: "CAM-001", Unit: "un", Active: true}
+		active := false
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().FindById(gomock.Any(), catalog.ProductKind, id).Return(existing, nil)
+		repo.EXPECT().FindBySKU(gomock.Any(), catalog.ProductKind, "CAM-001").Return(existing, nil)
+		repo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, item *catalog.Item) error {
+			assert.False(t, item.Active)
+			return nil
+		})
+
+		service := catalog.NewCatalogService(repo)
+		result := service.Update(context.Background(), catalog.ProductKind, id, &catalog.ItemDto{
+			Name:   "Camisa",
+			SKU:    "CAM-001",
+			Active: &active,
+		})
+
+		assert.Equal(t, "success", result.Status)
+	})
+
+	t.Run("should return not found when updating an unknown service", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().FindById(gomock.Any(), catalog.ServiceKind, gomock.Any()).Return(nil, nil)
+		repo.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+
+		service := catalog.NewCatalogService(repo)
+		result := service.Update(context.Background(), catalog.ServiceKind, ulid.Make(), &catalog.ItemDto{Name: "Ajuste"})
+
+		assert.Equal(t, "error", result.Status)
+		assert.Equal(t, "service not found", result.Message)
+	})
+}
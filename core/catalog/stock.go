@@ -0,0 +1,78 @@
+package catalog
+
+import (
+	"errors"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+type MovementType string
+
+const (
+	Purchase   MovementType = "purchase"
+	Sale       MovementType = "sale"
+	Return     MovementType = "return"
+	Adjustment MovementType = "adjustment"
+)
+
+// StockMovement is an entry of the stock ledger of a product. The current
+// quantity of a product is the sum of the quantities of its movements, so
+// Quantity is negative when the product leaves the stock.
+type StockMovement struct {
+	Id          ulid.ULID
+	ProductId   ulid.ULID
+	Type        MovementType
+	Quantity    int
+	Description string
+	DebtId      *ulid.ULID
+	CreatedAt   *time.Time
+}
+
+// StockLevel is the current quantity of a product against its low stock
+// threshold. A threshold of zero means the product is not watched.
+type StockLevel struct {
+	ProductId         ulid.ULID
+	Name              string
+	Quantity          int
+	LowStockThreshold int
+}
+
+func (l StockLevel) IsLow() bool {
+	return l.LowStockThreshold > 0 && l.Quantity <= l.LowStockThreshold
+}
+
+// newStockMovement applies the sign of the movement type to quantity.
+// Adjustments keep the sign informed, since they can fix the stock in both
+// directions.
+func newStockMovement(productId ulid.ULID, movementType MovementType, quantity int, description string) (*StockMovement, error) {
+	if quantity == 0 {
+		return nil, errors.New("quantity must not be zero")
+	}
+
+	switch movementType {
+	case Purchase, Return:
+		if quantity < 0 {
+			return nil, errors.New("quantity must be greater than 0")
+		}
+	case Sale:
+		if quantity < 0 {
+			return nil, errors.New("quantity must be greater than 0")
+		}
+		quantity = -quantity
+	case Adjustment:
+	default:
+		return nil, errors.New("the movement type informed is invalid")
+	}
+
+	now := time.Now()
+
+	return &StockMovement{
+		Id:          ulid.Make(),
+		ProductId:   productId,
+		Type:        movementType,
+		Quantity:    quantity,
+		Description: description,
+		CreatedAt:   &now,
+	}, nil
+}
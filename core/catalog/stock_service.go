@@ -0,0 +1,187 @@
+package catalog
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+)
+
+type StockService interface {
+	RegisterMovement(ctx context.Context, productId ulid.ULID, dto *StockMovementDto) shared.ServiceResponse
+	StockLevel(ctx context.Context, productId ulid.ULID) shared.ServiceResponse
+	LowStock(ctx context.Context) shared.ServiceResponse
+	Movements(ctx context.Context, productId ulid.ULID, criteria *paginate.PaginateRequest) shared.ServiceResponse
+}
+
+type CatalogStockService struct {
+	items Repository
+	stock StockRepository
+}
+
+func NewStockService(items Repository, stock StockRepository) *CatalogStockService {
+	return &CatalogStockService{
+		items: items,
+		stock: stock,
+	}
+}
+
+// RegisterMovement records a manual entry in the stock ledger, such as a
+// purchase from a supplier or an inventory adjustment. Sales and returns of
+// debts are written by the debt repository itself.
+func (s *CatalogStockService) RegisterMovement(ctx context.Context, productId ulid.ULID, dto *StockMovementDto) shared.ServiceResponse {
+	if response, ok := s.productExists(ctx, productId); !ok {
+		return response
+	}
+
+	movement, err := newStockMovement(productId, MovementType(dto.Type), dto.Quantity, dto.Description)
+	if err != nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: err.Error(),
+		}
+	}
+
+	if err = s.stock.AddMovement(ctx, movement); err != nil {
+		log.Println("Error adding stock movement:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in register stock movement",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "stock movement registered successfully",
+		Data:    s.convertToMovementDto(movement),
+	}
+}
+
+func (s *CatalogStockService) StockLevel(ctx context.Context, productId ulid.ULID) shared.ServiceResponse {
+	if response, ok := s.productExists(ctx, productId); !ok {
+		return response
+	}
+
+	level, err := s.stock.StockLevel(ctx, productId)
+	if err != nil {
+		log.Println("Error getting stock level:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in get stock level",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "stock level retrieved successfully",
+		Data:    s.convertToStockLevelDto(level),
+	}
+}
+
+func (s *CatalogStockService) LowStock(ctx context.Context) shared.ServiceResponse {
+	levels, err := s.stock.LowStock(ctx)
+	if err != nil {
+		log.Println("Error getting low stock products:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in get low stock products",
+		}
+	}
+
+	levelsDto := []StockLevelDto{}
+	for _, level := range levels {
+		levelsDto = append(levelsDto, s.convertToStockLevelDto(level))
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "low stock products retrieved successfully",
+		Data:    levelsDto,
+	}
+}
+
+func (s *CatalogStockService) Movements(ctx context.Context, productId ulid.ULID, criteria *paginate.PaginateRequest) shared.ServiceResponse {
+	pagDto := paginate.SearchDto{
+		Limit:         criteria.Limit,
+		SortField:     criteria.SortField,
+		TermSearch:    criteria.SearchTerm,
+		SortDirection: criteria.SortDirection,
+	}
+
+	pagDto.SetPage(criteria.Page)
+
+	result, err := s.stock.Movements(ctx, productId, pagDto)
+	if err != nil {
+		log.Println("Error getting stock movements:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in get stock movements",
+		}
+	}
+
+	var movementsDto []StockMovementDto
+	for _, movement := range result.Data {
+		movementsDto = append(movementsDto, s.convertToMovementDto(movement))
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "stock movements retrieved successfully",
+		Data: paginate.Result{
+			TotalRecords: result.TotalRecords,
+			Data:         movementsDto,
+		},
+	}
+}
+
+func (s *CatalogStockService) productExists(ctx context.Context, productId ulid.ULID) (shared.ServiceResponse, bool) {
+	product, err := s.items.FindById(ctx, ProductKind, productId)
+	if err != nil {
+		log.Println("Error finding product:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in find product",
+		}, false
+	}
+
+	if product == nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "product not found",
+		}, false
+	}
+
+	return shared.ServiceResponse{}, true
+}
+
+func (s *CatalogStockService) convertToMovementDto(movement *StockMovement) StockMovementDto {
+	movementDto := StockMovementDto{
+		Id:          movement.Id.String(),
+		Type:        string(movement.Type),
+		Quantity:    movement.Quantity,
+		Description: movement.Description,
+	}
+
+	if movement.DebtId != nil {
+		movementDto.DebtId = movement.DebtId.String()
+	}
+
+	if movement.CreatedAt != nil {
+		movementDto.CreatedAt = movement.CreatedAt.Format(time.DateTime)
+	}
+
+	return movementDto
+}
+
+func (s *CatalogStockService) convertToStockLevelDto(level *StockLevel) StockLevelDto {
+	return StockLevelDto{
+		ProductId:         level.ProductId.String(),
+		Name:              level.Name,
+		Quantity:          level.Quantity,
+		LowStockThreshold: level.LowStockThreshold,
+		Low:               level.IsLow(),
+	}
+}
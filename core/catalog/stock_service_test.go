@@ -0,0 +1,112 @@
+package catalog_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/catalog"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/catalog/mocks"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestStockService(t *testing.T) {
+	testCases := []struct {
+		name     string
+		dto      catalog.StockMovementDto
+		expected int
+	}{
+		{
+			name:     "purchase adds to the stock",
+			dto:      catalog.StockMovementDto{Type: "purchase", Quantity: 10},
+			expected: 10,
+		},
+		{
+			name:     "sale takes from the stock",
+			dto:      catalog.StockMovementDto{Type: "sale", Quantity: 4},
+			expected: -4,
+		},
+		{
+			name:     "adjustment keeps the sign informed",
+			dto:      catalog.StockMovementDto{Type: "adjustment", Quantity: -2},
+			expected: -2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			productId := ulid.Make()
+			items := mocks.NewMockRepository(ctrl)
+			items.EXPECT().FindById(gomock.Any(), catalog.ProductKind, productId).Return(&catalog.Item{Id: productId}, nil)
+			stock := mocks.NewMockStockRepository(ctrl)
+			stock.EXPECT().AddMovement(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, movement *catalog.StockMovement) error {
+				assert.Equal(t, tc.expected, movement.Quantity)
+				assert.Equal(t, productId, movement.ProductId)
+				return nil
+			})
+
+			service := catalog.NewStockService(items, stock)
+			result := service.RegisterMovement(context.Background(), productId, &tc.dto)
+
+			assert.Equal(t, "success", result.Status)
+		})
+	}
+
+	t.Run("should not register a purchase with negative quantity", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		items := mocks.NewMockRepository(ctrl)
+		items.EXPECT().FindById(gomock.Any(), gomock.Any(), gomock.Any()).Return(&catalog.Item{}, nil)
+		stock := mocks.NewMockStockRepository(ctrl)
+		stock.EXPECT().AddMovement(gomock.Any(), gomock.Any()).Times(0)
+
+		service := catalog.NewStockService(items, stock)
+		result := service.RegisterMovement(context.Background(), ulid.Make(), &catalog.StockMovementDto{Type: "purchase", Quantity: -1})
+
+		assert.Equal(t, "error", result.Status)
+		assert.Equal(t, "quantity must be greater than 0", result.Message)
+	})
+
+	t.Run("should not register a movement for an unknown product", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		items := mocks.NewMockRepository(ctrl)
+		items.EXPECT().FindById(gomock.Any(), catalog.ProductKind, gomock.Any()).Return(nil, nil)
+		stock := mocks.NewMockStockRepository(ctrl)
+		stock.EXPECT().AddMovement(gomock.Any(), gomock.Any()).Times(0)
+
+		service := catalog.NewStockService(items, stock)
+		result := service.RegisterMovement(context.Background(), ulid.Make(), &catalog.StockMovementDto{Type: "purchase", Quantity: 1})
+
+		assert.Equal(t, "product not found", result.Message)
+	})
+
+	t.Run("should flag the stock level at or below the threshold", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		productId := ulid.Make()
+		items := mocks.NewMockRepository(ctrl)
+		items.EXPECT().FindById(gomock.Any(), catalog.ProductKind, productId).Return(&catalog.Item{Id: productId}, nil)
+		stock := mocks.NewMockStockRepository(ctrl)
+		stock.EXPECT().StockLevel(gomock.Any(), productId).Return(&catalog.StockLevel{
+			ProductId:         productId,
+			Name:              "Camisa",
+			Quantity:          3,
+			LowStockThreshold: 3,
+		}, nil)
+
+		service := catalog.NewStockService(items, stock)
+		result := service.StockLevel(context.Background(), productId)
+
+		level := result.Data.(catalog.StockLevelDto)
+		assert.Equal(t, 3, level.Quantity)
+		assert.True(t, level.Low)
+	})
+}
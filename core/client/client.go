@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/henriquerocha2004/quem-me-deve-api/pkg/document"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
 	"github.com/oklog/ulid/v2"
 )
 
@@ -16,14 +17,16 @@ const (
 )
 
 type Client struct {
-	Id         ulid.ULID
-	Name       string
-	LastName   string
-	EntityType EntityType
-	Document   document.Document
-	BirthDay   *time.Time
-	Addresses  []Address
-	Phones     []Phone
+	Id            ulid.ULID
+	Name          string
+	LastName      string
+	EntityType    EntityType
+	Document      document.Document
+	BirthDay      *time.Time
+	Email         string
+	Addresses     []Address
+	Phones        []Phone
+	CreditBalance money.Money
 }
 
 func (c *Client) validate() error {
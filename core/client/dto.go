@@ -6,6 +6,7 @@ type ClientRequestDto struct {
 	BirthDay   string              `json:"birthday" validate:"required,dateFormat:YYYY-MM-DD"`
 	EntityType string              `json:"entity_type" validate:"required"`
 	Document   string              `json:"document" validate:"required"`
+	Email      string              `json:"email,omitempty" validate:"omitempty,email"`
 	Phones     []PhoneRequestDto   `json:"phones,omitempty"`
 	Addresses  []AddressRequestDto `json:"addresses,omitempty"`
 }
@@ -3,6 +3,7 @@ package gorm
 import (
 	"time"
 
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
 	"github.com/oklog/ulid/v2"
 	"gorm.io/gorm"
 )
@@ -14,8 +15,11 @@ type Client struct {
 	EntityType string     `gorm:"column:entity_type;type:text;not null"`
 	Document   string     `gorm:"column:document;type:text;not null"`
 	BirthDay   *time.Time `gorm:"column:birth_day;type:timestamp"`
+	Email      string     `gorm:"column:email;type:text"`
 	Addresses  []Address  `gorm:"foreignKey:OwnerID"`
 	Phones     []Phone    `gorm:"foreignKey:OwnerID"`
+	Wallet     *Wallet    `gorm:"foreignKey:ClientID"`
+	TenantID   string     `gorm:"column:tenant_id;type:char(26);not null"`
 	DeletedAt  gorm.DeletedAt
 }
 
@@ -68,3 +72,35 @@ func (d *Phone) BeforeCreate(tx *gorm.DB) (err error) {
 func (d *Phone) TableName() string {
 	return "phones"
 }
+
+type Wallet struct {
+	ClientID  string      `gorm:"column:client_id;primaryKey;type:char(26)"`
+	Balance   money.Money `gorm:"column:balance;type:decimal(12,2);not null"`
+	UpdatedAt *time.Time  `gorm:"column:updated_at"`
+	TenantID  string      `gorm:"column:tenant_id;type:char(26);not null"`
+}
+
+func (d *Wallet) TableName() string {
+	return "client_wallets"
+}
+
+type WalletEntry struct {
+	ID          string      `gorm:"column:id;primaryKey;type:char(26)"`
+	Type        string      `gorm:"column:type"`
+	Amount      money.Money `gorm:"column:amount"`
+	Description string      `gorm:"column:description"`
+	CreatedAt   *time.Time  `gorm:"column:created_at"`
+	ClientID    string      `gorm:"column:client_id;type:char(26);not null"`
+	TenantID    string      `gorm:"column:tenant_id;type:char(26);not null"`
+}
+
+func (d *WalletEntry) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == "" {
+		d.ID = ulid.Make().String()
+	}
+	return nil
+}
+
+func (d *WalletEntry) TableName() string {
+	return "client_wallet_entries"
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/henriquerocha2004/quem-me-deve-api/core/client"
 	"github.com/henriquerocha2004/quem-me-deve-api/pkg/document"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
 	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
 	"github.com/oklog/ulid/v2"
 	"gorm.io/gorm"
@@ -28,11 +29,12 @@ func (c *GormClientRepository) Create(ctx context.Context, client *client.Client
 		EntityType: string(client.EntityType),
 		Document:   string(client.Document),
 		BirthDay:   client.BirthDay,
+		Email:      client.Email,
 		Addresses:  c.convertAddressToModel(client.Addresses, client.Id),
 		Phones:     c.convertPhoneToModel(client.Phones, client.Id),
 	}
 
-	tx := c.db.Begin()
+	tx := c.db.WithContext(ctx).Begin()
 
 	if err := tx.Create(&clientModel).Error; err != nil {
 		tx.Rollback()
@@ -52,18 +54,28 @@ func (c *GormClientRepository) Update(ctx context.Context, client *client.Client
 		EntityType: string(client.EntityType),
 		Document:   string(client.Document),
 		BirthDay:   client.BirthDay,
+		Email:      client.Email,
 		Addresses:  c.convertAddressToModel(client.Addresses, client.Id),
 		Phones:     c.convertPhoneToModel(client.Phones, client.Id),
 	}
 
-	tx := c.db.Begin()
+	tx := c.db.WithContext(ctx).Begin()
 
-	err := tx.Model(&Client{}).Where("id = ?", clientModel.ID).Updates(clientModel).Error
-	if err != nil {
+	result := tx.Model(&Client{}).Where("id = ?", clientModel.ID).Updates(clientModel)
+	if result.Error != nil {
 		tx.Rollback()
-		return err
+		return result.Error
+	}
+
+	// Nothing was updated when the client belongs to another tenant, and
+	// then its addresses and phones must not be touched either.
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return errors.New("client not found")
 	}
 
+	var err error
+
 	if len(clientModel.Addresses) != 0 {
 		err = tx.WithContext(ctx).Model(clientModel).
 			Association("Addresses").
@@ -92,7 +104,7 @@ func (c *GormClientRepository) Update(ctx context.Context, client *client.Client
 }
 
 func (c *GormClientRepository) Delete(ctx context.Context, id ulid.ULID) error {
-	tx := c.db.Begin()
+	tx := c.db.WithContext(ctx).Begin()
 
 	if err := tx.Where("id = ?", id.String()).Delete(&Client{}).Error; err != nil {
 		tx.Rollback()
@@ -110,6 +122,7 @@ func (c *GormClientRepository) FindById(ctx context.Context, id ulid.ULID) (*cli
 	result := c.db.WithContext(ctx).Where("id = ?", id.String()).
 		Preload("Addresses").
 		Preload("Phones").
+		Preload("Wallet").
 		First(&clientModel)
 
 	if result.Error != nil {
@@ -120,14 +133,16 @@ func (c *GormClientRepository) FindById(ctx context.Context, id ulid.ULID) (*cli
 	}
 
 	return &client.Client{
-		Id:         id,
-		Name:       clientModel.Name,
-		LastName:   clientModel.LastName,
-		EntityType: client.EntityType(clientModel.EntityType),
-		Document:   document.Document(clientModel.Document),
-		BirthDay:   clientModel.BirthDay,
-		Addresses:  c.convertModelAddressToDomainAddress(clientModel.Addresses),
-		Phones:     c.convertModelPhoneToDomainPhone(clientModel.Phones),
+		Id:            id,
+		Name:          clientModel.Name,
+		LastName:      clientModel.LastName,
+		EntityType:    client.EntityType(clientModel.EntityType),
+		Document:      document.Document(clientModel.Document),
+		BirthDay:      clientModel.BirthDay,
+		Email:         clientModel.Email,
+		Addresses:     c.convertModelAddressToDomainAddress(clientModel.Addresses),
+		Phones:        c.convertModelPhoneToDomainPhone(clientModel.Phones),
+		CreditBalance: c.walletBalance(clientModel.Wallet),
 	}, nil
 }
 
@@ -137,6 +152,7 @@ func (c *GormClientRepository) FindByDocument(ctx context.Context, doc string) (
 	result := c.db.WithContext(ctx).Where("document = ?", doc).
 		Preload("Addresses").
 		Preload("Phones").
+		Preload("Wallet").
 		First(&clientModel)
 
 	if result.Error != nil {
@@ -152,14 +168,16 @@ func (c *GormClientRepository) FindByDocument(ctx context.Context, doc string) (
 	}
 
 	return &client.Client{
-		Id:         id,
-		Name:       clientModel.Name,
-		LastName:   clientModel.LastName,
-		EntityType: client.EntityType(clientModel.EntityType),
-		Document:   document.Document(clientModel.Document),
-		BirthDay:   clientModel.BirthDay,
-		Addresses:  c.convertModelAddressToDomainAddress(clientModel.Addresses),
-		Phones:     c.convertModelPhoneToDomainPhone(clientModel.Phones),
+		Id:            id,
+		Name:          clientModel.Name,
+		LastName:      clientModel.LastName,
+		EntityType:    client.EntityType(clientModel.EntityType),
+		Document:      document.Document(clientModel.Document),
+		BirthDay:      clientModel.BirthDay,
+		Email:         clientModel.Email,
+		Addresses:     c.convertModelAddressToDomainAddress(clientModel.Addresses),
+		Phones:        c.convertModelPhoneToDomainPhone(clientModel.Phones),
+		CreditBalance: c.walletBalance(clientModel.Wallet),
 	}, nil
 }
 
@@ -167,13 +185,14 @@ func (c *GormClientRepository) FindAll(ctx context.Context, criteria paginate.Se
 	var models []Client
 	var total int64
 
-	query := c.db.Model(&Client{}).
+	query := c.db.WithContext(ctx).Model(&Client{}).
 		Count(&total).
 		Offset(criteria.Offset()).
 		Limit(criteria.Limit).
 		Order("created_at DESC").
 		Preload("Addresses").
-		Preload("Phones")
+		Preload("Phones").
+		Preload("Wallet")
 
 	if criteria.TermSearch != "" {
 		query = query.
@@ -265,15 +284,25 @@ func (c *GormClientRepository) convertClientModelToDomain(clientModel Client) *c
 	}
 
 	return &client.Client{
-		Id:         id,
-		Name:       clientModel.Name,
-		LastName:   clientModel.LastName,
-		EntityType: client.EntityType(clientModel.EntityType),
-		Document:   document.Document(clientModel.Document),
-		BirthDay:   clientModel.BirthDay,
-		Addresses:  c.convertModelAddressToDomainAddress(clientModel.Addresses),
-		Phones:     c.convertModelPhoneToDomainPhone(clientModel.Phones),
+		Id:            id,
+		Name:          clientModel.Name,
+		LastName:      clientModel.LastName,
+		EntityType:    client.EntityType(clientModel.EntityType),
+		Document:      document.Document(clientModel.Document),
+		BirthDay:      clientModel.BirthDay,
+		Email:         clientModel.Email,
+		Addresses:     c.convertModelAddressToDomainAddress(clientModel.Addresses),
+		Phones:        c.convertModelPhoneToDomainPhone(clientModel.Phones),
+		CreditBalance: c.walletBalance(clientModel.Wallet),
+	}
+}
+
+func (c *GormClientRepository) walletBalance(wallet *Wallet) money.Money {
+	if wallet == nil {
+		return money.Money{}
 	}
+
+	return wallet.Balance
 }
 
 type ClientReaderGormRepository struct {
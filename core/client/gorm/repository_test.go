@@ -10,9 +10,11 @@ import (
 
 	setupdbtests "github.com/henriquerocha2004/quem-me-deve-api/config/setupDbTests"
 	"github.com/henriquerocha2004/quem-me-deve-api/core/client"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
 	ormdb "github.com/henriquerocha2004/quem-me-deve-api/core/shared/gorm"
 	"github.com/henriquerocha2004/quem-me-deve-api/pkg/document"
 	"github.com/henriquerocha2004/quem-me-deve-api/pkg/helpers"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
 	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
 	"github.com/joho/godotenv"
 	"github.com/oklog/ulid/v2"
@@ -22,6 +24,9 @@ import (
 
 var gormDB *orm.DB = nil
 
+// tenantCtx scopes the tests to a single tenant, as the requests are.
+var tenantCtx = shared.WithTenant(context.Background(), ulid.Make())
+
 func TestMain(m *testing.M) {
 	envPath := helpers.ProjetctRoot() + ".env.testing"
 	err := godotenv.Overload(envPath)
@@ -84,10 +89,10 @@ func (s *ClientRepositorySuiteTest) TestShouldCreateClient() {
 		BirthDay:   &now,
 	}
 
-	err := clientRepo.Create(context.Background(), client)
+	err := clientRepo.Create(tenantCtx, client)
 	s.NoError(err, "Expected no error when creating client")
 
-	cliDb, err := clientRepo.FindById(context.Background(), client.Id)
+	cliDb, err := clientRepo.FindById(tenantCtx, client.Id)
 	s.NoError(err, "Expected no error when finding client by ID")
 	s.NotNil(cliDb, "Expected client to be found")
 	s.Equal(client.Id, cliDb.Id, "Expected found client ID to match")
@@ -116,16 +121,16 @@ func (s *ClientRepositorySuiteTest) TestShouldUpdateClient() {
 		Phones: []client.Phone{},
 	}
 
-	err := clientRepo.Create(context.Background(), client)
+	err := clientRepo.Create(tenantCtx, client)
 	s.NoError(err, "Expected no error when creating client")
 
 	client.Name = "Jane"
 	client.LastName = "Smith"
 
-	err = clientRepo.Update(context.Background(), client)
+	err = clientRepo.Update(tenantCtx, client)
 	s.NoError(err, "Expected no error when updating client")
 
-	cliDb, err := clientRepo.FindById(context.Background(), client.Id)
+	cliDb, err := clientRepo.FindById(tenantCtx, client.Id)
 	s.NoError(err, "Expected no error when finding updated client by ID")
 	s.NotNil(cliDb, "Expected updated client to be found")
 	s.Equal("Jane", cliDb.Name, "Expected updated name to match")
@@ -144,13 +149,13 @@ func (s *ClientRepositorySuiteTest) TestShouldDeleteClient() {
 		BirthDay:   &now,
 	}
 
-	err := clientRepo.Create(context.Background(), client)
+	err := clientRepo.Create(tenantCtx, client)
 	s.NoError(err, "Expected no error when creating client")
 
-	err = clientRepo.Delete(context.Background(), client.Id)
+	err = clientRepo.Delete(tenantCtx, client.Id)
 	s.NoError(err, "Expected no error when deleting client")
 
-	cliDb, err := clientRepo.FindById(context.Background(), client.Id)
+	cliDb, err := clientRepo.FindById(tenantCtx, client.Id)
 	s.Error(err, "client not found")
 	s.Nil(cliDb)
 }
@@ -167,10 +172,10 @@ func (s *ClientRepositorySuiteTest) TestShouldFindByDocument() {
 		BirthDay:   &now,
 	}
 
-	err := clientRepo.Create(context.Background(), client)
+	err := clientRepo.Create(tenantCtx, client)
 	s.NoError(err, "Expected no error when creating client")
 
-	cliDb, err := clientRepo.FindByDocument(context.Background(), "61824136030")
+	cliDb, err := clientRepo.FindByDocument(tenantCtx, "61824136030")
 	s.NoError(err, "Expected no error when finding client by document")
 	s.NotNil(cliDb, "Expected client to be found")
 	s.Equal(client.Id, cliDb.Id, "Expected found client ID to match")
@@ -198,17 +203,17 @@ func (s *ClientRepositorySuiteTest) TestShouldFindAllClients() {
 		BirthDay:   &now,
 	}
 
-	err := clientRepo.Create(context.Background(), client1)
+	err := clientRepo.Create(tenantCtx, client1)
 	s.NoError(err, "Expected no error when creating client 1")
 
-	err = clientRepo.Create(context.Background(), client2)
+	err = clientRepo.Create(tenantCtx, client2)
 	s.NoError(err, "Expected no error when creating client 2")
 
 	searchDto := paginate.SearchDto{
 		Limit: 10,
 	}
 
-	clients, err := clientRepo.FindAll(context.Background(), searchDto)
+	clients, err := clientRepo.FindAll(tenantCtx, searchDto)
 	s.NoError(err, "Expected no error when finding all clients")
 	s.Len(clients.Data, 2, "Expected 2 clients to be found")
 }
@@ -220,7 +225,7 @@ func (s *ClientRepositorySuiteTest) TestShouldReturnEmptyWhenNoClients() {
 		Limit: 10,
 	}
 
-	clients, err := clientRepo.FindAll(context.Background(), searchDto)
+	clients, err := clientRepo.FindAll(tenantCtx, searchDto)
 	s.NoError(err, "Expected no error when finding all clients")
 	s.Len(clients.Data, 0, "Expected no clients to be found")
 }
@@ -238,10 +243,10 @@ func (s *ClientRepositorySuiteTest) TestShouldReturnClientById() {
 		BirthDay:   &now,
 	}
 
-	err := clientRepo.Create(context.Background(), client)
+	err := clientRepo.Create(tenantCtx, client)
 	s.NoError(err, "Expected no error when creating client")
 
-	cliDb, err := clientRepo.FindById(context.Background(), client.Id)
+	cliDb, err := clientRepo.FindById(tenantCtx, client.Id)
 	s.NoError(err, "Expected no error when finding client by ID")
 	s.NotNil(cliDb, "Expected client to be found")
 	s.Equal(client.Id, cliDb.Id, "Expected found client ID to match")
@@ -260,11 +265,102 @@ func (s *ClientRepositorySuiteTest) TestShouldInformIfClientExist() {
 		BirthDay:   &now,
 	}
 
-	err := clientRepo.Create(context.Background(), client)
+	err := clientRepo.Create(tenantCtx, client)
 	s.NoError(err, "Expected no error when creating client")
 
 	CliDebtRepo := NewClientReaderGormRepository(gormDB)
-	exist, err := CliDebtRepo.ClientExists(context.Background(), client.Id)
+	exist, err := CliDebtRepo.ClientExists(tenantCtx, client.Id)
 	s.NoError(err, "Expected no error when checking if client exists")
 	s.True(exist, "Expected client to exist")
 }
+
+func (s *ClientRepositorySuiteTest) TestShouldCreditAndDebitClientWallet() {
+	clientRepo := NewGormClientRepository(gormDB)
+	walletRepo := NewGormWalletRepository(gormDB)
+	now := time.Now()
+	cli := &client.Client{
+		Id:         ulid.Make(),
+		Name:       "John",
+		LastName:   "Doe",
+		EntityType: client.Individual,
+		Document:   document.Document("61824136030"),
+		BirthDay:   &now,
+	}
+
+	err := clientRepo.Create(tenantCtx, cli)
+	s.NoError(err)
+
+	err = walletRepo.Credit(tenantCtx, cli.Id, money.FromCents(5000), "overpayment")
+	s.NoError(err)
+
+	err = walletRepo.Debit(tenantCtx, cli.Id, money.FromCents(2000), "settlement")
+	s.NoError(err)
+
+	err = walletRepo.Debit(tenantCtx, cli.Id, money.FromCents(10000), "settlement")
+	s.EqualError(err, "insufficient credit balance")
+
+	balance, err := walletRepo.Balance(tenantCtx, cli.Id)
+	s.NoError(err)
+	s.Equal(money.FromCents(3000), balance)
+
+	cliDb, err := clientRepo.FindById(tenantCtx, cli.Id)
+	s.NoError(err)
+	s.Equal(money.FromCents(3000), cliDb.CreditBalance)
+}
+
+func (s *ClientRepositorySuiteTest) TestShouldIsolateClientsByTenant() {
+	clientRepo := NewGormClientRepository(gormDB)
+	walletRepo := NewGormWalletRepository(gormDB)
+	reader := NewClientReaderGormRepository(gormDB)
+	otherTenantCtx := shared.WithTenant(context.Background(), ulid.Make())
+	now := time.Now()
+	cli := &client.Client{
+		Id:         ulid.Make(),
+		Name:       "John",
+		LastName:   "Doe",
+		EntityType: client.Individual,
+		Document:   document.Document("61824136030"),
+		BirthDay:   &now,
+	}
+
+	err := clientRepo.Create(tenantCtx, cli)
+	s.NoError(err)
+
+	found, err := clientRepo.FindById(otherTenantCtx, cli.Id)
+	s.EqualError(err, "client not found")
+	s.Nil(found)
+
+	_, err = clientRepo.FindByDocument(otherTenantCtx, "61824136030")
+	s.EqualError(err, "client not found")
+
+	result, err := clientRepo.FindAll(otherTenantCtx, paginate.SearchDto{Limit: 10})
+	s.NoError(err)
+	s.Equal(0, result.TotalRecords)
+
+	exist, err := reader.ClientExists(otherTenantCtx, cli.Id)
+	s.NoError(err)
+	s.False(exist)
+
+	changed := *cli
+	changed.Name = "Jane"
+	err = clientRepo.Update(otherTenantCtx, &changed)
+	s.EqualError(err, "client not found")
+
+	err = clientRepo.Delete(otherTenantCtx, cli.Id)
+	s.NoError(err)
+
+	err = walletRepo.Credit(otherTenantCtx, cli.Id, money.FromCents(5000), "overpayment")
+	s.EqualError(err, "client not found")
+
+	cliDb, err := clientRepo.FindById(tenantCtx, cli.Id)
+	s.NoError(err)
+	s.Equal("John", cliDb.Name)
+	s.True(cliDb.CreditBalance.IsZero())
+}
+
+func (s *ClientRepositorySuiteTest) TestShouldRequireTenant() {
+	clientRepo := NewGormClientRepository(gormDB)
+
+	_, err := clientRepo.FindAll(context.Background(), paginate.SearchDto{Limit: 10})
+	s.ErrorIs(err, ormdb.ErrTenantRequired)
+}
@@ -0,0 +1,127 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/client"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GormWalletRepository struct {
+	db *gorm.DB
+}
+
+func NewGormWalletRepository(db *gorm.DB) *GormWalletRepository {
+	return &GormWalletRepository{db: db}
+}
+
+func (w *GormWalletRepository) Balance(ctx context.Context, clientId ulid.ULID) (money.Money, error) {
+	var model Wallet
+
+	result := w.db.WithContext(ctx).Where("client_id = ?", clientId.String()).First(&model)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return money.Money{}, nil
+		}
+		return money.Money{}, result.Error
+	}
+
+	return model.Balance, nil
+}
+
+func (w *GormWalletRepository) Credit(ctx context.Context, clientId ulid.ULID, amount money.Money, description string) error {
+	return w.apply(ctx, clientId, func(wallet *client.Wallet) error {
+		return wallet.Credit(amount, description)
+	})
+}
+
+func (w *GormWalletRepository) Debit(ctx context.Context, clientId ulid.ULID, amount money.Money, description string) error {
+	return w.apply(ctx, clientId, func(wallet *client.Wallet) error {
+		return wallet.Debit(amount, description)
+	})
+}
+
+// apply runs the operation over the client wallet in a transaction of its
+// own.
+func (w *GormWalletRepository) apply(ctx context.Context, clientId ulid.ULID, operation func(wallet *client.Wallet) error) error {
+	return w.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return ApplyToWallet(tx, clientId, operation)
+	})
+}
+
+// ApplyToWallet locks the client wallet row, runs the operation over the
+// domain wallet and stores the new balance along with the entries it
+// produced. It runs in the transaction given, so that the wallet changes
+// along with what caused them.
+func ApplyToWallet(tx *gorm.DB, clientId ulid.ULID, operation func(wallet *client.Wallet) error) error {
+	var clients int64
+	err := tx.Model(&Client{}).Where("id = ?", clientId.String()).Count(&clients).Error
+	if err != nil {
+		return err
+	}
+
+	// The wallet of a client from another tenant is never created nor
+	// changed.
+	if clients == 0 {
+		return errors.New("client not found")
+	}
+
+	model := Wallet{ClientID: clientId.String()}
+
+	err = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model).Error
+	if err != nil {
+		return err
+	}
+
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("client_id = ?", clientId.String()).
+		First(&model).Error
+	if err != nil {
+		return err
+	}
+
+	wallet := &client.Wallet{
+		ClientId: clientId,
+		Balance:  model.Balance,
+	}
+
+	if err := operation(wallet); err != nil {
+		return err
+	}
+
+	// The balance read under the lock is the one that counts: what was
+	// checked before the transaction may have been spent since.
+	if wallet.Balance.IsNegative() {
+		return client.ErrInsufficientCredit
+	}
+
+	err = tx.Model(&Wallet{}).
+		Where("client_id = ?", clientId.String()).
+		Updates(map[string]any{"balance": wallet.Balance, "updated_at": gorm.Expr("CURRENT_TIMESTAMP")}).Error
+	if err != nil {
+		return err
+	}
+
+	entries := convertEntriesToModel(wallet.Entries, clientId)
+
+	return tx.Create(&entries).Error
+}
+
+func convertEntriesToModel(entries []client.WalletEntry, clientId ulid.ULID) []WalletEntry {
+	var models []WalletEntry
+	for _, entry := range entries {
+		models = append(models, WalletEntry{
+			ID:          entry.Id.String(),
+			Type:        string(entry.Type),
+			Amount:      entry.Amount,
+			Description: entry.Description,
+			CreatedAt:   entry.CreatedAt,
+			ClientID:    clientId.String(),
+		})
+	}
+	return models
+}
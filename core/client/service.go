@@ -2,8 +2,11 @@ package client
 
 import (
 	"context"
+	"errors"
+	"log"
 	"time"
 
+	"github.com/henriquerocha2004/quem-me-deve-api/core/plan"
 	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
 	"github.com/henriquerocha2004/quem-me-deve-api/pkg/document"
 	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
@@ -20,6 +23,7 @@ type Service interface {
 
 type ClientService struct {
 	repository Repository
+	limits     plan.LimitChecker
 }
 
 func NewClientService(repository Repository) *ClientService {
@@ -28,7 +32,19 @@ func NewClientService(repository Repository) *ClientService {
 	}
 }
 
+// WithPlanLimits makes clients be created only while the account is below
+// the client limit of its plan.
+func (s *ClientService) WithPlanLimits(limits plan.LimitChecker) *ClientService {
+	s.limits = limits
+	return s
+}
+
 func (s *ClientService) Create(ctx context.Context, dto *ClientRequestDto) shared.ServiceResponse {
+	release, response, reached := s.reservePlanLimit(ctx)
+	if reached {
+		return response
+	}
+	defer release()
 
 	c, err := s.repository.FindByDocument(ctx, dto.Document)
 
@@ -55,6 +71,7 @@ func (s *ClientService) Create(ctx context.Context, dto *ClientRequestDto) share
 		EntityType: EntityType(dto.EntityType),
 		Document:   document.Document(dto.Document),
 		BirthDay:   &birth,
+		Email:      dto.Email,
 	}
 
 	err = client.validate()
@@ -101,6 +118,7 @@ func (s *ClientService) Update(ctx context.Context, id ulid.ULID, dto *ClientReq
 		EntityType: EntityType(dto.EntityType),
 		Document:   document.Document(dto.Document),
 		BirthDay:   &birth,
+		Email:      dto.Email,
 	}
 
 	err := client.validate()
@@ -216,6 +234,7 @@ func (s *ClientService) convertToClientDto(clients []*Client) []ClientRequestDto
 			BirthDay:   c.BirthDay.Format(time.DateOnly),
 			EntityType: string(c.EntityType),
 			Document:   string(c.Document),
+			Email:      c.Email,
 		}
 
 		if len(c.Addresses) >= 1 {
@@ -251,3 +270,30 @@ func (s *ClientService) convertToClientDto(clients []*Client) []ClientRequestDto
 
 	return clientsDto
 }
+
+// reservePlanLimit holds the plan usage until the returned release is
+// called, after the client is stored.
+func (s *ClientService) reservePlanLimit(ctx context.Context) (func(), shared.ServiceResponse, bool) {
+	if s.limits == nil {
+		return func() {}, shared.ServiceResponse{}, false
+	}
+
+	release, err := s.limits.ReserveLimit(ctx, plan.FeatureClient)
+	if err == nil {
+		return release, shared.ServiceResponse{}, false
+	}
+
+	if errors.Is(err, plan.ErrLimitReached) {
+		return nil, shared.ServiceResponse{
+			Status:  "error",
+			Message: err.Error(),
+			Code:    plan.LimitReachedCode,
+		}, true
+	}
+
+	log.Println("Error checking plan limit:", err)
+	return nil, shared.ServiceResponse{
+		Status:  "error",
+		Message: "error in create client",
+	}, true
+}
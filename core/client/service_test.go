@@ -7,6 +7,8 @@ import (
 
 	"github.com/henriquerocha2004/quem-me-deve-api/core/client"
 	"github.com/henriquerocha2004/quem-me-deve-api/core/client/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/plan"
+	planMocks "github.com/henriquerocha2004/quem-me-deve-api/core/plan/mocks"
 	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
 	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/assert"
@@ -51,6 +53,31 @@ func TestClientService(t *testing.T) {
 		assert.Equal(t, result.Status, "success")
 	})
 
+	t.Run("should not create client when the plan limit was reached", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cliRepo := mocks.NewMockRepository(ctrl)
+		cliRepo.EXPECT().FindByDocument(gomock.Any(), gomock.Any()).Times(0)
+		cliRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		limits := planMocks.NewMockLimitChecker(ctrl)
+		limits.EXPECT().ReserveLimit(gomock.Any(), plan.FeatureClient).Return(nil, plan.ErrLimitReached)
+
+		service := client.NewClientService(cliRepo).WithPlanLimits(limits)
+		result := service.Create(context.Background(), &client.ClientRequestDto{
+			Name:       "Nome",
+			LastName:   "Sobrenome",
+			BirthDay:   "2000-01-01",
+			EntityType: "PF",
+			Document:   "510.091.940-03",
+		})
+
+		assert.Equal(t, "error", result.Status)
+		assert.Equal(t, "plan limit reached", result.Message)
+		assert.Equal(t, plan.LimitReachedCode, result.Code)
+	})
+
 	t.Run("should not create client if already exists client", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"errors"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+)
+
+type WalletEntryType string
+
+const (
+	CreditEntry WalletEntryType = "credit"
+	DebitEntry  WalletEntryType = "debit"
+)
+
+// Wallet keeps the credit balance of a client, fed by overpaid installments
+// and spent when settling future installments.
+type Wallet struct {
+	ClientId ulid.ULID
+	Balance  money.Money
+	Entries  []WalletEntry
+}
+
+// ErrInsufficientCredit refuses a debit greater than the balance of the
+// wallet, which never goes negative.
+var ErrInsufficientCredit = errors.New("insufficient credit balance")
+
+type WalletEntry struct {
+	Id          ulid.ULID
+	Type        WalletEntryType
+	Amount      money.Money
+	Description string
+	CreatedAt   *time.Time
+}
+
+func (w *Wallet) Credit(amount money.Money, description string) error {
+	if !amount.IsPositive() {
+		return errors.New("credit amount must be greater than 0")
+	}
+
+	w.Balance = w.Balance.Add(amount)
+	w.addEntry(CreditEntry, amount, description)
+
+	return nil
+}
+
+func (w *Wallet) Debit(amount money.Money, description string) error {
+	if !amount.IsPositive() {
+		return errors.New("debit amount must be greater than 0")
+	}
+
+	if amount.GreaterThan(w.Balance) {
+		return ErrInsufficientCredit
+	}
+
+	w.Balance = w.Balance.Sub(amount)
+	w.addEntry(DebitEntry, amount, description)
+
+	return nil
+}
+
+func (w *Wallet) addEntry(entryType WalletEntryType, amount money.Money, description string) {
+	now := time.Now()
+
+	w.Entries = append(w.Entries, WalletEntry{
+		Id:          ulid.Make(),
+		Type:        entryType,
+		Amount:      amount,
+		Description: description,
+		CreatedAt:   &now,
+	})
+}
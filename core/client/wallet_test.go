@@ -0,0 +1,44 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldCreditWallet(t *testing.T) {
+	wallet := Wallet{ClientId: ulid.Make()}
+
+	err := wallet.Credit(money.MustParse("10.50"), "overpayment")
+	assert.NoError(t, err)
+	assert.Equal(t, money.MustParse("10.50"), wallet.Balance)
+	assert.Len(t, wallet.Entries, 1)
+	assert.Equal(t, CreditEntry, wallet.Entries[0].Type)
+}
+
+func TestShouldDebitWallet(t *testing.T) {
+	wallet := Wallet{ClientId: ulid.Make(), Balance: money.FromCents(3000)}
+
+	err := wallet.Debit(money.MustParse("12.30"), "settlement")
+	assert.NoError(t, err)
+	assert.Equal(t, money.MustParse("17.70"), wallet.Balance)
+	assert.Equal(t, DebitEntry, wallet.Entries[0].Type)
+}
+
+func TestShouldNotDebitMoreThanWalletBalance(t *testing.T) {
+	wallet := Wallet{ClientId: ulid.Make(), Balance: money.FromCents(1000)}
+
+	err := wallet.Debit(money.MustParse("10.01"), "settlement")
+	assert.EqualError(t, err, "insufficient credit balance")
+	assert.Equal(t, money.FromCents(1000), wallet.Balance)
+	assert.Empty(t, wallet.Entries)
+}
+
+func TestShouldNotCreditInvalidAmount(t *testing.T) {
+	wallet := Wallet{ClientId: ulid.Make()}
+
+	err := wallet.Credit(money.Money{}, "overpayment")
+	assert.EqualError(t, err, "credit amount must be greater than 0")
+}
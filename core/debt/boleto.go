@@ -0,0 +1,82 @@
+package debt
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/client"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/boleto"
+)
+
+// Size in pixels of the barcode images of the boletos: the width of the
+// narrow bars and the height of the bars.
+const (
+	BoletoBarcodeScale  = 2
+	BoletoBarcodeHeight = 100
+)
+
+// boletoDueDate is the due date printed on the boleto of the installment.
+// Overdue installments are charged with their balance of the day, so their
+// boletos are due the same day.
+func boletoDueDate(installment *Installment, now time.Time) time.Time {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if installment.DueDate == nil {
+		return today
+	}
+
+	dueDate := time.Date(installment.DueDate.Year(), installment.DueDate.Month(), installment.DueDate.Day(), 0, 0, 0, 0, time.UTC)
+	if dueDate.Before(today) {
+		return today
+	}
+
+	return dueDate
+}
+
+// boletoInstructions tells the bank the late charges of the debt.
+func boletoInstructions(debt *Debt, installment *Installment) []string {
+	instructions := []string{
+		fmt.Sprintf("Parcela %d de %d - %s", installment.Number, len(debt.Intallments), debt.Description),
+	}
+
+	policy := debt.ChargePolicy
+	if policy.FinePercentage > 0 {
+		instructions = append(instructions, fmt.Sprintf("Após o vencimento, cobrar multa de %s%%", percentage(policy.FinePercentage)))
+	}
+
+	if policy.InterestRate > 0 {
+		period := "ao mês"
+		if policy.InterestPeriod == DailyInterest {
+			period = "ao dia"
+		}
+		instructions = append(instructions, fmt.Sprintf("Após o vencimento, cobrar juros de mora de %s%% %s", percentage(policy.InterestRate), period))
+	}
+
+	return instructions
+}
+
+// boletoPayer writes the client as the payer of the boleto, at its first
+// address.
+func boletoPayer(c *client.Client) boleto.Payer {
+	payer := boleto.Payer{
+		Name:     strings.TrimSpace(c.Name + " " + c.LastName),
+		Document: string(c.Document),
+	}
+
+	if len(c.Addresses) > 0 {
+		address := c.Addresses[0]
+		payer.Address = boleto.Address{
+			Street:       address.Street,
+			Neighborhood: address.Neighborhood,
+			City:         address.City,
+			State:        address.State,
+			ZipCode:      address.ZipCode,
+		}
+	}
+
+	return payer
+}
+
+func percentage(value float64) string {
+	return strings.Replace(fmt.Sprintf("%.2f", value), ".", ",", 1)
+}
@@ -0,0 +1,116 @@
+package debt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+)
+
+type interestPeriod int
+
+const (
+	MonthlyInterest interestPeriod = iota
+	DailyInterest
+)
+
+var interestPeriodString = map[interestPeriod]string{
+	MonthlyInterest: "monthly",
+	DailyInterest:   "daily",
+}
+
+var InterestPeriodValue = map[string]interestPeriod{
+	"monthly": MonthlyInterest,
+	"daily":   DailyInterest,
+}
+
+func (p interestPeriod) String() string {
+	if int(p) >= 0 && int(p) < len(interestPeriodString) {
+		return interestPeriodString[p]
+	}
+
+	return "unknown"
+}
+
+func (p *interestPeriod) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return err
+	}
+
+	if val, ok := InterestPeriodValue[str]; ok {
+		*p = val
+		return nil
+	}
+
+	return fmt.Errorf("invalid interest period: %s", str)
+}
+
+// ChargePolicy holds the late charges applied to an overdue installment:
+// a one-off fine (multa) over the installment value and simple interest
+// (juros de mora) for each day or month past the due date.
+type ChargePolicy struct {
+	FinePercentage float64
+	InterestRate   float64
+	InterestPeriod interestPeriod
+}
+
+// ChargeBreakdown is the amount owed for an installment at a reference date.
+type ChargeBreakdown struct {
+	Principal money.Money
+	Fine      money.Money
+	Interest  money.Money
+	DaysLate  int
+}
+
+func (c ChargeBreakdown) Total() money.Money {
+	return c.Principal.Add(c.Fine).Add(c.Interest)
+}
+
+func (p ChargePolicy) Validate() error {
+	if p.FinePercentage < 0 {
+		return errors.New("finePercentage must not be negative")
+	}
+
+	if p.InterestRate < 0 {
+		return errors.New("interestRate must not be negative")
+	}
+
+	return nil
+}
+
+// Calculate returns the updated amount of an installment with the given value
+// and due date, as it stands at the reference date. Interest is computed pro
+// rata die, so a monthly rate is spread over 30 days.
+func (p ChargePolicy) Calculate(value money.Money, dueDate *time.Time, ref time.Time) ChargeBreakdown {
+	charges := ChargeBreakdown{Principal: value}
+
+	daysLate := daysBetween(dueDate, ref)
+	if daysLate <= 0 {
+		return charges
+	}
+
+	dailyRate := p.InterestRate
+	if p.InterestPeriod == MonthlyInterest {
+		dailyRate = p.InterestRate / 30
+	}
+
+	charges.DaysLate = daysLate
+	charges.Fine = value.Percent(p.FinePercentage)
+	charges.Interest = value.Percent(dailyRate * float64(daysLate))
+
+	return charges
+}
+
+func daysBetween(dueDate *time.Time, ref time.Time) int {
+	if dueDate == nil {
+		return 0
+	}
+
+	due := time.Date(dueDate.Year(), dueDate.Month(), dueDate.Day(), 0, 0, 0, 0, time.UTC)
+	day := time.Date(ref.Year(), ref.Month(), ref.Day(), 0, 0, 0, 0, time.UTC)
+
+	return int(day.Sub(due).Hours() / 24)
+}
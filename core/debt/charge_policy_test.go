@@ -0,0 +1,68 @@
+package debt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChargePolicy(t *testing.T) {
+	t.Run("Nao deve cobrar encargos de uma parcela em dia", func(t *testing.T) {
+		dueDate := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+		policy := debt.ChargePolicy{
+			FinePercentage: 2,
+			InterestRate:   1,
+			InterestPeriod: debt.MonthlyInterest,
+		}
+
+		charges := policy.Calculate(money.FromCents(10000), &dueDate, dueDate.Add(15*time.Hour))
+
+		assert.Equal(t, 0, charges.DaysLate)
+		assert.True(t, charges.Fine.IsZero())
+		assert.True(t, charges.Interest.IsZero())
+		assert.Equal(t, money.FromCents(10000), charges.Total())
+	})
+
+	t.Run("Deve calcular multa e juros mensais pro rata die", func(t *testing.T) {
+		dueDate := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+		policy := debt.ChargePolicy{
+			FinePercentage: 2,
+			InterestRate:   1,
+			InterestPeriod: debt.MonthlyInterest,
+		}
+
+		charges := policy.Calculate(money.FromCents(30000), &dueDate, dueDate.AddDate(0, 0, 40))
+
+		assert.Equal(t, 40, charges.DaysLate)
+		assert.Equal(t, money.FromCents(30000), charges.Principal)
+		assert.Equal(t, money.FromCents(600), charges.Fine)
+		assert.Equal(t, money.FromCents(400), charges.Interest)
+		assert.Equal(t, money.FromCents(31000), charges.Total())
+	})
+
+	t.Run("Deve calcular juros diarios", func(t *testing.T) {
+		dueDate := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+		policy := debt.ChargePolicy{
+			FinePercentage: 0,
+			InterestRate:   0.5,
+			InterestPeriod: debt.DailyInterest,
+		}
+
+		charges := policy.Calculate(money.FromCents(20000), &dueDate, dueDate.AddDate(0, 0, 3))
+
+		assert.True(t, charges.Fine.IsZero())
+		assert.Equal(t, money.FromCents(300), charges.Interest)
+		assert.Equal(t, money.FromCents(20300), charges.Total())
+	})
+
+	t.Run("Deve retornar erro para encargos negativos", func(t *testing.T) {
+		policy := debt.ChargePolicy{FinePercentage: -1}
+		assert.EqualError(t, policy.Validate(), "finePercentage must not be negative")
+
+		policy = debt.ChargePolicy{InterestRate: -1}
+		assert.EqualError(t, policy.Validate(), "interestRate must not be negative")
+	})
+}
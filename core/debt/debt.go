@@ -4,6 +4,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
 	"github.com/henriquerocha2004/quem-me-deve-api/pkg/validateErrors"
 	"github.com/oklog/ulid/v2"
 )
@@ -11,13 +12,57 @@ import (
 type Installment struct {
 	Id            ulid.ULID
 	Description   string
-	Value         float64
+	Value         money.Money
 	DueDate       *time.Time
 	DebDate       *time.Time
 	Status        status
 	PaymentDate   *time.Time
 	PaymentMethod string
 	Number        int
+	Charges       ChargeBreakdown
+	Payments      []Payment
+}
+
+// CreditPaymentMethod identifies payments settled from the client credit wallet.
+const CreditPaymentMethod = "credit"
+
+// PixPaymentMethod identifies payments received by Pix.
+const PixPaymentMethod = "pix"
+
+// Payment is a single entry of an installment payment ledger. An installment
+// may receive several payments until their sum covers the amount owed.
+type Payment struct {
+	Id          ulid.ULID
+	Amount      money.Money
+	Method      string
+	PaymentDate *time.Time
+	// Reference identifies the payment at its origin, as the end to end id
+	// of a Pix, so that it is never registered twice.
+	Reference string
+}
+
+// IsOpen reports whether the installment still waits for payment.
+func (i *Installment) IsOpen() bool {
+	return i.Status == Pending || i.Status == PartiallyPaid || i.Status == Overdue
+}
+
+// IsOverdue reports whether the installment is still open after its due
+// date, as of the reference date.
+func (i *Installment) IsOverdue(ref time.Time) bool {
+	return i.IsOpen() && daysBetween(i.DueDate, ref) > 0
+}
+
+func (i *Installment) hasPayments() bool {
+	return i.Status == Paid || i.Status == PartiallyPaid || len(i.Payments) > 0
+}
+
+func (i *Installment) PaidAmount() money.Money {
+	total := money.Money{}
+	for _, payment := range i.Payments {
+		total = total.Add(payment.Amount)
+	}
+
+	return total
 }
 
 type CancelInfo struct {
@@ -34,21 +79,69 @@ type ReversalInfo struct {
 	CancelledInstallmentQtd int
 }
 
+// OverdueInstallment is an installment open after its due date, along with
+// the debt and the client it belongs to.
+type OverdueInstallment struct {
+	Installment
+	DebtId       ulid.ULID
+	UserClientId ulid.ULID
+}
+
+// TenantInstallment is an open installment found by the jobs across every
+// tenant, along with the debt, the client and the tenant it belongs to.
+type TenantInstallment struct {
+	Installment
+	DebtId       ulid.ULID
+	UserClientId ulid.ULID
+	TenantId     ulid.ULID
+}
+
+// PlannedInstallment is an installment informed by the operator, used
+// instead of splitting the total value evenly.
+type PlannedInstallment struct {
+	Value   money.Money
+	DueDate time.Time
+}
+
+// DownPayment is the part of the total value paid at the moment of the sale
+// (entrada). It is registered as installment number 0, already paid.
+type DownPayment struct {
+	Amount        money.Money
+	PaymentMethod string
+}
+
+// RenegotiationInfo is kept on the original debt and points to the debt
+// that replaced its open installments.
+type RenegotiationInfo struct {
+	RenegotiatedDebtId ulid.ULID
+	OpenBalance        money.Money
+	Fees               money.Money
+	Discount           money.Money
+	RenegotiationDate  *time.Time
+	RenegotiatedBy     ulid.ULID
+}
+
 type Debt struct {
 	Id                   ulid.ULID
 	Description          string
-	TotalValue           float64
+	TotalValue           money.Money
 	DueDate              *time.Time
 	InstallmentsQuantity int
 	DebtDate             *time.Time
 	Status               status
 	UserClientId         ulid.ULID
-	ProductIds           []ulid.ULID
-	ServiceIds           []ulid.ULID
+	Items                []DebtItem
+	StockMovements       []StockMovement
 	Intallments          []Installment
 	CancelInfo           *CancelInfo
 	ReversalInfo         *ReversalInfo
 	FinishedAt           *time.Time
+	ChargePolicy         ChargePolicy
+	Schedule             Schedule
+	DownPayment          *DownPayment
+	PlannedInstallments  []PlannedInstallment
+	RenegotiationInfo    *RenegotiationInfo
+	OriginDebtId         *ulid.ULID
 }
 
 func (d *Debt) Validate() validateErrors.ValidationErrors {
@@ -81,11 +174,39 @@ func (d *Debt) Validate() validateErrors.ValidationErrors {
 		})
 	}
 
+	if err := d.ChargePolicy.Validate(); err != nil {
+		validationErrors.Errors = append(validationErrors.Errors, validateErrors.ValidationError{
+			Field:   "chargePolicy",
+			Message: err.Error(),
+		})
+	}
+
+	if err := d.Schedule.Validate(); err != nil {
+		validationErrors.Errors = append(validationErrors.Errors, validateErrors.ValidationError{
+			Field:   "schedule",
+			Message: err.Error(),
+		})
+	}
+
+	if err := d.ValidateDownPayment(); err != nil {
+		validationErrors.Errors = append(validationErrors.Errors, validateErrors.ValidationError{
+			Field:   "downPayment",
+			Message: err.Error(),
+		})
+	}
+
+	if err := d.ValidatePlannedInstallments(); err != nil {
+		validationErrors.Errors = append(validationErrors.Errors, validateErrors.ValidationError{
+			Field:   "installments",
+			Message: err.Error(),
+		})
+	}
+
 	return validationErrors
 }
 
 func (d *Debt) ValidateTotalValue() error {
-	if d.TotalValue > 0 {
+	if d.TotalValue.IsPositive() {
 		return nil
 	}
 
@@ -110,14 +231,79 @@ func (d *Debt) ValidateDueDate() error {
 	return nil
 }
 
+func (d *Debt) ValidateDownPayment() error {
+	if d.DownPayment == nil {
+		return nil
+	}
+
+	if !d.DownPayment.Amount.IsPositive() {
+		return errors.New("downPayment must be greater than 0")
+	}
+
+	if !d.DownPayment.Amount.LessThan(d.TotalValue) {
+		return errors.New("downPayment must be lower than totalValue")
+	}
+
+	if d.DownPayment.PaymentMethod == "" {
+		return errors.New("downPayment payment method is required")
+	}
+
+	return nil
+}
+
+// ValidatePlannedInstallments checks that the informed installments plus
+// the down payment add up exactly to the total value of the debt.
+func (d *Debt) ValidatePlannedInstallments() error {
+	if len(d.PlannedInstallments) == 0 {
+		return nil
+	}
+
+	total := money.Money{}
+	if d.DownPayment != nil {
+		total = total.Add(d.DownPayment.Amount)
+	}
+
+	for _, planned := range d.PlannedInstallments {
+		if !planned.Value.IsPositive() {
+			return errors.New("installment value must be greater than 0")
+		}
+
+		total = total.Add(planned.Value)
+	}
+
+	if !total.Equal(d.TotalValue) {
+		return errors.New("installments and down payment must sum to totalValue")
+	}
+
+	return nil
+}
+
 func (d *Debt) ValidateServiceOrProduct() error {
-	if len(d.ProductIds) == 0 && len(d.ServiceIds) == 0 {
-		return errors.New("at least one productId or serviceId is required")
+	if len(d.Items) == 0 {
+		return errors.New("at least one product or service item is required")
+	}
+
+	for _, item := range d.Items {
+		if err := item.Validate(); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// CalculateTotalValue derives the total value of the debt from its items.
+func (d *Debt) CalculateTotalValue() {
+	total := money.Money{}
+
+	for i := range d.Items {
+		d.Items[i].CalculateTotal()
+		total = total.Add(d.Items[i].Total)
+	}
+
+	d.TotalValue = total
+}
+
 func (d *Debt) ValidateClientId() error {
 	if d.UserClientId == (ulid.ULID{}) {
 		return errors.New("userClientId is required")
@@ -127,32 +313,75 @@ func (d *Debt) ValidateClientId() error {
 }
 
 func (d *Debt) GenerateInstallments() error {
+	now := time.Now()
+	remaining := d.TotalValue
+
+	if d.DownPayment != nil {
+		d.Intallments = append(d.Intallments, Installment{
+			Id:            ulid.Make(),
+			Description:   d.Description,
+			Value:         d.DownPayment.Amount,
+			DueDate:       &now,
+			DebDate:       &now,
+			Status:        Paid,
+			PaymentDate:   &now,
+			PaymentMethod: d.DownPayment.PaymentMethod,
+			Number:        0,
+			Payments: []Payment{
+				{
+					Id:          ulid.Make(),
+					Amount:      d.DownPayment.Amount,
+					Method:      d.DownPayment.PaymentMethod,
+					PaymentDate: &now,
+				},
+			},
+		})
+
+		remaining = remaining.Sub(d.DownPayment.Amount)
+	}
+
+	if len(d.PlannedInstallments) > 0 {
+		d.InstallmentsQuantity = len(d.PlannedInstallments)
+
+		for i, planned := range d.PlannedInstallments {
+			dueDate := planned.DueDate
+
+			d.Intallments = append(d.Intallments, Installment{
+				Id:          ulid.Make(),
+				Description: d.Description,
+				Value:       planned.Value,
+				DueDate:     &dueDate,
+				DebDate:     &now,
+				Status:      Pending,
+				Number:      i + 1,
+			})
+		}
+
+		return nil
+	}
+
 	if d.InstallmentsQuantity <= 0 {
 		d.InstallmentsQuantity = 1
 	}
 
-	now := time.Now()
-	currentDueDate := d.DueDate
+	values := remaining.Split(d.InstallmentsQuantity)
 
-	baseValue := d.TotalValue / float64(d.InstallmentsQuantity)
-	totalAllocated := 0.0
+	var dueDates []time.Time
+	if d.DueDate != nil {
+		dueDates = d.Schedule.DueDates(*d.DueDate, d.InstallmentsQuantity)
+	}
 
 	for i := range d.InstallmentsQuantity {
-		if i > 0 {
-			futureDate := currentDueDate.AddDate(0, 0, 30)
-			currentDueDate = &futureDate
-		}
-
-		value := baseValue
-		if i == d.InstallmentsQuantity-1 {
-			value = d.TotalValue - totalAllocated
+		var dueDate *time.Time
+		if dueDates != nil {
+			dueDate = &dueDates[i]
 		}
 
 		installment := Installment{
 			Id:            ulid.Make(),
 			Description:   d.Description,
-			Value:         value,
-			DueDate:       currentDueDate,
+			Value:         values[i],
+			DueDate:       dueDate,
 			DebDate:       &now,
 			Status:        Pending,
 			PaymentDate:   nil,
@@ -161,16 +390,15 @@ func (d *Debt) GenerateInstallments() error {
 		}
 
 		d.Intallments = append(d.Intallments, installment)
-		totalAllocated += value
 	}
 
 	return nil
 }
 
 func (d *Debt) PayInstallment(payInfo *PaymentInfoDto) error {
-	now := time.Now()
+	now := payInfo.PaymentDate()
 
-	if d.Status != Pending {
+	if !d.isOpen() {
 		return errors.New("debt is not in pending status")
 	}
 
@@ -183,17 +411,39 @@ func (d *Debt) PayInstallment(payInfo *PaymentInfoDto) error {
 
 		installmentExists = true
 
-		if installment.Status != Pending {
+		if !installment.IsOpen() {
 			return errors.New("installment is not in pending status")
 		}
 
-		if payInfo.Amount < installment.Value {
-			return errors.New("amount does not match the installment value")
+		if !payInfo.Amount.IsPositive() {
+			return errors.New("amount must be greater than 0")
 		}
 
-		installment.Status = Paid
+		charges := d.ChargePolicy.Calculate(installment.Value, installment.DueDate, now)
+		balance := charges.Total().Sub(installment.PaidAmount())
+
+		installment.Payments = append(installment.Payments, Payment{
+			Id:          ulid.Make(),
+			Amount:      payInfo.Amount,
+			Method:      payInfo.PaymentMethod,
+			PaymentDate: &now,
+			Reference:   payInfo.Reference,
+		})
+
+		installment.Charges = charges
 		installment.PaymentDate = &now
 		installment.PaymentMethod = payInfo.PaymentMethod
+		installment.Status = PartiallyPaid
+
+		// A partial payment after the due date does not clear the delay:
+		// the installment, and so the debt, stays overdue.
+		if daysBetween(installment.DueDate, now) > 0 {
+			installment.Status = Overdue
+		}
+
+		if !payInfo.Amount.LessThan(balance) {
+			installment.Status = Paid
+		}
 
 		d.Intallments[i] = installment
 		break
@@ -208,8 +458,56 @@ func (d *Debt) PayInstallment(payInfo *PaymentInfoDto) error {
 	return nil
 }
 
+// HasPayment reports whether a payment with the reference was already
+// registered on any installment of the debt.
+func (d *Debt) HasPayment(reference string) bool {
+	for _, installment := range d.Intallments {
+		for _, payment := range installment.Payments {
+			if payment.Reference == reference {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// InstallmentCharges returns the amount owed for an installment at the
+// reference date, including fine and interest when it is overdue.
+func (d *Debt) InstallmentCharges(installmentId string, ref time.Time) (ChargeBreakdown, error) {
+	for _, installment := range d.Intallments {
+		if installment.Id.String() != installmentId {
+			continue
+		}
+
+		return d.ChargePolicy.Calculate(installment.Value, installment.DueDate, ref), nil
+	}
+
+	return ChargeBreakdown{}, errors.New("installment not found")
+}
+
+// InstallmentBalance returns how much is still owed on an installment at the
+// reference date, discounting the payments already registered.
+func (d *Debt) InstallmentBalance(installmentId string, ref time.Time) (money.Money, error) {
+	for _, installment := range d.Intallments {
+		if installment.Id.String() != installmentId {
+			continue
+		}
+
+		charges := d.ChargePolicy.Calculate(installment.Value, installment.DueDate, ref)
+		balance := charges.Total().Sub(installment.PaidAmount())
+		if balance.IsNegative() {
+			return money.Money{}, nil
+		}
+
+		return balance, nil
+	}
+
+	return money.Money{}, errors.New("installment not found")
+}
+
 func (d *Debt) Cancel(cancelInfo *CancelInfoDto) error {
-	if d.Status != Pending {
+	if !d.isOpen() {
 		return errors.New("debt is not in pending status")
 	}
 
@@ -231,6 +529,8 @@ func (d *Debt) Cancel(cancelInfo *CancelInfoDto) error {
 		d.Intallments[i].Status = Canceled
 	}
 
+	d.restoreStock()
+
 	return nil
 }
 
@@ -239,6 +539,10 @@ func (d *Debt) Reverse(reversalInfo *ReversalInfoDto) error {
 		return errors.New("debt is already canceled or reversed")
 	}
 
+	if d.Status == Renegotiated {
+		return errors.New("debt has been renegotiated")
+	}
+
 	if d.ReversalInfo != nil {
 		return errors.New("debt has already been reversed")
 	}
@@ -251,7 +555,7 @@ func (d *Debt) Reverse(reversalInfo *ReversalInfoDto) error {
 	qtdInstallmentsCanceled := 0
 
 	for i := range d.Intallments {
-		if d.Intallments[i].Status == Paid {
+		if d.Intallments[i].hasPayments() {
 			d.Intallments[i].Status = Reversed
 			qtdInstallmentsReversed++
 			continue
@@ -269,20 +573,131 @@ func (d *Debt) Reverse(reversalInfo *ReversalInfoDto) error {
 		CancelledInstallmentQtd: qtdInstallmentsCanceled,
 	}
 
+	d.restoreStock()
+
 	return nil
 }
 
+// Renegotiate closes the open installments of the debt and returns a new
+// debt, linked to this one, for their balance plus fees minus discount.
+// The new debt carries no items: what was sold stays on the original one.
+func (d *Debt) Renegotiate(renegotiation *RenegotiationDto) (*Debt, error) {
+	if !d.isOpen() {
+		return nil, errors.New("debt is not in pending status")
+	}
+
+	if renegotiation.Fees.IsNegative() {
+		return nil, errors.New("fees must not be negative")
+	}
+
+	if renegotiation.Discount.IsNegative() {
+		return nil, errors.New("discount must not be negative")
+	}
+
+	firstDueDate, err := time.Parse(time.DateOnly, renegotiation.FirstDueDate)
+	if err != nil {
+		return nil, errors.New("invalid first due date")
+	}
+
+	now := time.Now()
+	originDebtId := d.Id
+	openBalance := money.Money{}
+
+	for _, installment := range d.Intallments {
+		if !installment.IsOpen() {
+			continue
+		}
+
+		charges := d.ChargePolicy.Calculate(installment.Value, installment.DueDate, now)
+		balance := charges.Total().Sub(installment.PaidAmount())
+		if balance.IsPositive() {
+			openBalance = openBalance.Add(balance)
+		}
+	}
+
+	if !openBalance.IsPositive() {
+		return nil, errors.New("debt has no open balance to renegotiate")
+	}
+
+	totalValue := openBalance.Add(renegotiation.Fees).Sub(renegotiation.Discount)
+	if !totalValue.IsPositive() {
+		return nil, errors.New("discount must be lower than the open balance")
+	}
+
+	if renegotiation.InstallmentsQuantity <= 0 {
+		return nil, errors.New("installmentsQuantity must be greater than 0")
+	}
+
+	renegotiated := &Debt{
+		Id:                   ulid.Make(),
+		Description:          d.Description,
+		TotalValue:           totalValue,
+		DueDate:              &firstDueDate,
+		InstallmentsQuantity: renegotiation.InstallmentsQuantity,
+		DebtDate:             &now,
+		Status:               Pending,
+		UserClientId:         d.UserClientId,
+		ChargePolicy:         d.ChargePolicy,
+		Schedule:             d.Schedule,
+		OriginDebtId:         &originDebtId,
+	}
+
+	if err := renegotiated.ValidateDueDate(); err != nil {
+		return nil, err
+	}
+
+	if err := renegotiated.GenerateInstallments(); err != nil {
+		return nil, err
+	}
+
+	for i, installment := range d.Intallments {
+		if !installment.IsOpen() {
+			continue
+		}
+
+		d.Intallments[i].Charges = d.ChargePolicy.Calculate(installment.Value, installment.DueDate, now)
+		d.Intallments[i].Status = Renegotiated
+	}
+
+	d.Status = Renegotiated
+	d.FinishedAt = &now
+	d.RenegotiationInfo = &RenegotiationInfo{
+		RenegotiatedDebtId: renegotiated.Id,
+		OpenBalance:        openBalance,
+		Fees:               renegotiation.Fees,
+		Discount:           renegotiation.Discount,
+		RenegotiationDate:  &now,
+		RenegotiatedBy:     renegotiation.RenegotiatedBy,
+	}
+
+	return renegotiated, nil
+}
+
+// isOpen reports whether the debt still waits for payment. An overdue debt
+// is still open: it can be paid, canceled or renegotiated as a pending one.
+func (d *Debt) isOpen() bool {
+	return d.Status == Pending || d.Status == Overdue
+}
+
 func (d *Debt) updateDebtStatus() {
 	allPaid := true
+	anyOverdue := false
 
 	for _, installment := range d.Intallments {
 		if installment.Status != Paid {
 			allPaid = false
-			break
+		}
+
+		if installment.Status == Overdue {
+			anyOverdue = true
 		}
 	}
 
 	if !allPaid {
+		d.Status = Pending
+		if anyOverdue {
+			d.Status = Overdue
+		}
 		return
 	}
 
@@ -293,7 +708,7 @@ func (d *Debt) updateDebtStatus() {
 
 func (d *Debt) hasInstallmentPaid() bool {
 	for _, installment := range d.Intallments {
-		if installment.Status == Paid {
+		if installment.hasPayments() {
 			return true
 		}
 	}
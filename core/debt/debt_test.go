@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
 	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/assert"
 )
@@ -14,7 +15,7 @@ func TestDebtTests(t *testing.T) {
 		// Arrange
 		d := &debt.Debt{
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              nil,
 			InstallmentsQuantity: 12,
 			DebtDate:             nil,
@@ -24,14 +25,14 @@ func TestDebtTests(t *testing.T) {
 
 		assert.NotNil(t, d)
 		assert.Equal(t, "Test Debt", d.Description)
-		assert.Equal(t, float64(1000), d.TotalValue)
+		assert.Equal(t, money.FromCents(100000), d.TotalValue)
 	})
 
 	t.Run("Should validate Debt with totalValue <= 0", func(t *testing.T) {
 		// Arrange
 		d := &debt.Debt{
 			Description:          "Test Debt",
-			TotalValue:           -1000,
+			TotalValue:           money.FromCents(-100000),
 			DueDate:              nil,
 			InstallmentsQuantity: 12,
 			DebtDate:             nil,
@@ -52,7 +53,7 @@ func TestDebtTests(t *testing.T) {
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              nil,
 			InstallmentsQuantity: 12,
 			DebtDate:             nil,
@@ -76,7 +77,7 @@ func TestDebtTests(t *testing.T) {
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              &dueDate,
 			InstallmentsQuantity: 12,
 			DebtDate:             nil,
@@ -96,21 +97,19 @@ func TestDebtTests(t *testing.T) {
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              nil,
 			InstallmentsQuantity: 12,
 			DebtDate:             nil,
 			Status:               debt.Pending,
 			UserClientId:         ulid.Make(),
-			ProductIds:           []ulid.ULID{},
-			ServiceIds:           []ulid.ULID{},
 		}
 
 		// Act
 		err := d.ValidateServiceOrProduct()
 
 		assert.NotNil(t, err)
-		assert.Equal(t, "at least one productId or serviceId is required", err.Error())
+		assert.Equal(t, "at least one product or service item is required", err.Error())
 	})
 
 	t.Run("Should validate debt when not provided userClientId", func(t *testing.T) {
@@ -118,14 +117,12 @@ func TestDebtTests(t *testing.T) {
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              nil,
 			InstallmentsQuantity: 12,
 			DebtDate:             nil,
 			Status:               debt.Pending,
 			UserClientId:         ulid.ULID{},
-			ProductIds:           []ulid.ULID{},
-			ServiceIds:           []ulid.ULID{},
 		}
 
 		// Act
@@ -144,14 +141,13 @@ func TestDebtTests(t *testing.T) {
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              &dueDate,
 			InstallmentsQuantity: 12,
 			DebtDate:             nil,
 			Status:               debt.Pending,
 			UserClientId:         ulid.Make(),
-			ProductIds:           []ulid.ULID{ulid.Make()},
-			ServiceIds:           []ulid.ULID{},
+			Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 		}
 
 		// Act
@@ -170,14 +166,12 @@ func TestDebtTests(t *testing.T) {
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              &dueDate,
 			InstallmentsQuantity: 12,
 			DebtDate:             nil,
 			Status:               debt.Pending,
 			UserClientId:         ulid.Make(),
-			ProductIds:           []ulid.ULID{},
-			ServiceIds:           []ulid.ULID{},
 			Intallments:          []debt.Installment{},
 		}
 
@@ -186,15 +180,17 @@ func TestDebtTests(t *testing.T) {
 		assert.NotNil(t, d)
 		assert.Len(t, d.Intallments, 12)
 
-		var value float64
+		var value money.Money
 
 		for _, installment := range d.Intallments {
 			assert.NotNil(t, installment)
 			assert.Equal(t, debt.Pending, installment.Status)
-			value += installment.Value
+			value = value.Add(installment.Value)
 		}
 
 		assert.Equal(t, d.TotalValue, value)
+		assert.Equal(t, money.MustParse("83.33"), d.Intallments[0].Value)
+		assert.Equal(t, money.MustParse("83.37"), d.Intallments[11].Value)
 	})
 
 	t.Run("Deve realizar o pagamento de uma parcela", func(t *testing.T) {
@@ -205,14 +201,13 @@ func TestDebtTests(t *testing.T) {
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              &dueDate,
 			InstallmentsQuantity: 2,
 			DebtDate:             nil,
 			Status:               debt.Pending,
 			UserClientId:         ulid.Make(),
-			ProductIds:           []ulid.ULID{ulid.Make()},
-			ServiceIds:           []ulid.ULID{},
+			Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 			Intallments:          []debt.Installment{},
 		}
 
@@ -223,7 +218,7 @@ func TestDebtTests(t *testing.T) {
 		paymentInfo := &debt.PaymentInfoDto{
 			DebtId:        d.Id.String(),
 			InstallmentId: installmentId,
-			Amount:        500,
+			Amount:        money.FromCents(50000),
 			PaymentMethod: "credit_card",
 		}
 
@@ -242,14 +237,13 @@ func TestDebtTests(t *testing.T) {
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              &dueDate,
 			InstallmentsQuantity: 2,
 			DebtDate:             nil,
 			Status:               debt.Paid,
 			UserClientId:         ulid.Make(),
-			ProductIds:           []ulid.ULID{ulid.Make()},
-			ServiceIds:           []ulid.ULID{},
+			Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 			Intallments:          []debt.Installment{},
 		}
 
@@ -260,7 +254,7 @@ func TestDebtTests(t *testing.T) {
 		paymentInfo := &debt.PaymentInfoDto{
 			DebtId:        d.Id.String(),
 			InstallmentId: installmentId,
-			Amount:        500,
+			Amount:        money.FromCents(50000),
 			PaymentMethod: "credit_card",
 		}
 
@@ -277,14 +271,13 @@ func TestDebtTests(t *testing.T) {
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              &dueDate,
 			InstallmentsQuantity: 2,
 			DebtDate:             nil,
 			Status:               debt.Pending,
 			UserClientId:         ulid.Make(),
-			ProductIds:           []ulid.ULID{ulid.Make()},
-			ServiceIds:           []ulid.ULID{},
+			Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 			Intallments:          []debt.Installment{},
 		}
 
@@ -293,7 +286,7 @@ func TestDebtTests(t *testing.T) {
 		paymentInfo := &debt.PaymentInfoDto{
 			DebtId:        d.Id.String(),
 			InstallmentId: ulid.Make().String(),
-			Amount:        500,
+			Amount:        money.FromCents(50000),
 			PaymentMethod: "credit_card",
 		}
 
@@ -309,14 +302,13 @@ func TestDebtTests(t *testing.T) {
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              &dueDate,
 			InstallmentsQuantity: 2,
 			DebtDate:             nil,
 			Status:               debt.Pending,
 			UserClientId:         ulid.Make(),
-			ProductIds:           []ulid.ULID{ulid.Make()},
-			ServiceIds:           []ulid.ULID{},
+			Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 			Intallments:          []debt.Installment{},
 		}
 
@@ -329,7 +321,7 @@ func TestDebtTests(t *testing.T) {
 		paymentInfo := &debt.PaymentInfoDto{
 			DebtId:        d.Id.String(),
 			InstallmentId: d.Intallments[0].Id.String(),
-			Amount:        500,
+			Amount:        money.FromCents(50000),
 			PaymentMethod: "credit_card",
 		}
 
@@ -338,21 +330,20 @@ func TestDebtTests(t *testing.T) {
 		assert.Equal(t, "installment is not in pending status", err.Error())
 	})
 
-	t.Run("Deve retornar erro ao tentar pagar uma parcela com valor diferente do valor da parcela", func(t *testing.T) {
+	t.Run("Deve registrar pagamento parcial de uma parcela", func(t *testing.T) {
 		now := time.Now()
 		dueDate := now.Add(24 * time.Hour)
 
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              &dueDate,
 			InstallmentsQuantity: 2,
 			DebtDate:             nil,
 			Status:               debt.Pending,
 			UserClientId:         ulid.Make(),
-			ProductIds:           []ulid.ULID{ulid.Make()},
-			ServiceIds:           []ulid.ULID{},
+			Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 			Intallments:          []debt.Installment{},
 		}
 
@@ -361,13 +352,171 @@ func TestDebtTests(t *testing.T) {
 		paymentInfo := &debt.PaymentInfoDto{
 			DebtId:        d.Id.String(),
 			InstallmentId: d.Intallments[0].Id.String(),
-			Amount:        400,
-			PaymentMethod: "credit_card",
+			Amount:        money.FromCents(20000),
+			PaymentMethod: "cash",
 		}
 
 		err := d.PayInstallment(paymentInfo)
-		assert.NotNil(t, err)
-		assert.Equal(t, "amount does not match the installment value", err.Error())
+		assert.Nil(t, err)
+		assert.Equal(t, debt.PartiallyPaid, d.Intallments[0].Status)
+		assert.Len(t, d.Intallments[0].Payments, 1)
+		assert.Equal(t, money.FromCents(20000), d.Intallments[0].PaidAmount())
+
+		balance, err := d.InstallmentBalance(paymentInfo.InstallmentId, now)
+		assert.Nil(t, err)
+		assert.Equal(t, money.FromCents(30000), balance)
+
+		paymentInfo.Amount = money.FromCents(30000)
+		paymentInfo.PaymentMethod = "pix"
+		err = d.PayInstallment(paymentInfo)
+		assert.Nil(t, err)
+		assert.Equal(t, debt.Paid, d.Intallments[0].Status)
+		assert.Len(t, d.Intallments[0].Payments, 2)
+		assert.Equal(t, "pix", d.Intallments[0].Payments[1].Method)
+		assert.NotEqual(t, d.Intallments[0].Payments[0].Id, d.Intallments[0].Payments[1].Id)
+		assert.Equal(t, debt.Pending, d.Status)
+	})
+
+	t.Run("Deve retornar erro ao tentar pagar uma parcela com valor zerado", func(t *testing.T) {
+		now := time.Now()
+		dueDate := now.Add(24 * time.Hour)
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			Description:          "Test Debt",
+			TotalValue:           money.FromCents(100000),
+			DueDate:              &dueDate,
+			InstallmentsQuantity: 2,
+			Status:               debt.Pending,
+			UserClientId:         ulid.Make(),
+			Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
+		}
+
+		d.GenerateInstallments()
+
+		paymentInfo := &debt.PaymentInfoDto{
+			DebtId:        d.Id.String(),
+			InstallmentId: d.Intallments[0].Id.String(),
+			Amount:        money.FromCents(0),
+			PaymentMethod: "cash",
+		}
+
+		err := d.PayInstallment(paymentInfo)
+		assert.EqualError(t, err, "amount must be greater than 0")
+	})
+
+	t.Run("Deve retornar erro ao tentar cancelar uma divida com parcela parcialmente paga", func(t *testing.T) {
+		now := time.Now()
+		dueDate := now.Add(24 * time.Hour)
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			Description:          "Test Debt",
+			TotalValue:           money.FromCents(100000),
+			DueDate:              &dueDate,
+			InstallmentsQuantity: 2,
+			Status:               debt.Pending,
+			UserClientId:         ulid.Make(),
+			Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
+		}
+
+		d.GenerateInstallments()
+
+		err := d.PayInstallment(&debt.PaymentInfoDto{
+			DebtId:        d.Id.String(),
+			InstallmentId: d.Intallments[0].Id.String(),
+			Amount:        money.FromCents(10000),
+			PaymentMethod: "cash",
+		})
+		assert.Nil(t, err)
+
+		err = d.Cancel(&debt.CancelInfoDto{
+			DebtId:      d.Id.String(),
+			Reason:      "User requested cancellation",
+			CancelledBy: ulid.Make(),
+		})
+		assert.EqualError(t, err, "cannot cancel debt with paid installments")
+	})
+
+	t.Run("Deve calcular os encargos na data em que o pagamento foi recebido", func(t *testing.T) {
+		dueDate := time.Now().AddDate(0, 0, -10)
+		paidAt := dueDate.AddDate(0, 0, -1)
+
+		d := &debt.Debt{
+			Id:     ulid.Make(),
+			Status: debt.Overdue,
+			Intallments: []debt.Installment{
+				{Id: ulid.Make(), Value: money.FromCents(10000), DueDate: &dueDate, Status: debt.Overdue},
+			},
+			ChargePolicy: debt.ChargePolicy{
+				FinePercentage: 2,
+				InterestRate:   1,
+				InterestPeriod: debt.MonthlyInterest,
+			},
+		}
+
+		err := d.PayInstallment(&debt.PaymentInfoDto{
+			InstallmentId: d.Intallments[0].Id.String(),
+			Amount:        money.FromCents(10000),
+			PaymentMethod: "pix",
+			PaidAt:        &paidAt,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, debt.Paid, d.Intallments[0].Status)
+		assert.Equal(t, 0, d.Intallments[0].Charges.DaysLate)
+		assert.Equal(t, paidAt, *d.Intallments[0].PaymentDate)
+	})
+
+	t.Run("Deve exigir o valor corrigido ao pagar uma parcela em atraso", func(t *testing.T) {
+		dueDate := time.Now().AddDate(0, 0, -40)
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			Description:          "Test Debt",
+			TotalValue:           money.FromCents(30000),
+			DueDate:              &dueDate,
+			InstallmentsQuantity: 1,
+			Status:               debt.Pending,
+			UserClientId:         ulid.Make(),
+			Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
+			ChargePolicy: debt.ChargePolicy{
+				FinePercentage: 2,
+				InterestRate:   1,
+				InterestPeriod: debt.MonthlyInterest,
+			},
+		}
+
+		d.GenerateInstallments()
+
+		paymentInfo := &debt.PaymentInfoDto{
+			DebtId:        d.Id.String(),
+			InstallmentId: d.Intallments[0].Id.String(),
+			Amount:        money.FromCents(30000),
+			PaymentMethod: "pix",
+		}
+
+		charges, err := d.InstallmentCharges(paymentInfo.InstallmentId, time.Now())
+		assert.Nil(t, err)
+		assert.Equal(t, money.FromCents(31000), charges.Total())
+
+		err = d.PayInstallment(paymentInfo)
+		assert.Nil(t, err)
+		assert.Equal(t, debt.Overdue, d.Intallments[0].Status)
+		assert.Equal(t, debt.Overdue, d.Status)
+
+		balance, err := d.InstallmentBalance(paymentInfo.InstallmentId, time.Now())
+		assert.Nil(t, err)
+		assert.Equal(t, money.FromCents(1000), balance)
+
+		paymentInfo.Amount = balance
+		err = d.PayInstallment(paymentInfo)
+		assert.Nil(t, err)
+		assert.Equal(t, debt.Paid, d.Intallments[0].Status)
+		assert.Equal(t, money.FromCents(30000), d.Intallments[0].Charges.Principal)
+		assert.Equal(t, money.FromCents(600), d.Intallments[0].Charges.Fine)
+		assert.Equal(t, money.FromCents(400), d.Intallments[0].Charges.Interest)
+		assert.Equal(t, 40, d.Intallments[0].Charges.DaysLate)
 	})
 
 	t.Run("Deve finalizar a divida quando todas as parcelas forem pagas", func(t *testing.T) {
@@ -377,14 +526,13 @@ func TestDebtTests(t *testing.T) {
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              &dueDate,
 			InstallmentsQuantity: 2,
 			DebtDate:             nil,
 			Status:               debt.Pending,
 			UserClientId:         ulid.Make(),
-			ProductIds:           []ulid.ULID{ulid.Make()},
-			ServiceIds:           []ulid.ULID{},
+			Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 			Intallments:          []debt.Installment{},
 		}
 
@@ -412,14 +560,13 @@ func TestDebtTests(t *testing.T) {
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              &dueDate,
 			InstallmentsQuantity: 2,
 			DebtDate:             nil,
 			Status:               debt.Pending,
 			UserClientId:         ulid.Make(),
-			ProductIds:           []ulid.ULID{ulid.Make()},
-			ServiceIds:           []ulid.ULID{},
+			Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 			Intallments:          []debt.Installment{},
 		}
 
@@ -446,14 +593,13 @@ func TestDebtTests(t *testing.T) {
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              &dueDate,
 			InstallmentsQuantity: 2,
 			DebtDate:             nil,
 			Status:               debt.Paid,
 			UserClientId:         ulid.Make(),
-			ProductIds:           []ulid.ULID{ulid.Make()},
-			ServiceIds:           []ulid.ULID{},
+			Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 			Intallments:          []debt.Installment{},
 		}
 
@@ -475,14 +621,13 @@ func TestDebtTests(t *testing.T) {
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              &dueDate,
 			InstallmentsQuantity: 2,
 			DebtDate:             nil,
 			Status:               debt.Pending,
 			UserClientId:         ulid.Make(),
-			ProductIds:           []ulid.ULID{ulid.Make()},
-			ServiceIds:           []ulid.ULID{},
+			Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 			Intallments:          []debt.Installment{},
 		}
 
@@ -510,14 +655,13 @@ func TestDebtTests(t *testing.T) {
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              &dueDate,
 			InstallmentsQuantity: 2,
 			DebtDate:             nil,
 			Status:               debt.Pending,
 			UserClientId:         ulid.Make(),
-			ProductIds:           []ulid.ULID{ulid.Make()},
-			ServiceIds:           []ulid.ULID{},
+			Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 			Intallments:          []debt.Installment{},
 		}
 
@@ -548,14 +692,13 @@ func TestDebtTests(t *testing.T) {
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              &dueDate,
 			InstallmentsQuantity: 2,
 			DebtDate:             nil,
 			Status:               debt.Pending,
 			UserClientId:         ulid.Make(),
-			ProductIds:           []ulid.ULID{ulid.Make()},
-			ServiceIds:           []ulid.ULID{},
+			Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 			Intallments:          []debt.Installment{},
 		}
 
@@ -564,7 +707,7 @@ func TestDebtTests(t *testing.T) {
 		paymentInfo := debt.PaymentInfoDto{
 			DebtId:        d.Id.String(),
 			InstallmentId: d.Intallments[0].Id.String(),
-			Amount:        500,
+			Amount:        money.FromCents(50000),
 			PaymentMethod: "Cartão de crédito",
 		}
 
@@ -590,14 +733,13 @@ func TestDebtTests(t *testing.T) {
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			Description:          "Test Debt",
-			TotalValue:           1000,
+			TotalValue:           money.FromCents(100000),
 			DueDate:              &dueDate,
 			InstallmentsQuantity: 2,
 			DebtDate:             nil,
 			Status:               debt.Canceled,
 			UserClientId:         ulid.Make(),
-			ProductIds:           []ulid.ULID{ulid.Make()},
-			ServiceIds:           []ulid.ULID{},
+			Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 			Intallments:          []debt.Installment{},
 		}
 
@@ -610,4 +752,382 @@ func TestDebtTests(t *testing.T) {
 		err := d.Reverse(&reverseInfo)
 		assert.Error(t, err, "debt is already canceled or reversed")
 	})
+
+	t.Run("Deve renegociar o saldo em aberto da divida em um novo parcelamento", func(t *testing.T) {
+		dueDate := time.Now().Add(24 * time.Hour)
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			Description:          "Test Debt",
+			TotalValue:           money.FromCents(100000),
+			DueDate:              &dueDate,
+			InstallmentsQuantity: 2,
+			Status:               debt.Pending,
+			UserClientId:         ulid.Make(),
+			Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
+			Intallments:          []debt.Installment{},
+		}
+
+		d.GenerateInstallments()
+
+		err := d.PayInstallment(&debt.PaymentInfoDto{
+			DebtId:        d.Id.String(),
+			InstallmentId: d.Intallments[0].Id.String(),
+			Amount:        money.FromCents(20000),
+			PaymentMethod: "Cash",
+		})
+		assert.Nil(t, err)
+
+		renegotiation := &debt.RenegotiationDto{
+			DebtId:               d.Id.String(),
+			InstallmentsQuantity: 3,
+			FirstDueDate:         time.Now().AddDate(0, 1, 0).Format(time.DateOnly),
+			Fees:                 money.FromCents(5000),
+			Discount:             money.FromCents(1000),
+			RenegotiatedBy:       ulid.Make(),
+		}
+
+		renegotiated, err := d.Renegotiate(renegotiation)
+		assert.Nil(t, err)
+		assert.Equal(t, debt.Renegotiated, d.Status)
+		assert.NotNil(t, d.FinishedAt)
+		assert.Equal(t, debt.Renegotiated, d.Intallments[0].Status)
+		assert.Equal(t, debt.Renegotiated, d.Intallments[1].Status)
+
+		assert.NotNil(t, d.RenegotiationInfo)
+		assert.Equal(t, renegotiated.Id, d.RenegotiationInfo.RenegotiatedDebtId)
+		assert.Equal(t, money.FromCents(80000), d.RenegotiationInfo.OpenBalance)
+
+		assert.Equal(t, debt.Pending, renegotiated.Status)
+		assert.Equal(t, d.Id, *renegotiated.OriginDebtId)
+		assert.Equal(t, d.UserClientId, renegotiated.UserClientId)
+		assert.Equal(t, money.FromCents(84000), renegotiated.TotalValue)
+		assert.Len(t, renegotiated.Intallments, 3)
+		assert.Equal(t, renegotiation.FirstDueDate, renegotiated.Intallments[0].DueDate.Format(time.DateOnly))
+	})
+
+	t.Run("Deve retornar erro ao renegociar uma divida que nao esta pendente", func(t *testing.T) {
+		dueDate := time.Now().Add(24 * time.Hour)
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			TotalValue:           money.FromCents(100000),
+			DueDate:              &dueDate,
+			InstallmentsQuantity: 1,
+			Status:               debt.Canceled,
+			UserClientId:         ulid.Make(),
+		}
+
+		_, err := d.Renegotiate(&debt.RenegotiationDto{
+			DebtId:               d.Id.String(),
+			InstallmentsQuantity: 2,
+			FirstDueDate:         time.Now().AddDate(0, 1, 0).Format(time.DateOnly),
+		})
+		assert.EqualError(t, err, "debt is not in pending status")
+	})
+
+	t.Run("Deve retornar erro ao renegociar com desconto maior que o saldo em aberto", func(t *testing.T) {
+		dueDate := time.Now().Add(24 * time.Hour)
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			TotalValue:           money.FromCents(10000),
+			DueDate:              &dueDate,
+			InstallmentsQuantity: 1,
+			Status:               debt.Pending,
+			UserClientId:         ulid.Make(),
+		}
+
+		d.GenerateInstallments()
+
+		_, err := d.Renegotiate(&debt.RenegotiationDto{
+			DebtId:               d.Id.String(),
+			InstallmentsQuantity: 2,
+			FirstDueDate:         time.Now().AddDate(0, 1, 0).Format(time.DateOnly),
+			Discount:             money.FromCents(10000),
+		})
+		assert.EqualError(t, err, "discount must be lower than the open balance")
+		assert.Equal(t, debt.Pending, d.Status)
+		assert.Equal(t, debt.Pending, d.Intallments[0].Status)
+	})
+
+	t.Run("Deve registrar a entrada como parcela paga e dividir o restante", func(t *testing.T) {
+		dueDate := time.Now().Add(24 * time.Hour)
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			Description:          "Venda com entrada",
+			TotalValue:           money.FromCents(120000),
+			DueDate:              &dueDate,
+			InstallmentsQuantity: 5,
+			Status:               debt.Pending,
+			UserClientId:         ulid.Make(),
+			Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
+			DownPayment: &debt.DownPayment{
+				Amount:        money.FromCents(20000),
+				PaymentMethod: "pix",
+			},
+		}
+
+		assert.Empty(t, d.Validate().Errors)
+
+		d.GenerateInstallments()
+
+		assert.Len(t, d.Intallments, 6)
+		assert.Equal(t, 0, d.Intallments[0].Number)
+		assert.Equal(t, debt.Paid, d.Intallments[0].Status)
+		assert.Equal(t, money.FromCents(20000), d.Intallments[0].PaidAmount())
+
+		for _, installment := range d.Intallments[1:] {
+			assert.Equal(t, debt.Pending, installment.Status)
+			assert.Equal(t, money.FromCents(20000), installment.Value)
+		}
+
+		assert.Equal(t, debt.Pending, d.Status)
+	})
+
+	t.Run("Deve gerar as parcelas informadas com valores diferentes", func(t *testing.T) {
+		dueDate := time.Now().Add(24 * time.Hour)
+
+		d := &debt.Debt{
+			Id:           ulid.Make(),
+			Description:  "Parcelas customizadas",
+			TotalValue:   money.FromCents(100000),
+			DueDate:      &dueDate,
+			Status:       debt.Pending,
+			UserClientId: ulid.Make(),
+			Items:        []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
+			DownPayment: &debt.DownPayment{
+				Amount:        money.FromCents(10000),
+				PaymentMethod: "cash",
+			},
+			PlannedInstallments: []debt.PlannedInstallment{
+				{Value: money.FromCents(50000), DueDate: dueDate},
+				{Value: money.FromCents(40000), DueDate: dueDate.AddDate(0, 2, 0)},
+			},
+		}
+
+		assert.Empty(t, d.Validate().Errors)
+
+		d.GenerateInstallments()
+
+		assert.Equal(t, 2, d.InstallmentsQuantity)
+		assert.Len(t, d.Intallments, 3)
+		assert.Equal(t, money.FromCents(50000), d.Intallments[1].Value)
+		assert.Equal(t, money.FromCents(40000), d.Intallments[2].Value)
+		assert.Equal(t, dueDate.AddDate(0, 2, 0).Format(time.DateOnly), d.Intallments[2].DueDate.Format(time.DateOnly))
+	})
+
+	t.Run("Deve retornar erro quando as parcelas informadas nao somam o valor total", func(t *testing.T) {
+		dueDate := time.Now().Add(24 * time.Hour)
+
+		d := &debt.Debt{
+			TotalValue: money.FromCents(100000),
+			PlannedInstallments: []debt.PlannedInstallment{
+				{Value: money.FromCents(50000), DueDate: dueDate},
+				{Value: money.FromCents(49999), DueDate: dueDate},
+			},
+		}
+
+		assert.EqualError(t, d.ValidatePlannedInstallments(), "installments and down payment must sum to totalValue")
+	})
+
+	t.Run("Deve retornar erro quando a entrada cobre todo o valor", func(t *testing.T) {
+		d := &debt.Debt{
+			TotalValue: money.FromCents(100000),
+			DownPayment: &debt.DownPayment{
+				Amount:        money.FromCents(100000),
+				PaymentMethod: "cash",
+			},
+		}
+
+		assert.EqualError(t, d.ValidateDownPayment(), "downPayment must be lower than totalValue")
+	})
+
+	t.Run("Deve calcular o valor total a partir dos itens", func(t *testing.T) {
+		d := &debt.Debt{
+			Items: []debt.DebtItem{
+				{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Camisa", Quantity: 3, UnitPrice: money.FromCents(4990), Discount: money.FromCents(970)},
+				{Type: debt.ServiceItem, ReferenceId: ulid.Make(), Description: "Ajuste", Quantity: 1, UnitPrice: money.FromCents(2500)},
+			},
+		}
+
+		d.CalculateTotalValue()
+
+		assert.NoError(t, d.ValidateServiceOrProduct())
+		assert.Equal(t, money.FromCents(14000), d.Items[0].Total)
+		assert.Equal(t, money.FromCents(2500), d.Items[1].Total)
+		assert.Equal(t, money.FromCents(16500), d.TotalValue)
+	})
+
+	t.Run("Deve validar os itens da divida", func(t *testing.T) {
+		testCases := []struct {
+			item     debt.DebtItem
+			expected string
+		}{
+			{
+				item:     debt.DebtItem{Description: "Camisa", Quantity: 1},
+				expected: "item must reference a product or service",
+			},
+			{
+				item:     debt.DebtItem{ReferenceId: ulid.Make(), Description: "Camisa"},
+				expected: "item quantity must be greater than 0",
+			},
+			{
+				item:     debt.DebtItem{ReferenceId: ulid.Make(), Description: "Camisa", Quantity: 2, UnitPrice: money.FromCents(1000), Discount: money.FromCents(2001)},
+				expected: "item discount must not be greater than its subtotal",
+			},
+		}
+
+		for _, tc := range testCases {
+			d := &debt.Debt{Items: []debt.DebtItem{tc.item}}
+			assert.EqualError(t, d.ValidateServiceOrProduct(), tc.expected)
+		}
+	})
+
+	t.Run("Deve baixar o estoque dos produtos vendidos e devolver ao cancelar", func(t *testing.T) {
+		productId := ulid.Make()
+		d := &debt.Debt{
+			Id:     ulid.Make(),
+			Status: debt.Pending,
+			Items: []debt.DebtItem{
+				{Type: debt.ProductItem, ReferenceId: productId, Description: "Camisa", Quantity: 3, UnitPrice: money.FromCents(5000)},
+				{Type: debt.ServiceItem, ReferenceId: ulid.Make(), Description: "Ajuste", Quantity: 1, UnitPrice: money.FromCents(2000)},
+			},
+		}
+
+		d.RegisterSale()
+
+		assert.Len(t, d.StockMovements, 1)
+		assert.Equal(t, productId, d.StockMovements[0].ProductId)
+		assert.Equal(t, debt.SaleMovement, d.StockMovements[0].Type)
+		assert.Equal(t, -3, d.StockMovements[0].Quantity)
+
+		d.StockMovements = nil
+		assert.NoError(t, d.Cancel(&debt.CancelInfoDto{Reason: "desistencia"}))
+
+		assert.Len(t, d.StockMovements, 1)
+		assert.Equal(t, debt.ReturnMovement, d.StockMovements[0].Type)
+		assert.Equal(t, 3, d.StockMovements[0].Quantity)
+	})
+
+	t.Run("Deve devolver o estoque ao estornar", func(t *testing.T) {
+		d := &debt.Debt{
+			Id:     ulid.Make(),
+			Status: debt.Paid,
+			Items: []debt.DebtItem{
+				{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Camisa", Quantity: 2, UnitPrice: money.FromCents(5000)},
+			},
+		}
+
+		assert.NoError(t, d.Reverse(&debt.ReversalInfoDto{Reason: "devolucao"}))
+
+		assert.Len(t, d.StockMovements, 1)
+		assert.Equal(t, debt.ReturnMovement, d.StockMovements[0].Type)
+		assert.Equal(t, 2, d.StockMovements[0].Quantity)
+	})
+
+	t.Run("Deve considerar em atraso a parcela aberta apos o vencimento", func(t *testing.T) {
+		dueDate := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+		installment := debt.Installment{Status: debt.Pending, DueDate: &dueDate}
+
+		assert.False(t, installment.IsOverdue(time.Date(2025, 3, 10, 18, 0, 0, 0, time.UTC)))
+		assert.True(t, installment.IsOverdue(time.Date(2025, 3, 11, 8, 0, 0, 0, time.UTC)))
+
+		installment.Status = debt.Paid
+		assert.False(t, installment.IsOverdue(time.Date(2025, 3, 11, 8, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("Deve receber pagamento de uma divida em atraso e volta-la para pendente", func(t *testing.T) {
+		lateDate := time.Now().AddDate(0, 0, -5)
+		dueDate := time.Now().AddDate(0, 1, 0)
+		d := &debt.Debt{
+			Id:     ulid.Make(),
+			Status: debt.Overdue,
+			Intallments: []debt.Installment{
+				{Id: ulid.Make(), Value: money.FromCents(10000), DueDate: &lateDate, Status: debt.Overdue},
+				{Id: ulid.Make(), Value: money.FromCents(10000), DueDate: &dueDate, Status: debt.Pending},
+			},
+		}
+
+		err := d.PayInstallment(&debt.PaymentInfoDto{
+			InstallmentId: d.Intallments[0].Id.String(),
+			Amount:        money.FromCents(10000),
+			PaymentMethod: "pix",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, debt.Paid, d.Intallments[0].Status)
+		assert.Equal(t, debt.Pending, d.Status)
+	})
+
+	t.Run("Deve manter em atraso a parcela vencida paga parcialmente", func(t *testing.T) {
+		lateDate := time.Now().AddDate(0, 0, -5)
+		dueDate := time.Now().AddDate(0, 1, 0)
+		d := &debt.Debt{
+			Id:     ulid.Make(),
+			Status: debt.Overdue,
+			Intallments: []debt.Installment{
+				{Id: ulid.Make(), Value: money.FromCents(10000), DueDate: &lateDate, Status: debt.Overdue},
+				{Id: ulid.Make(), Value: money.FromCents(10000), DueDate: &dueDate, Status: debt.Pending},
+			},
+		}
+
+		err := d.PayInstallment(&debt.PaymentInfoDto{
+			InstallmentId: d.Intallments[0].Id.String(),
+			Amount:        money.FromCents(4000),
+			PaymentMethod: "pix",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, debt.Overdue, d.Intallments[0].Status)
+		assert.Equal(t, debt.Overdue, d.Status)
+	})
+
+	t.Run("Deve manter em atraso a divida com outra parcela atrasada", func(t *testing.T) {
+		lateDate := time.Now().AddDate(0, 0, -40)
+		otherLateDate := time.Now().AddDate(0, 0, -10)
+		d := &debt.Debt{
+			Id:     ulid.Make(),
+			Status: debt.Overdue,
+			Intallments: []debt.Installment{
+				{Id: ulid.Make(), Value: money.FromCents(10000), DueDate: &lateDate, Status: debt.Overdue},
+				{Id: ulid.Make(), Value: money.FromCents(10000), DueDate: &otherLateDate, Status: debt.Overdue},
+			},
+		}
+
+		err := d.PayInstallment(&debt.PaymentInfoDto{
+			InstallmentId: d.Intallments[0].Id.String(),
+			Amount:        money.FromCents(10000),
+			PaymentMethod: "pix",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, debt.Paid, d.Intallments[0].Status)
+		assert.Equal(t, debt.Overdue, d.Status)
+	})
+
+	t.Run("Nao deve cancelar divida em atraso com parcela parcialmente paga", func(t *testing.T) {
+		lateDate := time.Now().AddDate(0, 0, -5)
+		d := &debt.Debt{
+			Id:     ulid.Make(),
+			Status: debt.Overdue,
+			Intallments: []debt.Installment{
+				{
+					Id:       ulid.Make(),
+					Value:    money.FromCents(10000),
+					DueDate:  &lateDate,
+					Status:   debt.Overdue,
+					Payments: []debt.Payment{{Id: ulid.Make(), Amount: money.FromCents(3000), Method: "pix"}},
+				},
+			},
+		}
+
+		err := d.Cancel(&debt.CancelInfoDto{Reason: "desistencia"})
+		assert.EqualError(t, err, "cannot cancel debt with paid installments")
+
+		err = d.Reverse(&debt.ReversalInfoDto{Reason: "devolucao"})
+		assert.NoError(t, err)
+		assert.Equal(t, debt.Reversed, d.Intallments[0].Status)
+	})
 }
@@ -1,31 +1,80 @@
 package debt
 
-import "github.com/oklog/ulid/v2"
+import (
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+)
 
 type DebtDto struct {
-	Id                   string           `json:"id,omitempty"`
-	Description          string           `json:"description" validate:"required"`
-	TotalValue           float64          `json:"total_value" validate:"required,gt=0"`
-	DueDate              string           `json:"due_date" validate:"required,dateFormat:YYYY-MM-DD"`
-	InstallmentsQuantity int              `json:"installments_quantity" validate:"required,gt=0"`
-	UserClientId         string           `json:"user_client_id" validate:"required"`
-	ProductIds           []string         `json:"product_ids"`
-	ServiceIds           []string         `json:"service_ids"`
-	Status               string           `json:"status,omitempty"`
-	Intallments          []InstallmentDto `json:"intallments,omitempty"`
-	DebtDate             string           `json:"debt_date,omitempty"`
+	Id                   string                 `json:"id,omitempty"`
+	Description          string                 `json:"description" validate:"required"`
+	TotalValue           money.Money            `json:"total_value"`
+	DueDate              string                 `json:"due_date" validate:"required,dateFormat:YYYY-MM-DD"`
+	InstallmentsQuantity int                    `json:"installments_quantity" validate:"required_without=CustomInstallments,gte=0"`
+	UserClientId         string                 `json:"user_client_id" validate:"required"`
+	Items                []DebtItemDto          `json:"items" validate:"required,min=1,dive"`
+	Status               string                 `json:"status,omitempty"`
+	Intallments          []InstallmentDto       `json:"intallments,omitempty"`
+	DebtDate             string                 `json:"debt_date,omitempty"`
+	FinePercentage       float64                `json:"fine_percentage" validate:"gte=0"`
+	InterestRate         float64                `json:"interest_rate" validate:"gte=0"`
+	InterestPeriod       string                 `json:"interest_period,omitempty" validate:"omitempty,oneof=daily monthly"`
+	Schedule             string                 `json:"schedule,omitempty" validate:"omitempty,oneof=monthly interval weekly biweekly"`
+	IntervalDays         int                    `json:"interval_days,omitempty" validate:"gte=0"`
+	NextBusinessDay      bool                   `json:"next_business_day"`
+	DownPayment          money.Money            `json:"down_payment" validate:"gte=0"`
+	DownPaymentMethod    string                 `json:"down_payment_method,omitempty" validate:"required_with=DownPayment"`
+	CustomInstallments   []CustomInstallmentDto `json:"custom_installments,omitempty" validate:"omitempty,dive"`
+	OriginDebtId         string                 `json:"origin_debt_id,omitempty"`
+	RenegotiatedDebtId   string                 `json:"renegotiated_debt_id,omitempty"`
+}
+
+type DebtItemDto struct {
+	Id          string      `json:"id,omitempty"`
+	ProductId   string      `json:"product_id,omitempty" validate:"required_without=ServiceId,omitempty,ulid"`
+	ServiceId   string      `json:"service_id,omitempty" validate:"required_without=ProductId,omitempty,ulid"`
+	Description string      `json:"description" validate:"required"`
+	Quantity    int         `json:"quantity" validate:"required,gt=0"`
+	UnitPrice   money.Money `json:"unit_price" validate:"gte=0"`
+	Discount    money.Money `json:"discount" validate:"gte=0"`
+	Total       money.Money `json:"total"`
+}
+
+type CustomInstallmentDto struct {
+	Value   money.Money `json:"value" validate:"required,gt=0"`
+	DueDate string      `json:"due_date" validate:"required,dateFormat:YYYY-MM-DD"`
 }
 
 type InstallmentDto struct {
-	Id            string  `json:"id,omitempty"`
-	Description   string  `json:"description"`
-	Value         float64 `json:"value"`
-	DueDate       string  `json:"due_date"`
-	DebDate       string  `json:"debt_date"`
-	Status        string  `json:"status"`
-	PaymentDate   string  `json:"payment_date"`
-	PaymentMethod string  `json:"payment_method"`
-	Number        int     `json:"number"`
+	Id            string       `json:"id,omitempty"`
+	Description   string       `json:"description"`
+	Value         money.Money  `json:"value"`
+	DueDate       string       `json:"due_date"`
+	DebDate       string       `json:"debt_date"`
+	Status        string       `json:"status"`
+	PaymentDate   string       `json:"payment_date"`
+	PaymentMethod string       `json:"payment_method"`
+	Number        int          `json:"number"`
+	Fine          money.Money  `json:"fine"`
+	Interest      money.Money  `json:"interest"`
+	PaidAmount    money.Money  `json:"paid_amount"`
+	Payments      []PaymentDto `json:"payments,omitempty"`
+}
+
+type OverdueInstallmentDto struct {
+	InstallmentDto
+	DebtId   string `json:"debt_id"`
+	ClientId string `json:"client_id"`
+	DaysLate int    `json:"days_late"`
+}
+
+type PaymentDto struct {
+	Id            string      `json:"id"`
+	Amount        money.Money `json:"amount"`
+	PaymentMethod string      `json:"payment_method"`
+	PaymentDate   string      `json:"payment_date"`
 }
 
 type PaginationResult struct {
@@ -34,10 +83,40 @@ type PaginationResult struct {
 }
 
 type PaymentInfoDto struct {
-	DebtId        string  `json:"debt_id" validate:"required,ulid"`
-	InstallmentId string  `json:"installment_id" validate:"required,ulid"`
-	Amount        float64 `json:"amount" validate:"required,gt=0"`
-	PaymentMethod string  `json:"payment_method" validate:"required"`
+	DebtId        string      `json:"debt_id" validate:"required,ulid"`
+	InstallmentId string      `json:"installment_id" validate:"required,ulid"`
+	Amount        money.Money `json:"amount" validate:"required,gt=0"`
+	PaymentMethod string      `json:"payment_method" validate:"required"`
+	CreditSurplus bool        `json:"credit_surplus"`
+	// PaidAt is when the money was received, when it is known to be other
+	// than now, as for the Pix payments reconciled from a statement.
+	PaidAt *time.Time `json:"-"`
+	// Reference identifies the payment at its origin. A payment with a
+	// reference already registered on the debt is not registered again.
+	Reference string `json:"-"`
+}
+
+// PaymentDate is the reference date of the payment: the fine and interest
+// are those owed on that day.
+func (p *PaymentInfoDto) PaymentDate() time.Time {
+	if p.PaidAt != nil {
+		return *p.PaidAt
+	}
+
+	return time.Now()
+}
+
+type PaymentResultDto struct {
+	CreditedSurplus money.Money `json:"credited_surplus"`
+	// ReceiptId is left empty when the receipt could not be issued.
+	ReceiptId     string `json:"receipt_id,omitempty"`
+	ReceiptNumber int64  `json:"receipt_number,omitempty"`
+}
+
+type CreditSettlementDto struct {
+	DebtId        string      `json:"debt_id" validate:"required,ulid"`
+	InstallmentId string      `json:"installment_id" validate:"required,ulid"`
+	Amount        money.Money `json:"amount" validate:"gte=0"`
 }
 
 type CancelInfoDto struct {
@@ -51,3 +130,32 @@ type ReversalInfoDto struct {
 	Reason     string `json:"reason" validate:"required"`
 	ReversedBy ulid.ULID
 }
+
+type RenegotiationDto struct {
+	DebtId               string      `json:"debt_id" validate:"required,ulid"`
+	InstallmentsQuantity int         `json:"installments_quantity" validate:"required,gt=0"`
+	FirstDueDate         string      `json:"first_due_date" validate:"required,dateFormat:YYYY-MM-DD"`
+	Fees                 money.Money `json:"fees" validate:"gte=0"`
+	Discount             money.Money `json:"discount" validate:"gte=0"`
+	RenegotiatedBy       ulid.ULID
+}
+
+type InstallmentPixDto struct {
+	TxId    string      `json:"txid"`
+	Amount  money.Money `json:"amount"`
+	Payload string      `json:"payload"`
+	// QRCode is the PNG image of the payload, written in base64.
+	QRCode []byte `json:"qr_code"`
+}
+
+type InstallmentBoletoDto struct {
+	OurNumber     string      `json:"our_number"`
+	Barcode       string      `json:"barcode"`
+	DigitableLine string      `json:"digitable_line"`
+	Amount        money.Money `json:"amount"`
+	DueDate       string      `json:"due_date"`
+	// BarcodeImage is the PNG image of the bars, written in base64.
+	BarcodeImage []byte `json:"barcode_image"`
+	// PDF is the printable slip, served on its own.
+	PDF []byte `json:"-"`
+}
@@ -0,0 +1,65 @@
+package gorm
+
+import (
+	"context"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	ormdb "github.com/henriquerocha2004/quem-me-deve-api/core/shared/gorm"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type GormBoletoNumberRepository struct {
+	db *gorm.DB
+}
+
+func NewGormBoletoNumberRepository(db *gorm.DB) *GormBoletoNumberRepository {
+	return &GormBoletoNumberRepository{db: db}
+}
+
+// OurNumber takes the next number of the account under a transaction lock of
+// the tenant, so that two installments never get the same one.
+func (g *GormBoletoNumberRepository) OurNumber(ctx context.Context, installmentId ulid.ULID) (int64, error) {
+	tenantId, ok := shared.TenantFromContext(ctx)
+	if !ok {
+		return 0, ormdb.ErrTenantRequired
+	}
+
+	var ourNumber int64
+	err := g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "boletos:"+tenantId.String()).Error; err != nil {
+			return err
+		}
+
+		var model Boleto
+		result := tx.Where("installment_id = ?", installmentId.String()).Limit(1).Find(&model)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected > 0 {
+			ourNumber = model.OurNumber
+			return nil
+		}
+
+		var last int64
+		if err := tx.Model(&Boleto{}).Select("COALESCE(MAX(our_number), 0)").Scan(&last).Error; err != nil {
+			return err
+		}
+
+		model = Boleto{
+			InstallmentID: installmentId.String(),
+			OurNumber:     last + 1,
+			CreatedAt:     time.Now(),
+		}
+		if err := tx.Create(&model).Error; err != nil {
+			return err
+		}
+
+		ourNumber = model.OurNumber
+		return nil
+	})
+
+	return ourNumber, err
+}
@@ -3,25 +3,33 @@ package gorm
 import (
 	"time"
 
-	"github.com/lib/pq"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
 	"github.com/oklog/ulid/v2"
 	"gorm.io/gorm"
 )
 
 type Debt struct {
-	ID                   string         `gorm:"column:id;primaryKey;type:char(26)"`
-	Description          string         `gorm:"column:description;type:text;not null"`
-	TotalValue           float64        `gorm:"column:total_value;type:decimal(10,2);not null"`
-	DueDate              *time.Time     `gorm:"column:due_date;type:timestamp;not null"`
-	InstallmentsQuantity int            `gorm:"column:installments_quantity;type:int;not null"`
-	UserClientId         string         `gorm:"column:user_client_id;type:char(26);not null"`
-	ProductIds           pq.StringArray `gorm:"column:product_ids;type:text[];not null"`
-	ServiceIds           pq.StringArray `gorm:"column:service_ids;type:text[];not null"`
-	Status               string         `gorm:"column:status;type:text;not null"`
-	DebtDate             *time.Time     `gorm:"column:debt_date;type:timestamp;not null"`
-	Installments         []Installment  `gorm:"foreignKey:DebtId"`
-	CancelInfo           CancelInfo     `gorm:"foreignKey:DebtId"`
-	ReversalInfo         ReversalInfo   `gorm:"foreignKey:DebtId"`
+	ID                   string            `gorm:"column:id;primaryKey;type:char(26)"`
+	Description          string            `gorm:"column:description;type:text;not null"`
+	TotalValue           money.Money       `gorm:"column:total_value;type:decimal(10,2);not null"`
+	DueDate              *time.Time        `gorm:"column:due_date;type:timestamp;not null"`
+	InstallmentsQuantity int               `gorm:"column:installments_quantity;type:int;not null"`
+	UserClientId         string            `gorm:"column:user_client_id;type:char(26);not null"`
+	Status               string            `gorm:"column:status;type:text;not null"`
+	DebtDate             *time.Time        `gorm:"column:debt_date;type:timestamp;not null"`
+	FinePercentage       float64           `gorm:"column:fine_percentage;type:decimal(5,2);not null"`
+	InterestRate         float64           `gorm:"column:interest_rate;type:decimal(7,4);not null"`
+	InterestPeriod       string            `gorm:"column:interest_period;type:text;not null"`
+	Schedule             string            `gorm:"column:schedule;type:varchar(20);not null"`
+	IntervalDays         int               `gorm:"column:interval_days;type:int;not null"`
+	NextBusinessDay      bool              `gorm:"column:next_business_day;type:boolean;not null"`
+	Items                []DebtItem        `gorm:"foreignKey:DebtId"`
+	Installments         []Installment     `gorm:"foreignKey:DebtId"`
+	CancelInfo           CancelInfo        `gorm:"foreignKey:DebtId"`
+	ReversalInfo         ReversalInfo      `gorm:"foreignKey:DebtId"`
+	RenegotiationInfo    RenegotiationInfo `gorm:"foreignKey:DebtId"`
+	Origin               RenegotiationInfo `gorm:"foreignKey:RenegotiatedDebtId"`
+	TenantID             string            `gorm:"column:tenant_id;type:char(26);not null"`
 }
 
 func (d *Debt) BeforeCreate(tx *gorm.DB) (err error) {
@@ -35,17 +43,47 @@ func (d *Debt) TableName() string {
 	return "debts"
 }
 
+type DebtItem struct {
+	Id          string      `gorm:"column:id;primaryKey;type:char(26)"`
+	ItemType    string      `gorm:"column:item_type"`
+	ReferenceId string      `gorm:"column:reference_id"`
+	Description string      `gorm:"column:description"`
+	Quantity    int         `gorm:"column:quantity"`
+	UnitPrice   money.Money `gorm:"column:unit_price"`
+	Discount    money.Money `gorm:"column:discount"`
+	Total       money.Money `gorm:"column:total"`
+	DebtId      string      `gorm:"column:debt_id"`
+	TenantID    string      `gorm:"column:tenant_id"`
+}
+
+func (d *DebtItem) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.Id == "" {
+		d.Id = ulid.Make().String()
+	}
+	return nil
+}
+
+func (d *DebtItem) TableName() string {
+	return "debt_items"
+}
+
 type Installment struct {
-	Id            string     `gorm:"column:id"`
-	Description   string     `gorm:"column:description"`
-	Value         float64    `gorm:"column:value"`
-	DueDate       *time.Time `gorm:"column:due_date"`
-	DebDate       *time.Time `gorm:"column:deb_date"`
-	Status        string     `gorm:"column:status"`
-	PaymentDate   *time.Time `gorm:"column:payment_date"`
-	PaymentMethod string     `gorm:"column:payment_method"`
-	Number        int        `gorm:"column:number"`
-	DebtId        string     `gorm:"column:debt_id"`
+	Id              string               `gorm:"column:id"`
+	Description     string               `gorm:"column:description"`
+	Value           money.Money          `gorm:"column:value"`
+	DueDate         *time.Time           `gorm:"column:due_date"`
+	DebDate         *time.Time           `gorm:"column:deb_date"`
+	Status          string               `gorm:"column:status"`
+	PaymentDate     *time.Time           `gorm:"column:payment_date"`
+	PaymentMethod   string               `gorm:"column:payment_method"`
+	Number          int                  `gorm:"column:number"`
+	PrincipalAmount money.Money          `gorm:"column:principal_amount"`
+	FineAmount      money.Money          `gorm:"column:fine_amount"`
+	InterestAmount  money.Money          `gorm:"column:interest_amount"`
+	DaysLate        int                  `gorm:"column:days_late"`
+	DebtId          string               `gorm:"column:debt_id"`
+	Payments        []InstallmentPayment `gorm:"foreignKey:InstallmentId"`
+	TenantID        string               `gorm:"column:tenant_id"`
 }
 
 func (d *Installment) BeforeCreate(tx *gorm.DB) (err error) {
@@ -59,6 +97,27 @@ func (d *Installment) TableName() string {
 	return "installments"
 }
 
+type InstallmentPayment struct {
+	Id            string      `gorm:"column:id;primaryKey;type:char(26)"`
+	Amount        money.Money `gorm:"column:amount"`
+	PaymentMethod string      `gorm:"column:payment_method"`
+	PaymentDate   *time.Time  `gorm:"column:payment_date"`
+	InstallmentId string      `gorm:"column:installment_id"`
+	Reference     string      `gorm:"column:reference"`
+	TenantID      string      `gorm:"column:tenant_id"`
+}
+
+func (d *InstallmentPayment) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.Id == "" {
+		d.Id = ulid.Make().String()
+	}
+	return nil
+}
+
+func (d *InstallmentPayment) TableName() string {
+	return "installment_payments"
+}
+
 type CancelInfo struct {
 	Id          string     `gorm:"column:id"`
 	Reason      string     `gorm:"column:reason"`
@@ -98,3 +157,69 @@ func (d *ReversalInfo) BeforeCreate(tx *gorm.DB) (err error) {
 func (d *ReversalInfo) TableName() string {
 	return "reversal_info"
 }
+
+type RenegotiationInfo struct {
+	Id                 string      `gorm:"column:id"`
+	RenegotiatedDebtId string      `gorm:"column:renegotiated_debt_id"`
+	OpenBalance        money.Money `gorm:"column:open_balance"`
+	Fees               money.Money `gorm:"column:fees"`
+	Discount           money.Money `gorm:"column:discount"`
+	RenegotiationDate  *time.Time  `gorm:"column:renegotiation_date"`
+	RenegotiatedBy     string      `gorm:"column:renegotiated_by"`
+	DebtId             string      `gorm:"column:debt_id"`
+	TenantID           string      `gorm:"column:tenant_id"`
+}
+
+func (d *RenegotiationInfo) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.Id == "" {
+		d.Id = ulid.Make().String()
+	}
+	return nil
+}
+
+func (d *RenegotiationInfo) TableName() string {
+	return "renegotiation_info"
+}
+
+// StockMovement maps the stock ledger of the catalog. The debt repository
+// only appends to it, inside the transaction that writes the debt.
+type StockMovement struct {
+	Id           string     `gorm:"column:id;primaryKey;type:char(26)"`
+	ProductId    string     `gorm:"column:product_id"`
+	MovementType string     `gorm:"column:movement_type"`
+	Quantity     int        `gorm:"column:quantity"`
+	Description  string     `gorm:"column:description"`
+	DebtId       *string    `gorm:"column:debt_id"`
+	CreatedAt    *time.Time `gorm:"column:created_at"`
+	TenantID     string     `gorm:"column:tenant_id"`
+}
+
+func (d *StockMovement) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.Id == "" {
+		d.Id = ulid.Make().String()
+	}
+	return nil
+}
+
+func (d *StockMovement) TableName() string {
+	return "stock_movements"
+}
+
+type Boleto struct {
+	ID            string    `gorm:"column:id;primaryKey;type:char(26)"`
+	InstallmentID string    `gorm:"column:installment_id;type:char(26);not null"`
+	OurNumber     int64     `gorm:"column:our_number;type:bigint;not null"`
+	CreatedAt     time.Time `gorm:"column:created_at;type:timestamp;not null"`
+	TenantID      string    `gorm:"column:tenant_id;type:char(26);not null"`
+}
+
+func (d *Boleto) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == "" {
+		d.ID = ulid.Make().String()
+	}
+	return nil
+}
+
+func (d *Boleto) TableName() string {
+	return "boletos"
+}
@@ -2,12 +2,18 @@ package gorm
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/henriquerocha2004/quem-me-deve-api/core/client"
+	clientGorm "github.com/henriquerocha2004/quem-me-deve-api/core/client/gorm"
 	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	receiptGorm "github.com/henriquerocha2004/quem-me-deve-api/core/receipt/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
 	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
-	"github.com/lib/pq"
 	"github.com/oklog/ulid/v2"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type GormDebtRepository struct {
@@ -20,10 +26,14 @@ func NewGormDebtRepository(db *gorm.DB) *GormDebtRepository {
 
 func (g *GormDebtRepository) ClientUserDebts(ctx context.Context, clientUserId ulid.ULID) ([]*debt.Debt, error) {
 	var models []Debt
-	result := g.db.Where("user_client_id = ?", clientUserId.String()).
+	result := g.db.WithContext(ctx).Where("user_client_id = ?", clientUserId.String()).
+		Preload("Items").
 		Preload("Installments").
+		Preload("Installments.Payments").
 		Preload("CancelInfo").
 		Preload("ReversalInfo").
+		Preload("RenegotiationInfo").
+		Preload("Origin").
 		Find(&models)
 
 	if result.Error != nil {
@@ -39,13 +49,16 @@ func (g *GormDebtRepository) ClientUserDebts(ctx context.Context, clientUserId u
 			DueDate:              model.DueDate,
 			InstallmentsQuantity: model.InstallmentsQuantity,
 			UserClientId:         ulid.MustParse(model.UserClientId),
-			ProductIds:           g.parseToUlidSlice(model.ProductIds),
-			ServiceIds:           g.parseToUlidSlice(model.ServiceIds),
+			Items:                g.parseItems(model.Items),
 			Status:               debt.StatusValue[model.Status],
 			DebtDate:             model.DebtDate,
 			Intallments:          g.parseInstallments(model.Installments),
 			CancelInfo:           g.parseCancelInfo(model.CancelInfo),
 			ReversalInfo:         g.parseReversalInfo(model.ReversalInfo),
+			ChargePolicy:         g.parseChargePolicy(model),
+			Schedule:             g.parseSchedule(model),
+			RenegotiationInfo:    g.parseRenegotiationInfo(model.RenegotiationInfo),
+			OriginDebtId:         g.parseOriginDebtId(model.Origin),
 		})
 	}
 
@@ -53,7 +66,8 @@ func (g *GormDebtRepository) ClientUserDebts(ctx context.Context, clientUserId u
 }
 func (g *GormDebtRepository) DebtInstallments(ctx context.Context, debtId ulid.ULID) ([]*debt.Installment, error) {
 	var installments []Installment
-	result := g.db.Where("debt_id = ?", debtId.String()).
+	result := g.db.WithContext(ctx).Where("debt_id = ?", debtId.String()).
+		Preload("Payments").
 		Find(&installments)
 
 	if result.Error != nil {
@@ -72,6 +86,8 @@ func (g *GormDebtRepository) DebtInstallments(ctx context.Context, debtId ulid.U
 			PaymentDate:   installment.PaymentDate,
 			PaymentMethod: installment.PaymentMethod,
 			Number:        installment.Number,
+			Charges:       g.parseCharges(installment),
+			Payments:      g.parsePayments(installment.Payments),
 		}
 		parsedInstallments = append(parsedInstallments, &i)
 	}
@@ -82,14 +98,18 @@ func (g *GormDebtRepository) GetDebts(ctx context.Context, pagData paginate.Sear
 	var models []Debt
 	var total int64
 
-	query := g.db.Model(&Debt{}).
+	query := g.db.WithContext(ctx).Model(&Debt{}).
 		Count(&total).
 		Offset(pagData.Offset()).
 		Limit(pagData.Limit).
 		Order("created_at DESC").
+		Preload("Items").
 		Preload("Installments").
+		Preload("Installments.Payments").
 		Preload("CancelInfo").
-		Preload("ReversalInfo")
+		Preload("ReversalInfo").
+		Preload("RenegotiationInfo").
+		Preload("Origin")
 
 	if pagData.TermSearch != "" {
 		query = query.Where("description LIKE ?", "%"+pagData.TermSearch+"%")
@@ -101,7 +121,7 @@ func (g *GormDebtRepository) GetDebts(ctx context.Context, pagData paginate.Sear
 		}
 	}
 
-	result := query.WithContext(ctx).Find(&models)
+	result := query.Find(&models)
 
 	if result.Error != nil {
 		return nil, result.Error
@@ -116,13 +136,16 @@ func (g *GormDebtRepository) GetDebts(ctx context.Context, pagData paginate.Sear
 			DueDate:              model.DueDate,
 			InstallmentsQuantity: model.InstallmentsQuantity,
 			UserClientId:         ulid.MustParse(model.UserClientId),
-			ProductIds:           g.parseToUlidSlice(model.ProductIds),
-			ServiceIds:           g.parseToUlidSlice(model.ServiceIds),
+			Items:                g.parseItems(model.Items),
 			Status:               debt.StatusValue[model.Status],
 			DebtDate:             model.DebtDate,
 			Intallments:          g.parseInstallments(model.Installments),
 			CancelInfo:           g.parseCancelInfo(model.CancelInfo),
 			ReversalInfo:         g.parseReversalInfo(model.ReversalInfo),
+			ChargePolicy:         g.parseChargePolicy(model),
+			Schedule:             g.parseSchedule(model),
+			RenegotiationInfo:    g.parseRenegotiationInfo(model.RenegotiationInfo),
+			OriginDebtId:         g.parseOriginDebtId(model.Origin),
 		})
 	}
 
@@ -134,10 +157,14 @@ func (g *GormDebtRepository) GetDebts(ctx context.Context, pagData paginate.Sear
 func (g *GormDebtRepository) GetDebt(ctx context.Context, debtId ulid.ULID) (*debt.Debt, error) {
 
 	var model Debt
-	result := g.db.Where("id = ?", debtId.String()).
+	result := g.db.WithContext(ctx).Where("id = ?", debtId.String()).
+		Preload("Items").
 		Preload("Installments").
+		Preload("Installments.Payments").
 		Preload("CancelInfo").
 		Preload("ReversalInfo").
+		Preload("RenegotiationInfo").
+		Preload("Origin").
 		First(&model)
 
 	if result.Error != nil {
@@ -151,36 +178,326 @@ func (g *GormDebtRepository) GetDebt(ctx context.Context, debtId ulid.ULID) (*de
 		DueDate:              model.DueDate,
 		InstallmentsQuantity: model.InstallmentsQuantity,
 		UserClientId:         ulid.MustParse(model.UserClientId),
-		ProductIds:           g.parseToUlidSlice(model.ProductIds),
-		ServiceIds:           g.parseToUlidSlice(model.ServiceIds),
+		Items:                g.parseItems(model.Items),
 		Status:               debt.StatusValue[model.Status],
 		DebtDate:             model.DebtDate,
 		Intallments:          g.parseInstallments(model.Installments),
 		CancelInfo:           g.parseCancelInfo(model.CancelInfo),
 		ReversalInfo:         g.parseReversalInfo(model.ReversalInfo),
+		ChargePolicy:         g.parseChargePolicy(model),
+		Schedule:             g.parseSchedule(model),
+		RenegotiationInfo:    g.parseRenegotiationInfo(model.RenegotiationInfo),
+		OriginDebtId:         g.parseOriginDebtId(model.Origin),
 	}
 
 	return debt, nil
 }
 func (g *GormDebtRepository) Save(ctx context.Context, debt *debt.Debt) error {
+	tx := g.db.WithContext(ctx).Begin()
+
+	err := g.create(ctx, tx, debt)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	tx.Commit()
+	return nil
+}
+func (g *GormDebtRepository) Update(ctx context.Context, debt *debt.Debt) error {
+	tx := g.db.WithContext(ctx).Begin()
+
+	err := g.update(ctx, tx, debt)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	tx.Commit()
+
+	return nil
+}
+
+// UpdateWithPayment writes the debt, changes the wallet of its client and
+// stores the receipt of the payment in the same transaction, so that a
+// payment is never kept without its credit, debit or receipt, nor the other
+// way around.
+func (g *GormDebtRepository) UpdateWithPayment(ctx context.Context, d *debt.Debt, write debt.PaymentWrite) error {
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := g.update(ctx, tx, d); err != nil {
+			return err
+		}
+
+		if change := write.Wallet; change != nil {
+			err := clientGorm.ApplyToWallet(tx, d.UserClientId, func(wallet *client.Wallet) error {
+				if change.Type == client.DebitEntry {
+					return wallet.Debit(change.Amount, change.Description)
+				}
+
+				return wallet.Credit(change.Amount, change.Description)
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if write.Receipt == nil {
+			return nil
+		}
+
+		return receiptGorm.CreateReceipt(tx, write.Receipt)
+	})
+}
+
+// SaveRenegotiation closes the original debt and creates the renegotiated
+// one, along with the link between them, in a single transaction.
+func (g *GormDebtRepository) SaveRenegotiation(ctx context.Context, original, renegotiated *debt.Debt) error {
+	tx := g.db.WithContext(ctx).Begin()
+
+	err := g.update(ctx, tx, original)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = g.create(ctx, tx, renegotiated)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	info := original.RenegotiationInfo
+	renegotiationInfo := RenegotiationInfo{
+		Id:                 ulid.Make().String(),
+		RenegotiatedDebtId: renegotiated.Id.String(),
+		OpenBalance:        info.OpenBalance,
+		Fees:               info.Fees,
+		Discount:           info.Discount,
+		RenegotiationDate:  info.RenegotiationDate,
+		RenegotiatedBy:     info.RenegotiatedBy.String(),
+		DebtId:             original.Id.String(),
+	}
+
+	err = tx.WithContext(ctx).Model(&RenegotiationInfo{}).
+		Create(&renegotiationInfo).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	tx.Commit()
+
+	return nil
+}
+func (g *GormDebtRepository) RenegotiatedDebt(ctx context.Context, originDebtId ulid.ULID) (*debt.Debt, error) {
+	var info RenegotiationInfo
+	result := g.db.WithContext(ctx).Where("debt_id = ?", originDebtId.String()).Limit(1).Find(&info)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return g.GetDebt(ctx, ulid.MustParse(info.RenegotiatedDebtId))
+}
+
+// InstallmentDebt returns the debt of the installment, or nil when the
+// tenant has no such installment.
+func (g *GormDebtRepository) InstallmentDebt(ctx context.Context, installmentId ulid.ULID) (*debt.Debt, error) {
+	var installment Installment
+	result := g.db.WithContext(ctx).Where("id = ?", installmentId.String()).Limit(1).Find(&installment)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return g.GetDebt(ctx, ulid.MustParse(installment.DebtId))
+}
+func (g *GormDebtRepository) OriginDebt(ctx context.Context, renegotiatedDebtId ulid.ULID) (*debt.Debt, error) {
+	var info RenegotiationInfo
+	result := g.db.WithContext(ctx).Where("renegotiated_debt_id = ?", renegotiatedDebtId.String()).Limit(1).Find(&info)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return g.GetDebt(ctx, ulid.MustParse(info.DebtId))
+}
+
+// OverdueInstallments returns the open installments of the tenant past
+// their due date, from every client, the oldest first. They are found by the
+// due date, so that the ones not yet marked by the overdue job are included.
+func (g *GormDebtRepository) OverdueInstallments(ctx context.Context, ref time.Time) ([]*debt.OverdueInstallment, error) {
+	var installments []Installment
+	err := g.db.WithContext(ctx).
+		Joins("JOIN debts ON debts.id = installments.debt_id").
+		Where("installments.status IN ? AND installments.due_date < ? AND debts.status IN ?",
+			openInstallmentStatus(), startOfDay(ref), openDebtStatus()).
+		Order("installments.due_date, installments.id").
+		Preload("Payments").
+		Find(&installments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	clients, err := g.debtClients(g.db.WithContext(ctx), installments)
+	if err != nil {
+		return nil, err
+	}
+
+	var overdue []*debt.OverdueInstallment
+	for i, installment := range g.parseInstallments(installments) {
+		overdue = append(overdue, &debt.OverdueInstallment{
+			Installment:  installment,
+			DebtId:       ulid.MustParse(installments[i].DebtId),
+			UserClientId: ulid.MustParse(clients[installments[i].DebtId]),
+		})
+	}
+
+	return overdue, nil
+}
+
+// DueSoonInstallments runs from the due soon job and not from a request, so
+// it goes through every tenant.
+func (g *GormDebtRepository) DueSoonInstallments(ctx context.Context, from, to time.Time) ([]*debt.TenantInstallment, error) {
+	db := g.db.WithContext(shared.AcrossTenants(ctx))
+
+	var installments []Installment
+	err := db.
+		Joins("JOIN debts ON debts.id = installments.debt_id").
+		Where("installments.status IN ? AND installments.due_date BETWEEN ? AND ? AND debts.status IN ?",
+			[]string{debt.Pending.String(), debt.PartiallyPaid.String()}, startOfDay(from), startOfDay(to), openDebtStatus()).
+		Order("installments.due_date, installments.id").
+		Find(&installments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return g.tenantInstallments(db, installments)
+}
+
+// MarkOverdue runs from the overdue job and not from a request, so it goes
+// through every tenant. Partially paid installments become overdue as well:
+// their payments are kept apart.
+func (g *GormDebtRepository) MarkOverdue(ctx context.Context, ref time.Time) ([]*debt.TenantInstallment, error) {
+	var marked []*debt.TenantInstallment
+
+	err := g.db.WithContext(shared.AcrossTenants(ctx)).Transaction(func(tx *gorm.DB) error {
+		var installments []Installment
+		err := tx.Model(&installments).Clauses(clause.Returning{}).
+			Where("status IN ? AND due_date < ?", []string{debt.Pending.String(), debt.PartiallyPaid.String()}, startOfDay(ref)).
+			Where("debt_id IN (?)", tx.Model(&Debt{}).Select("id").Where("status IN ?", openDebtStatus())).
+			Update("status", debt.Overdue.String()).Error
+		if err != nil {
+			return err
+		}
+
+		marked, err = g.tenantInstallments(tx, installments)
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&Debt{}).
+			Where("status = ?", debt.Pending.String()).
+			Where("EXISTS (?)", tx.Model(&Installment{}).Select("1").
+				Where("installments.debt_id = debts.id AND installments.status = ?", debt.Overdue.String())).
+			Update("status", debt.Overdue.String()).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return marked, nil
+}
+
+func (g *GormDebtRepository) tenantInstallments(db *gorm.DB, installments []Installment) ([]*debt.TenantInstallment, error) {
+	clients, err := g.debtClients(db, installments)
+	if err != nil {
+		return nil, err
+	}
+
+	var tenantInstallments []*debt.TenantInstallment
+	for i, installment := range g.parseInstallments(installments) {
+		tenantInstallments = append(tenantInstallments, &debt.TenantInstallment{
+			Installment:  installment,
+			DebtId:       ulid.MustParse(installments[i].DebtId),
+			UserClientId: ulid.MustParse(clients[installments[i].DebtId]),
+			TenantId:     ulid.MustParse(installments[i].TenantID),
+		})
+	}
+
+	return tenantInstallments, nil
+}
+
+// debtClients maps the debts of the installments to their clients.
+func (g *GormDebtRepository) debtClients(db *gorm.DB, installments []Installment) (map[string]string, error) {
+	if len(installments) == 0 {
+		return nil, nil
+	}
+
+	debtIds := make([]string, 0, len(installments))
+	for _, installment := range installments {
+		debtIds = append(debtIds, installment.DebtId)
+	}
+
+	var debts []Debt
+	err := db.Select("id", "user_client_id").Where("id IN ?", debtIds).Find(&debts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	clients := make(map[string]string, len(debts))
+	for _, model := range debts {
+		clients[model.ID] = model.UserClientId
+	}
+
+	return clients, nil
+}
+
+func openInstallmentStatus() []string {
+	return []string{debt.Pending.String(), debt.PartiallyPaid.String(), debt.Overdue.String()}
+}
+
+func openDebtStatus() []string {
+	return []string{debt.Pending.String(), debt.Overdue.String()}
+}
+
+// startOfDay matches the due dates, kept at midnight: an installment is only
+// overdue on the day after its due date.
+func startOfDay(ref time.Time) time.Time {
+	return time.Date(ref.Year(), ref.Month(), ref.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func (g *GormDebtRepository) create(ctx context.Context, tx *gorm.DB, debt *debt.Debt) error {
 
-	products := g.pushProducts(debt)
-	services := g.pushServices(debt)
 	installments := []Installment{}
 
 	if len(debt.Intallments) > 0 {
 		for _, installment := range debt.Intallments {
 			installments = append(installments, Installment{
-				Id:            installment.Id.String(),
-				Description:   installment.Description,
-				Value:         installment.Value,
-				DueDate:       installment.DueDate,
-				DebDate:       installment.DebDate,
-				Status:        installment.Status.String(),
-				PaymentDate:   installment.PaymentDate,
-				PaymentMethod: installment.PaymentMethod,
-				Number:        installment.Number,
-				DebtId:        debt.Id.String(),
+				Id:              installment.Id.String(),
+				Description:     installment.Description,
+				Value:           installment.Value,
+				DueDate:         installment.DueDate,
+				DebDate:         installment.DebDate,
+				Status:          installment.Status.String(),
+				PaymentDate:     installment.PaymentDate,
+				PaymentMethod:   installment.PaymentMethod,
+				Number:          installment.Number,
+				PrincipalAmount: installment.Charges.Principal,
+				FineAmount:      installment.Charges.Fine,
+				InterestAmount:  installment.Charges.Interest,
+				DaysLate:        installment.Charges.DaysLate,
+				DebtId:          debt.Id.String(),
+				Payments:        g.convertPaymentsToModel(installment),
 			})
 		}
 	}
@@ -192,28 +509,25 @@ func (g *GormDebtRepository) Save(ctx context.Context, debt *debt.Debt) error {
 		DueDate:              debt.DueDate,
 		InstallmentsQuantity: debt.InstallmentsQuantity,
 		UserClientId:         debt.UserClientId.String(),
-		ProductIds:           pq.StringArray(products),
-		ServiceIds:           pq.StringArray(services),
 		Status:               debt.Status.String(),
 		DebtDate:             debt.DebtDate,
+		FinePercentage:       debt.ChargePolicy.FinePercentage,
+		InterestRate:         debt.ChargePolicy.InterestRate,
+		InterestPeriod:       debt.ChargePolicy.InterestPeriod.String(),
+		Schedule:             debt.Schedule.Frequency.String(),
+		IntervalDays:         debt.Schedule.IntervalDays,
+		NextBusinessDay:      debt.Schedule.NextBusinessDay,
+		Items:                g.convertItemsToModel(debt),
 		Installments:         installments,
 	}
 
-	tx := g.db.Begin()
-
-	result := tx.WithContext(ctx).Create(model)
-	if result.Error != nil {
-		tx.Rollback()
-		return result.Error
+	if err := tx.WithContext(ctx).Create(model).Error; err != nil {
+		return err
 	}
 
-	tx.Commit()
-	return nil
+	return g.saveStockMovements(ctx, tx, debt)
 }
-func (g *GormDebtRepository) Update(ctx context.Context, debt *debt.Debt) error {
-	products := g.pushProducts(debt)
-	services := g.pushServices(debt)
-
+func (g *GormDebtRepository) update(ctx context.Context, tx *gorm.DB, debt *debt.Debt) error {
 	model := &Debt{
 		ID:                   debt.Id.String(),
 		Description:          debt.Description,
@@ -221,30 +535,38 @@ func (g *GormDebtRepository) Update(ctx context.Context, debt *debt.Debt) error
 		DueDate:              debt.DueDate,
 		InstallmentsQuantity: debt.InstallmentsQuantity,
 		UserClientId:         debt.UserClientId.String(),
-		ProductIds:           products,
-		ServiceIds:           services,
 		Status:               debt.Status.String(),
 		DebtDate:             debt.DebtDate,
+		FinePercentage:       debt.ChargePolicy.FinePercentage,
+		InterestRate:         debt.ChargePolicy.InterestRate,
+		InterestPeriod:       debt.ChargePolicy.InterestPeriod.String(),
+		Schedule:             debt.Schedule.Frequency.String(),
+		IntervalDays:         debt.Schedule.IntervalDays,
+		NextBusinessDay:      debt.Schedule.NextBusinessDay,
 		Installments:         g.convertInstallmentsToModel(debt.Intallments),
 	}
 
-	tx := g.db.Begin()
-
-	err := tx.WithContext(ctx).Model(&Debt{}).
+	result := tx.WithContext(ctx).Model(&Debt{}).
 		Where("id = ?", debt.Id.String()).
-		Updates(model).Error
+		Updates(model)
 
-	if err != nil {
-		tx.Rollback()
-		return err
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("debt not found")
 	}
 
-	err = tx.WithContext(ctx).Model(model).
+	// Full save so that status, charges and new payments of existing
+	// installments are written too, not only their foreign key.
+	err := tx.WithContext(ctx).
+		Session(&gorm.Session{FullSaveAssociations: true}).
+		Model(model).
 		Association("Installments").
 		Replace(model.Installments)
 
 	if err != nil {
-		tx.Rollback()
 		return err
 	}
 
@@ -261,7 +583,6 @@ func (g *GormDebtRepository) Update(ctx context.Context, debt *debt.Debt) error
 			Create(&cancelInfo).Error
 
 		if err != nil {
-			tx.Rollback()
 			return err
 		}
 	}
@@ -279,49 +600,74 @@ func (g *GormDebtRepository) Update(ctx context.Context, debt *debt.Debt) error
 		err = tx.WithContext(ctx).Model(&ReversalInfo{}).
 			Create(&reversalInfo).Error
 		if err != nil {
-			tx.Rollback()
 			return err
 		}
 	}
 
-	tx.Commit()
-
-	return nil
+	return g.saveStockMovements(ctx, tx, debt)
 }
-func (s *GormDebtRepository) pushProducts(debt *debt.Debt) []string {
 
-	var productIds []string
+// saveStockMovements writes the stock changes produced by the debt, so that
+// stock and debts are committed or rolled back together.
+func (g *GormDebtRepository) saveStockMovements(ctx context.Context, tx *gorm.DB, debt *debt.Debt) error {
+	if len(debt.StockMovements) == 0 {
+		return nil
+	}
 
-	if len(debt.ProductIds) > 0 {
-		for _, productId := range debt.ProductIds {
-			productIds = append(productIds, productId.String())
-		}
+	debtId := debt.Id.String()
+	now := time.Now()
+
+	var movements []StockMovement
+	for _, movement := range debt.StockMovements {
+		movements = append(movements, StockMovement{
+			Id:           movement.Id.String(),
+			ProductId:    movement.ProductId.String(),
+			MovementType: movement.Type.String(),
+			Quantity:     movement.Quantity,
+			Description:  movement.Description,
+			DebtId:       &debtId,
+			CreatedAt:    &now,
+		})
 	}
 
-	return productIds
+	return tx.WithContext(ctx).Create(&movements).Error
 }
-func (s *GormDebtRepository) pushServices(debt *debt.Debt) []string {
-	var serviceIds []string
-
-	if len(debt.ServiceIds) > 0 {
-		for _, serviceId := range debt.ServiceIds {
-			serviceIds = append(serviceIds, serviceId.String())
-		}
+func (s *GormDebtRepository) convertItemsToModel(debt *debt.Debt) []DebtItem {
+	var items []DebtItem
+
+	for _, item := range debt.Items {
+		items = append(items, DebtItem{
+			Id:          item.Id.String(),
+			ItemType:    item.Type.String(),
+			ReferenceId: item.ReferenceId.String(),
+			Description: item.Description,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			Discount:    item.Discount,
+			Total:       item.Total,
+			DebtId:      debt.Id.String(),
+		})
 	}
 
-	return serviceIds
+	return items
 }
-func (s *GormDebtRepository) parseToUlidSlice(productIds []string) []ulid.ULID {
-	var parsedIds []ulid.ULID
-
-	for _, productId := range productIds {
-		parsedId, err := ulid.Parse(productId)
-		if err == nil {
-			parsedIds = append(parsedIds, parsedId)
-		}
+func (s *GormDebtRepository) parseItems(items []DebtItem) []debt.DebtItem {
+	var parsedItems []debt.DebtItem
+
+	for _, item := range items {
+		parsedItems = append(parsedItems, debt.DebtItem{
+			Id:          ulid.MustParse(item.Id),
+			Type:        debt.ItemTypeValue[item.ItemType],
+			ReferenceId: ulid.MustParse(item.ReferenceId),
+			Description: item.Description,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			Discount:    item.Discount,
+			Total:       item.Total,
+		})
 	}
 
-	return parsedIds
+	return parsedItems
 }
 func (s *GormDebtRepository) parseInstallments(installments []Installment) []debt.Installment {
 	var parsedInstallments []debt.Installment
@@ -337,6 +683,8 @@ func (s *GormDebtRepository) parseInstallments(installments []Installment) []deb
 			PaymentDate:   installment.PaymentDate,
 			PaymentMethod: installment.PaymentMethod,
 			Number:        installment.Number,
+			Charges:       s.parseCharges(installment),
+			Payments:      s.parsePayments(installment.Payments),
 		})
 	}
 
@@ -369,19 +717,106 @@ func (g *GormDebtRepository) parseReversalInfo(reversalInfo ReversalInfo) *debt.
 	}
 }
 
+func (g *GormDebtRepository) parseRenegotiationInfo(renegotiationInfo RenegotiationInfo) *debt.RenegotiationInfo {
+	if renegotiationInfo.Id == "" {
+		return nil
+	}
+
+	return &debt.RenegotiationInfo{
+		RenegotiatedDebtId: ulid.MustParse(renegotiationInfo.RenegotiatedDebtId),
+		OpenBalance:        renegotiationInfo.OpenBalance,
+		Fees:               renegotiationInfo.Fees,
+		Discount:           renegotiationInfo.Discount,
+		RenegotiationDate:  renegotiationInfo.RenegotiationDate,
+		RenegotiatedBy:     ulid.MustParse(renegotiationInfo.RenegotiatedBy),
+	}
+}
+
+func (g *GormDebtRepository) parseOriginDebtId(origin RenegotiationInfo) *ulid.ULID {
+	if origin.Id == "" {
+		return nil
+	}
+
+	originDebtId := ulid.MustParse(origin.DebtId)
+	return &originDebtId
+}
+
+func (g *GormDebtRepository) parseChargePolicy(model Debt) debt.ChargePolicy {
+	return debt.ChargePolicy{
+		FinePercentage: model.FinePercentage,
+		InterestRate:   model.InterestRate,
+		InterestPeriod: debt.InterestPeriodValue[model.InterestPeriod],
+	}
+}
+
+func (g *GormDebtRepository) parseSchedule(model Debt) debt.Schedule {
+	return debt.Schedule{
+		Frequency:       debt.ScheduleFrequencyValue[model.Schedule],
+		IntervalDays:    model.IntervalDays,
+		NextBusinessDay: model.NextBusinessDay,
+	}
+}
+
+func (g *GormDebtRepository) parseCharges(installment Installment) debt.ChargeBreakdown {
+	return debt.ChargeBreakdown{
+		Principal: installment.PrincipalAmount,
+		Fine:      installment.FineAmount,
+		Interest:  installment.InterestAmount,
+		DaysLate:  installment.DaysLate,
+	}
+}
+
+func (g *GormDebtRepository) parsePayments(payments []InstallmentPayment) []debt.Payment {
+	var parsedPayments []debt.Payment
+
+	for _, payment := range payments {
+		parsedPayments = append(parsedPayments, debt.Payment{
+			Id:          ulid.MustParse(payment.Id),
+			Amount:      payment.Amount,
+			Method:      payment.PaymentMethod,
+			PaymentDate: payment.PaymentDate,
+			Reference:   payment.Reference,
+		})
+	}
+
+	return parsedPayments
+}
+
+func (g *GormDebtRepository) convertPaymentsToModel(installment debt.Installment) []InstallmentPayment {
+	var models []InstallmentPayment
+
+	for _, payment := range installment.Payments {
+		models = append(models, InstallmentPayment{
+			Id:            payment.Id.String(),
+			Amount:        payment.Amount,
+			PaymentMethod: payment.Method,
+			PaymentDate:   payment.PaymentDate,
+			InstallmentId: installment.Id.String(),
+			Reference:     payment.Reference,
+		})
+	}
+
+	return models
+}
+
 func (g *GormDebtRepository) convertInstallmentsToModel(installments []debt.Installment) []Installment {
 	var models []Installment
 	for _, installment := range installments {
 		models = append(models, Installment{
-			Id:            installment.Id.String(),
-			Description:   installment.Description,
-			Value:         installment.Value,
-			DueDate:       installment.DueDate,
-			DebDate:       installment.DebDate,
-			Status:        installment.Status.String(),
-			PaymentDate:   installment.PaymentDate,
-			PaymentMethod: installment.PaymentMethod,
-			Number:        installment.Number,
+			Id:              installment.Id.String(),
+			Description:     installment.Description,
+			Value:           installment.Value,
+			DueDate:         installment.DueDate,
+			DebDate:         installment.DebDate,
+			Status:          installment.Status.String(),
+			PaymentDate:     installment.PaymentDate,
+			PaymentMethod:   installment.PaymentMethod,
+			Number:          installment.Number,
+			PrincipalAmount: installment.Charges.Principal,
+			FineAmount:      installment.Charges.Fine,
+			InterestAmount:  installment.Charges.Interest,
+			DaysLate:        installment.Charges.DaysLate,
+			Payments:        g.convertPaymentsToModel(installment),
 		})
 	}
 	return models
@@ -9,10 +9,16 @@ import (
 	"time"
 
 	setupdbtests "github.com/henriquerocha2004/quem-me-deve-api/config/setupDbTests"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/client"
+	clientGorm "github.com/henriquerocha2004/quem-me-deve-api/core/client/gorm"
 	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
 	"github.com/henriquerocha2004/quem-me-deve-api/core/debt/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/receipt"
+	receiptGorm "github.com/henriquerocha2004/quem-me-deve-api/core/receipt/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
 	ormdb "github.com/henriquerocha2004/quem-me-deve-api/core/shared/gorm"
 	"github.com/henriquerocha2004/quem-me-deve-api/pkg/helpers"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
 	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
 	"github.com/joho/godotenv"
 	"github.com/oklog/ulid/v2"
@@ -22,6 +28,9 @@ import (
 
 var gormDB *orm.DB = nil
 
+// tenantCtx scopes the tests to a single tenant, as the requests are.
+var tenantCtx = shared.WithTenant(context.Background(), ulid.Make())
+
 func TestMain(m *testing.M) {
 	envPath := helpers.ProjetctRoot() + ".env.testing"
 	err := godotenv.Overload(envPath)
@@ -66,14 +75,14 @@ func (s *DebtRepositorySuiteTest) TestShouldCreateDebt() {
 	debt := &debt.Debt{
 		Id:           ulid.Make(),
 		Description:  "Test Debt",
-		TotalValue:   100.0,
+		TotalValue:   money.FromCents(10000),
 		DueDate:      &dueDate,
 		UserClientId: ulid.Make(),
 		Intallments: []debt.Installment{
 			{
 				Id:          ulid.Make(),
 				Description: "First Installment",
-				Value:       50.0,
+				Value:       money.FromCents(5000),
 				DueDate:     &dueDate,
 				Status:      debt.Pending,
 				Number:      1,
@@ -81,17 +90,17 @@ func (s *DebtRepositorySuiteTest) TestShouldCreateDebt() {
 			{
 				Id:          ulid.Make(),
 				Description: "Second Installment",
-				Value:       50.0,
+				Value:       money.FromCents(5000),
 				DueDate:     &dueDate,
 				Status:      debt.Pending,
 				Number:      2,
 			},
 		},
 	}
-	err := repo.Save(context.Background(), debt)
+	err := repo.Save(tenantCtx, debt)
 	s.Assert().NoError(err)
 
-	savedDebt, err := repo.GetDebt(context.Background(), debt.Id)
+	savedDebt, err := repo.GetDebt(tenantCtx, debt.Id)
 	s.Assert().NoError(err)
 	s.Assert().NotNil(savedDebt)
 	s.Assert().Equal(debt.Description, savedDebt.Description)
@@ -109,14 +118,14 @@ func (s *DebtRepositorySuiteTest) TestShouldUpdateDebtInformation() {
 	debt := &debt.Debt{
 		Id:           ulid.Make(),
 		Description:  "Test Debt",
-		TotalValue:   100.0,
+		TotalValue:   money.FromCents(10000),
 		DueDate:      &dueDate,
 		UserClientId: ulid.Make(),
 		Intallments: []debt.Installment{
 			{
 				Id:          ulid.Make(),
 				Description: "First Installment",
-				Value:       50.0,
+				Value:       money.FromCents(5000),
 				DueDate:     &dueDate,
 				Status:      debt.Pending,
 				Number:      1,
@@ -124,21 +133,21 @@ func (s *DebtRepositorySuiteTest) TestShouldUpdateDebtInformation() {
 			{
 				Id:          ulid.Make(),
 				Description: "Second Installment",
-				Value:       50.0,
+				Value:       money.FromCents(5000),
 				DueDate:     &dueDate,
 				Status:      debt.Pending,
 				Number:      2,
 			},
 		},
 	}
-	err := repo.Save(context.Background(), debt)
+	err := repo.Save(tenantCtx, debt)
 	s.Assert().NoError(err)
 
 	debt.Description = "Updated Debt"
-	err = repo.Update(context.Background(), debt)
+	err = repo.Update(tenantCtx, debt)
 	s.Assert().NoError(err)
 
-	savedDebt, err := repo.GetDebt(context.Background(), debt.Id)
+	savedDebt, err := repo.GetDebt(tenantCtx, debt.Id)
 	s.Assert().NoError(err)
 	s.Assert().Equal("Updated Debt", savedDebt.Description)
 }
@@ -150,14 +159,14 @@ func (s *DebtRepositorySuiteTest) TestShouldCancelDebt() {
 	d := &debt.Debt{
 		Id:           ulid.Make(),
 		Description:  "Test Debt",
-		TotalValue:   100.0,
+		TotalValue:   money.FromCents(10000),
 		DueDate:      &dueDate,
 		UserClientId: ulid.Make(),
 		Intallments: []debt.Installment{
 			{
 				Id:          ulid.Make(),
 				Description: "First Installment",
-				Value:       50.0,
+				Value:       money.FromCents(5000),
 				DueDate:     &dueDate,
 				Status:      debt.Pending,
 				Number:      1,
@@ -165,14 +174,14 @@ func (s *DebtRepositorySuiteTest) TestShouldCancelDebt() {
 			{
 				Id:          ulid.Make(),
 				Description: "Second Installment",
-				Value:       50.0,
+				Value:       money.FromCents(5000),
 				DueDate:     &dueDate,
 				Status:      debt.Pending,
 				Number:      2,
 			},
 		},
 	}
-	err := repo.Save(context.Background(), d)
+	err := repo.Save(tenantCtx, d)
 	s.Assert().NoError(err)
 
 	cancelInfo := debt.CancelInfo{
@@ -182,10 +191,10 @@ func (s *DebtRepositorySuiteTest) TestShouldCancelDebt() {
 	}
 
 	d.CancelInfo = &cancelInfo
-	err = repo.Update(context.Background(), d)
+	err = repo.Update(tenantCtx, d)
 	s.Assert().NoError(err)
 
-	savedDebt, err := repo.GetDebt(context.Background(), d.Id)
+	savedDebt, err := repo.GetDebt(tenantCtx, d.Id)
 	s.Assert().NoError(err)
 	s.Assert().NotNil(savedDebt.CancelInfo)
 	s.Assert().Equal(cancelInfo.Reason, savedDebt.CancelInfo.Reason)
@@ -198,14 +207,14 @@ func (s *DebtRepositorySuiteTest) TestShouldReverseDebt() {
 	d := &debt.Debt{
 		Id:           ulid.Make(),
 		Description:  "Test Debt",
-		TotalValue:   100.0,
+		TotalValue:   money.FromCents(10000),
 		DueDate:      &dueDate,
 		UserClientId: ulid.Make(),
 		Intallments: []debt.Installment{
 			{
 				Id:          ulid.Make(),
 				Description: "First Installment",
-				Value:       50.0,
+				Value:       money.FromCents(5000),
 				DueDate:     &dueDate,
 				Status:      debt.Pending,
 				Number:      1,
@@ -213,14 +222,14 @@ func (s *DebtRepositorySuiteTest) TestShouldReverseDebt() {
 			{
 				Id:          ulid.Make(),
 				Description: "Second Installment",
-				Value:       50.0,
+				Value:       money.FromCents(5000),
 				DueDate:     &dueDate,
 				Status:      debt.Pending,
 				Number:      2,
 			},
 		},
 	}
-	err := repo.Save(context.Background(), d)
+	err := repo.Save(tenantCtx, d)
 	s.Assert().NoError(err)
 
 	reversalInfo := debt.ReversalInfo{
@@ -230,10 +239,10 @@ func (s *DebtRepositorySuiteTest) TestShouldReverseDebt() {
 	}
 
 	d.ReversalInfo = &reversalInfo
-	err = repo.Update(context.Background(), d)
+	err = repo.Update(tenantCtx, d)
 	s.Assert().NoError(err)
 
-	savedDebt, err := repo.GetDebt(context.Background(), d.Id)
+	savedDebt, err := repo.GetDebt(tenantCtx, d.Id)
 	s.Assert().NoError(err)
 	s.Assert().NotNil(savedDebt.ReversalInfo)
 	s.Assert().Equal(reversalInfo.Reason, savedDebt.ReversalInfo.Reason)
@@ -246,24 +255,24 @@ func (s *DebtRepositorySuiteTest) TestShouldGetClientUserDebts() {
 	debt1 := &debt.Debt{
 		Id:           ulid.Make(),
 		Description:  "Client Debt 1",
-		TotalValue:   100.0,
+		TotalValue:   money.FromCents(10000),
 		DueDate:      nil,
 		UserClientId: clientUserId,
 	}
-	err := repo.Save(context.Background(), debt1)
+	err := repo.Save(tenantCtx, debt1)
 	s.Assert().NoError(err)
 
 	debt2 := &debt.Debt{
 		Id:           ulid.Make(),
 		Description:  "Client Debt 2",
-		TotalValue:   200.0,
+		TotalValue:   money.FromCents(20000),
 		DueDate:      nil,
 		UserClientId: clientUserId,
 	}
-	err = repo.Save(context.Background(), debt2)
+	err = repo.Save(tenantCtx, debt2)
 	s.Assert().NoError(err)
 
-	debts, err := repo.ClientUserDebts(context.Background(), clientUserId)
+	debts, err := repo.ClientUserDebts(tenantCtx, clientUserId)
 	s.Assert().NoError(err)
 	s.Assert().Len(debts, 2)
 }
@@ -275,14 +284,14 @@ func (s *DebtRepositorySuiteTest) TestShouldGetDebtInstallments() {
 	debt := &debt.Debt{
 		Id:           ulid.Make(),
 		Description:  "Test Debt",
-		TotalValue:   100.0,
+		TotalValue:   money.FromCents(10000),
 		DueDate:      &dueDate,
 		UserClientId: ulid.Make(),
 		Intallments: []debt.Installment{
 			{
 				Id:          ulid.Make(),
 				Description: "First Installment",
-				Value:       50.0,
+				Value:       money.FromCents(5000),
 				DueDate:     &dueDate,
 				Status:      debt.Pending,
 				Number:      1,
@@ -290,21 +299,54 @@ func (s *DebtRepositorySuiteTest) TestShouldGetDebtInstallments() {
 			{
 				Id:          ulid.Make(),
 				Description: "Second Installment",
-				Value:       50.0,
+				Value:       money.FromCents(5000),
 				DueDate:     &dueDate,
 				Status:      debt.Pending,
 				Number:      2,
 			},
 		},
 	}
-	err := repo.Save(context.Background(), debt)
+	err := repo.Save(tenantCtx, debt)
 	s.Assert().NoError(err)
 
-	installments, err := repo.DebtInstallments(context.Background(), debt.Id)
+	installments, err := repo.DebtInstallments(tenantCtx, debt.Id)
 	s.Assert().NoError(err)
 	s.Assert().Len(installments, 2)
 }
 
+func (s *DebtRepositorySuiteTest) TestShouldGetDebtOfInstallment() {
+	repo := gorm.NewGormDebtRepository(gormDB)
+	dueDate := time.Now().AddDate(0, 0, 30)
+
+	d := &debt.Debt{
+		Id:           ulid.Make(),
+		Description:  "Test Debt",
+		TotalValue:   money.FromCents(5000),
+		DueDate:      &dueDate,
+		UserClientId: ulid.Make(),
+		Intallments: []debt.Installment{
+			{
+				Id:          ulid.Make(),
+				Description: "First Installment",
+				Value:       money.FromCents(5000),
+				DueDate:     &dueDate,
+				Status:      debt.Pending,
+				Number:      1,
+			},
+		},
+	}
+	s.Assert().NoError(repo.Save(tenantCtx, d))
+
+	found, err := repo.InstallmentDebt(tenantCtx, d.Intallments[0].Id)
+	s.Assert().NoError(err)
+	s.Assert().Equal(d.Id, found.Id)
+
+	otherTenant := shared.WithTenant(context.Background(), ulid.Make())
+	found, err = repo.InstallmentDebt(otherTenant, d.Intallments[0].Id)
+	s.Assert().NoError(err)
+	s.Assert().Nil(found)
+}
+
 func (s *DebtRepositorySuiteTest) TestShouldGetDebtsWithPagination() {
 	repo := gorm.NewGormDebtRepository(gormDB)
 
@@ -313,11 +355,11 @@ func (s *DebtRepositorySuiteTest) TestShouldGetDebtsWithPagination() {
 		debt := &debt.Debt{
 			Id:           ulid.Make(),
 			Description:  fmt.Sprintf("Debt %d", i),
-			TotalValue:   float64(i * 10),
+			TotalValue:   money.FromCents(int64(i * 1000)),
 			DueDate:      &dueDate,
 			UserClientId: ulid.Make(),
 		}
-		err := repo.Save(context.Background(), debt)
+		err := repo.Save(tenantCtx, debt)
 		s.Assert().NoError(err)
 	}
 
@@ -326,9 +368,641 @@ func (s *DebtRepositorySuiteTest) TestShouldGetDebtsWithPagination() {
 	}
 	pagData.SetPage(1)
 
-	result, err := repo.GetDebts(context.Background(), pagData)
+	result, err := repo.GetDebts(tenantCtx, pagData)
 	s.Assert().NoError(err)
 	s.Assert().NotNil(result)
 	s.Assert().Len(result.Data, 5)
 	s.Assert().Equal(10, result.TotalRecords)
 }
+
+func (s *DebtRepositorySuiteTest) TestShouldPersistLateChargesBreakdown() {
+	repo := gorm.NewGormDebtRepository(gormDB)
+	dueDate := time.Now().AddDate(0, 0, -40)
+
+	d := &debt.Debt{
+		Id:           ulid.Make(),
+		Description:  "Late Debt",
+		TotalValue:   money.FromCents(30000),
+		DueDate:      &dueDate,
+		UserClientId: ulid.Make(),
+		Status:       debt.Pending,
+		ChargePolicy: debt.ChargePolicy{
+			FinePercentage: 2,
+			InterestRate:   1,
+			InterestPeriod: debt.MonthlyInterest,
+		},
+		Intallments: []debt.Installment{
+			{
+				Id:          ulid.Make(),
+				Description: "First Installment",
+				Value:       money.FromCents(30000),
+				DueDate:     &dueDate,
+				Status:      debt.Pending,
+				Number:      1,
+			},
+		},
+	}
+	err := repo.Save(tenantCtx, d)
+	s.Assert().NoError(err)
+
+	err = d.PayInstallment(&debt.PaymentInfoDto{
+		DebtId:        d.Id.String(),
+		InstallmentId: d.Intallments[0].Id.String(),
+		Amount:        money.FromCents(31000),
+		PaymentMethod: "pix",
+	})
+	s.Assert().NoError(err)
+
+	err = repo.Update(tenantCtx, d)
+	s.Assert().NoError(err)
+
+	savedDebt, err := repo.GetDebt(tenantCtx, d.Id)
+	s.Assert().NoError(err)
+	s.Assert().Equal(d.ChargePolicy, savedDebt.ChargePolicy)
+	s.Assert().Equal(money.FromCents(30000), savedDebt.Intallments[0].Charges.Principal)
+	s.Assert().Equal(money.FromCents(600), savedDebt.Intallments[0].Charges.Fine)
+	s.Assert().Equal(money.FromCents(400), savedDebt.Intallments[0].Charges.Interest)
+	s.Assert().Equal(40, savedDebt.Intallments[0].Charges.DaysLate)
+}
+
+func (s *DebtRepositorySuiteTest) TestShouldPersistInstallmentPayments() {
+	repo := gorm.NewGormDebtRepository(gormDB)
+	dueDate := time.Now().AddDate(0, 0, 30)
+
+	d := &debt.Debt{
+		Id:           ulid.Make(),
+		Description:  "Test Debt",
+		TotalValue:   money.FromCents(10000),
+		DueDate:      &dueDate,
+		UserClientId: ulid.Make(),
+		Status:       debt.Pending,
+		Intallments: []debt.Installment{
+			{
+				Id:          ulid.Make(),
+				Description: "First Installment",
+				Value:       money.FromCents(10000),
+				DueDate:     &dueDate,
+				Status:      debt.Pending,
+				Number:      1,
+			},
+		},
+	}
+	err := repo.Save(tenantCtx, d)
+	s.Assert().NoError(err)
+
+	for _, amount := range []money.Money{money.FromCents(4000), money.FromCents(6000)} {
+		err = d.PayInstallment(&debt.PaymentInfoDto{
+			DebtId:        d.Id.String(),
+			InstallmentId: d.Intallments[0].Id.String(),
+			Amount:        amount,
+			PaymentMethod: "cash",
+		})
+		s.Assert().NoError(err)
+
+		err = repo.Update(tenantCtx, d)
+		s.Assert().NoError(err)
+	}
+
+	savedDebt, err := repo.GetDebt(tenantCtx, d.Id)
+	s.Assert().NoError(err)
+	s.Assert().Equal(debt.Paid, savedDebt.Status)
+	s.Assert().Equal(debt.Paid, savedDebt.Intallments[0].Status)
+	s.Assert().Len(savedDebt.Intallments[0].Payments, 2)
+	s.Assert().Equal(money.FromCents(10000), savedDebt.Intallments[0].PaidAmount())
+
+	installments, err := repo.DebtInstallments(tenantCtx, d.Id)
+	s.Assert().NoError(err)
+	s.Assert().Len(installments[0].Payments, 2)
+}
+
+func (s *DebtRepositorySuiteTest) TestShouldSaveRenegotiationLinkedBothWays() {
+	repo := gorm.NewGormDebtRepository(gormDB)
+	dueDate := time.Now().AddDate(0, 0, 30)
+
+	original := &debt.Debt{
+		Id:                   ulid.Make(),
+		Description:          "Original Debt",
+		TotalValue:           money.FromCents(10000),
+		DueDate:              &dueDate,
+		InstallmentsQuantity: 2,
+		UserClientId:         ulid.Make(),
+		Status:               debt.Pending,
+	}
+	original.GenerateInstallments()
+
+	err := repo.Save(tenantCtx, original)
+	s.Assert().NoError(err)
+
+	renegotiated, err := original.Renegotiate(&debt.RenegotiationDto{
+		DebtId:               original.Id.String(),
+		InstallmentsQuantity: 3,
+		FirstDueDate:         time.Now().AddDate(0, 2, 0).Format(time.DateOnly),
+		Fees:                 money.FromCents(500),
+		RenegotiatedBy:       ulid.Make(),
+	})
+	s.Assert().NoError(err)
+
+	err = repo.SaveRenegotiation(tenantCtx, original, renegotiated)
+	s.Assert().NoError(err)
+
+	savedOriginal, err := repo.GetDebt(tenantCtx, original.Id)
+	s.Assert().NoError(err)
+	s.Assert().Equal(debt.Renegotiated, savedOriginal.Status)
+	s.Assert().Equal(debt.Renegotiated, savedOriginal.Intallments[0].Status)
+	s.Assert().Equal(renegotiated.Id, savedOriginal.RenegotiationInfo.RenegotiatedDebtId)
+	s.Assert().Equal(money.FromCents(10000), savedOriginal.RenegotiationInfo.OpenBalance)
+
+	next, err := repo.RenegotiatedDebt(tenantCtx, original.Id)
+	s.Assert().NoError(err)
+	s.Assert().Equal(renegotiated.Id, next.Id)
+	s.Assert().Equal(money.FromCents(10500), next.TotalValue)
+	s.Assert().Equal(original.Id, *next.OriginDebtId)
+
+	origin, err := repo.OriginDebt(tenantCtx, renegotiated.Id)
+	s.Assert().NoError(err)
+	s.Assert().Equal(original.Id, origin.Id)
+
+	none, err := repo.OriginDebt(tenantCtx, original.Id)
+	s.Assert().NoError(err)
+	s.Assert().Nil(none)
+}
+
+func (s *DebtRepositorySuiteTest) TestShouldPersistInstallmentSchedule() {
+	repo := gorm.NewGormDebtRepository(gormDB)
+	dueDate := time.Now().AddDate(0, 0, 30)
+
+	d := &debt.Debt{
+		Id:                   ulid.Make(),
+		Description:          "Scheduled Debt",
+		TotalValue:           money.FromCents(30000),
+		DueDate:              &dueDate,
+		InstallmentsQuantity: 3,
+		UserClientId:         ulid.Make(),
+		Status:               debt.Pending,
+		Schedule: debt.Schedule{
+			Frequency:       debt.IntervalSchedule,
+			IntervalDays:    10,
+			NextBusinessDay: true,
+		},
+	}
+	d.GenerateInstallments()
+
+	err := repo.Save(tenantCtx, d)
+	s.Assert().NoError(err)
+
+	savedDebt, err := repo.GetDebt(tenantCtx, d.Id)
+	s.Assert().NoError(err)
+	s.Assert().Equal(d.Schedule, savedDebt.Schedule)
+}
+
+func (s *DebtRepositorySuiteTest) TestShouldPersistDebtItems() {
+	repo := gorm.NewGormDebtRepository(gormDB)
+	dueDate := time.Now().AddDate(0, 0, 30)
+
+	d := &debt.Debt{
+		Id:                   ulid.Make(),
+		Description:          "Itemized Debt",
+		DueDate:              &dueDate,
+		InstallmentsQuantity: 2,
+		UserClientId:         ulid.Make(),
+		Status:               debt.Pending,
+		Items: []debt.DebtItem{
+			{Id: ulid.Make(), Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Camisa", Quantity: 2, UnitPrice: money.FromCents(5000), Discount: money.FromCents(1000)},
+			{Id: ulid.Make(), Type: debt.ServiceItem, ReferenceId: ulid.Make(), Description: "Ajuste", Quantity: 1, UnitPrice: money.FromCents(2000)},
+		},
+	}
+	d.CalculateTotalValue()
+	d.GenerateInstallments()
+
+	err := repo.Save(tenantCtx, d)
+	s.Assert().NoError(err)
+
+	savedDebt, err := repo.GetDebt(tenantCtx, d.Id)
+	s.Assert().NoError(err)
+	s.Assert().Equal(money.FromCents(11000), savedDebt.TotalValue)
+	s.Assert().ElementsMatch(d.Items, savedDebt.Items)
+}
+
+func (s *DebtRepositorySuiteTest) TestShouldMoveStockInTheSameTransactionAsTheDebt() {
+	repo := gorm.NewGormDebtRepository(gormDB)
+	dueDate := time.Now().AddDate(0, 0, 30)
+	productId := ulid.Make()
+
+	err := gormDB.Exec("INSERT INTO catalog_items (id, kind, name, unit, active) VALUES (?, 'product', 'Camisa', 'un', true)",
+		productId.String()).Error
+	s.Require().NoError(err)
+
+	stock := func() int {
+		var quantity int
+		gormDB.Raw("SELECT COALESCE(SUM(quantity), 0) FROM stock_movements WHERE product_id = ?", productId.String()).
+			Scan(&quantity)
+		return quantity
+	}
+
+	d := &debt.Debt{
+		Id:                   ulid.Make(),
+		Description:          "Stock Debt",
+		DueDate:              &dueDate,
+		InstallmentsQuantity: 1,
+		UserClientId:         ulid.Make(),
+		Status:               debt.Pending,
+		Items: []debt.DebtItem{
+			{Id: ulid.Make(), Type: debt.ProductItem, ReferenceId: productId, Description: "Camisa", Quantity: 2, UnitPrice: money.FromCents(5000)},
+		},
+	}
+	d.CalculateTotalValue()
+	d.GenerateInstallments()
+	d.RegisterSale()
+
+	err = repo.Save(tenantCtx, d)
+	s.Assert().NoError(err)
+	s.Assert().Equal(-2, stock())
+
+	savedDebt, err := repo.GetDebt(tenantCtx, d.Id)
+	s.Require().NoError(err)
+	s.Assert().Empty(savedDebt.StockMovements)
+
+	err = savedDebt.Cancel(&debt.CancelInfoDto{Reason: "desistencia", CancelledBy: ulid.Make()})
+	s.Require().NoError(err)
+
+	err = repo.Update(tenantCtx, savedDebt)
+	s.Assert().NoError(err)
+	s.Assert().Equal(0, stock())
+}
+
+func (s *DebtRepositorySuiteTest) TestShouldIsolateDebtsByTenant() {
+	repo := gorm.NewGormDebtRepository(gormDB)
+	otherTenantCtx := shared.WithTenant(context.Background(), ulid.Make())
+	dueDate := time.Now().AddDate(0, 0, 30)
+
+	d := &debt.Debt{
+		Id:           ulid.Make(),
+		Description:  "Test Debt",
+		TotalValue:   money.FromCents(10000),
+		DueDate:      &dueDate,
+		UserClientId: ulid.Make(),
+		Status:       debt.Pending,
+		Intallments: []debt.Installment{
+			{
+				Id:          ulid.Make(),
+				Description: "First Installment",
+				Value:       money.FromCents(10000),
+				DueDate:     &dueDate,
+				Status:      debt.Pending,
+				Number:      1,
+			},
+		},
+	}
+	err := repo.Save(tenantCtx, d)
+	s.Assert().NoError(err)
+
+	found, err := repo.GetDebt(otherTenantCtx, d.Id)
+	s.Assert().Error(err)
+	s.Assert().Nil(found)
+
+	pagData := paginate.SearchDto{
+		Limit: 10,
+	}
+	pagData.SetPage(1)
+
+	result, err := repo.GetDebts(otherTenantCtx, pagData)
+	s.Assert().NoError(err)
+	s.Assert().Equal(0, result.TotalRecords)
+	s.Assert().Empty(result.Data)
+
+	debts, err := repo.ClientUserDebts(otherTenantCtx, d.UserClientId)
+	s.Assert().NoError(err)
+	s.Assert().Empty(debts)
+
+	installments, err := repo.DebtInstallments(otherTenantCtx, d.Id)
+	s.Assert().NoError(err)
+	s.Assert().Empty(installments)
+
+	cancelled := *d
+	cancelled.Status = debt.Canceled
+	cancelled.CancelInfo = &debt.CancelInfo{
+		Reason:      "not mine",
+		CancelledBy: ulid.Make(),
+	}
+	err = repo.Update(otherTenantCtx, &cancelled)
+	s.Assert().EqualError(err, "debt not found")
+
+	savedDebt, err := repo.GetDebt(tenantCtx, d.Id)
+	s.Assert().NoError(err)
+	s.Assert().Equal(debt.Pending, savedDebt.Status)
+	s.Assert().Nil(savedDebt.CancelInfo)
+	s.Assert().Len(savedDebt.Intallments, 1)
+}
+
+func (s *DebtRepositorySuiteTest) TestShouldRequireTenant() {
+	repo := gorm.NewGormDebtRepository(gormDB)
+
+	_, err := repo.GetDebt(context.Background(), ulid.Make())
+	s.Assert().ErrorIs(err, ormdb.ErrTenantRequired)
+}
+
+func (s *DebtRepositorySuiteTest) TestShouldMarkOverdueInstallmentsOfEveryTenant() {
+	repo := gorm.NewGormDebtRepository(gormDB)
+	otherTenantCtx := shared.WithTenant(context.Background(), ulid.Make())
+	lateDate := time.Now().AddDate(0, 0, -3)
+	dueDate := time.Now().AddDate(0, 0, 30)
+
+	newDebt := func() *debt.Debt {
+		return &debt.Debt{
+			Id:           ulid.Make(),
+			Description:  "Test Debt",
+			TotalValue:   money.FromCents(10000),
+			DueDate:      &lateDate,
+			UserClientId: ulid.Make(),
+			Status:       debt.Pending,
+			Intallments: []debt.Installment{
+				{Id: ulid.Make(), Value: money.FromCents(5000), DueDate: &lateDate, Status: debt.Pending, Number: 1},
+				{Id: ulid.Make(), Value: money.FromCents(5000), DueDate: &dueDate, Status: debt.Pending, Number: 2},
+			},
+		}
+	}
+
+	late := newDebt()
+	s.Assert().NoError(repo.Save(tenantCtx, late))
+	otherLate := newDebt()
+	s.Assert().NoError(repo.Save(otherTenantCtx, otherLate))
+	canceled := newDebt()
+	canceled.Status = debt.Canceled
+	s.Assert().NoError(repo.Save(tenantCtx, canceled))
+
+	overdue, err := repo.OverdueInstallments(tenantCtx, time.Now())
+	s.Assert().NoError(err)
+	s.Assert().Len(overdue, 1)
+	s.Assert().Equal(late.Intallments[0].Id, overdue[0].Id)
+	s.Assert().Equal(late.UserClientId, overdue[0].UserClientId)
+
+	marked, err := repo.MarkOverdue(context.Background(), time.Now())
+	s.Assert().NoError(err)
+	s.Assert().Len(marked, 2)
+	for _, installment := range marked {
+		s.Assert().Equal(debt.Overdue, installment.Status)
+		s.Assert().NotEqual(ulid.ULID{}, installment.TenantId)
+	}
+
+	savedDebt, err := repo.GetDebt(tenantCtx, late.Id)
+	s.Assert().NoError(err)
+	s.Assert().Equal(debt.Overdue, savedDebt.Status)
+	s.Assert().Equal(debt.Overdue, savedDebt.Intallments[0].Status)
+	s.Assert().Equal(debt.Pending, savedDebt.Intallments[1].Status)
+
+	savedDebt, err = repo.GetDebt(tenantCtx, canceled.Id)
+	s.Assert().NoError(err)
+	s.Assert().Equal(debt.Canceled, savedDebt.Status)
+
+	marked, err = repo.MarkOverdue(context.Background(), time.Now())
+	s.Assert().NoError(err)
+	s.Assert().Empty(marked)
+}
+
+func (s *DebtRepositorySuiteTest) TestShouldFindInstallmentsDueSoonOfEveryTenant() {
+	repo := gorm.NewGormDebtRepository(gormDB)
+	otherTenantCtx := shared.WithTenant(context.Background(), ulid.Make())
+	today := time.Now()
+	soon := time.Date(today.Year(), today.Month(), today.Day()+2, 0, 0, 0, 0, time.UTC)
+	later := soon.AddDate(0, 1, 0)
+
+	newDebt := func() *debt.Debt {
+		return &debt.Debt{
+			Id:           ulid.Make(),
+			Description:  "Test Debt",
+			TotalValue:   money.FromCents(10000),
+			DueDate:      &soon,
+			UserClientId: ulid.Make(),
+			Status:       debt.Pending,
+			Intallments: []debt.Installment{
+				{Id: ulid.Make(), Value: money.FromCents(5000), DueDate: &soon, Status: debt.Pending, Number: 1},
+				{Id: ulid.Make(), Value: money.FromCents(5000), DueDate: &later, Status: debt.Pending, Number: 2},
+			},
+		}
+	}
+
+	s.Assert().NoError(repo.Save(tenantCtx, newDebt()))
+	s.Assert().NoError(repo.Save(otherTenantCtx, newDebt()))
+
+	dueSoon, err := repo.DueSoonInstallments(context.Background(), today, today.AddDate(0, 0, debt.DueSoonDays))
+	s.Assert().NoError(err)
+	s.Assert().Len(dueSoon, 2)
+	s.Assert().Equal(1, dueSoon[0].Number)
+	s.Assert().NotEqual(dueSoon[0].TenantId, dueSoon[1].TenantId)
+}
+
+func (s *DebtRepositorySuiteTest) TestShouldKeepOurNumberOfInstallment() {
+	repo := gorm.NewGormBoletoNumberRepository(gormDB)
+	first, second := ulid.Make(), ulid.Make()
+
+	number, err := repo.OurNumber(tenantCtx, first)
+	s.NoError(err)
+	s.Equal(int64(1), number)
+
+	number, err = repo.OurNumber(tenantCtx, second)
+	s.NoError(err)
+	s.Equal(int64(2), number)
+
+	number, err = repo.OurNumber(tenantCtx, first)
+	s.NoError(err)
+	s.Equal(int64(1), number)
+
+	otherCtx := shared.WithTenant(context.Background(), ulid.Make())
+	number, err = repo.OurNumber(otherCtx, ulid.Make())
+	s.NoError(err)
+	s.Equal(int64(1), number)
+}
+
+func (s *DebtRepositorySuiteTest) TestShouldUpdateDebtAndWalletInTheSameTransaction() {
+	repo := gorm.NewGormDebtRepository(gormDB)
+	tenantId, _ := shared.TenantFromContext(tenantCtx)
+	clientId := ulid.Make()
+	dueDate := time.Now().AddDate(0, 0, 30)
+
+	err := gormDB.Exec(`INSERT INTO clients (id, name, last_name, document, entity_type, birth_day, tenant_id)
+		VALUES (?, 'John', 'Doe', '61824136030', 'PF', '2000-01-01', ?)`,
+		clientId.String(), tenantId.String()).Error
+	s.Require().NoError(err)
+
+	d := &debt.Debt{
+		Id:           ulid.Make(),
+		Description:  "Test Debt",
+		TotalValue:   money.FromCents(10000),
+		DueDate:      &dueDate,
+		UserClientId: clientId,
+		Status:       debt.Pending,
+		Intallments: []debt.Installment{
+			{
+				Id:          ulid.Make(),
+				Description: "First Installment",
+				Value:       money.FromCents(10000),
+				DueDate:     &dueDate,
+				Status:      debt.Pending,
+				Number:      1,
+			},
+		},
+	}
+	err = repo.Save(tenantCtx, d)
+	s.Require().NoError(err)
+
+	d.Intallments[0].Status = debt.Paid
+	err = repo.UpdateWithPayment(tenantCtx, d, debt.PaymentWrite{
+		Wallet: &debt.WalletChange{Type: client.DebitEntry, Amount: money.FromCents(10000), Description: "settlement"},
+	})
+	s.Assert().EqualError(err, "insufficient credit balance")
+
+	savedDebt, err := repo.GetDebt(tenantCtx, d.Id)
+	s.Require().NoError(err)
+	s.Assert().Equal(debt.Pending, savedDebt.Intallments[0].Status)
+
+	err = repo.UpdateWithPayment(tenantCtx, d, debt.PaymentWrite{
+		Wallet: &debt.WalletChange{Type: client.CreditEntry, Amount: money.FromCents(3000), Description: "overpayment"},
+	})
+	s.Assert().NoError(err)
+
+	savedDebt, err = repo.GetDebt(tenantCtx, d.Id)
+	s.Require().NoError(err)
+	s.Assert().Equal(debt.Paid, savedDebt.Intallments[0].Status)
+
+	balance, err := clientGorm.NewGormWalletRepository(gormDB).Balance(tenantCtx, clientId)
+	s.Assert().NoError(err)
+	s.Assert().Equal(money.FromCents(3000), balance)
+}
+
+func (s *DebtRepositorySuiteTest) TestShouldRefuseSettlementOverTheBalanceLeftByAnotherOne() {
+	repo := gorm.NewGormDebtRepository(gormDB)
+	wallets := clientGorm.NewGormWalletRepository(gormDB)
+	tenantId, _ := shared.TenantFromContext(tenantCtx)
+	clientId := ulid.Make()
+	dueDate := time.Now().AddDate(0, 0, 30)
+
+	err := gormDB.Exec(`INSERT INTO clients (id, name, last_name, document, entity_type, birth_day, tenant_id)
+		VALUES (?, 'John', 'Doe', '61824136030', 'PF', '2000-01-01', ?)`,
+		clientId.String(), tenantId.String()).Error
+	s.Require().NoError(err)
+
+	err = wallets.Credit(tenantCtx, clientId, money.FromCents(5000), "overpayment")
+	s.Require().NoError(err)
+
+	newDebt := func() *debt.Debt {
+		return &debt.Debt{
+			Id:           ulid.Make(),
+			Description:  "Test Debt",
+			TotalValue:   money.FromCents(3000),
+			DueDate:      &dueDate,
+			UserClientId: clientId,
+			Status:       debt.Pending,
+			Intallments: []debt.Installment{
+				{
+					Id:          ulid.Make(),
+					Description: "First Installment",
+					Value:       money.FromCents(3000),
+					DueDate:     &dueDate,
+					Status:      debt.Pending,
+					Number:      1,
+				},
+			},
+		}
+	}
+
+	first, second := newDebt(), newDebt()
+	s.Require().NoError(repo.Save(tenantCtx, first))
+	s.Require().NoError(repo.Save(tenantCtx, second))
+
+	// Both settlements were checked against the balance of 50.00 before
+	// either was written.
+	settle := func(d *debt.Debt) error {
+		d.Intallments[0].Status = debt.Paid
+		return repo.UpdateWithPayment(tenantCtx, d, debt.PaymentWrite{
+			Wallet: &debt.WalletChange{Type: client.DebitEntry, Amount: money.FromCents(3000), Description: "settlement"},
+		})
+	}
+
+	s.Require().NoError(settle(first))
+
+	err = settle(second)
+	s.Assert().ErrorIs(err, client.ErrInsufficientCredit)
+
+	savedDebt, err := repo.GetDebt(tenantCtx, second.Id)
+	s.Require().NoError(err)
+	s.Assert().Equal(debt.Pending, savedDebt.Intallments[0].Status)
+
+	balance, err := wallets.Balance(tenantCtx, clientId)
+	s.Require().NoError(err)
+	s.Assert().Equal(money.FromCents(2000), balance)
+}
+
+func (s *DebtRepositorySuiteTest) TestShouldStoreTheReceiptAlongWithThePayment() {
+	repo := gorm.NewGormDebtRepository(gormDB)
+	receipts := receiptGorm.NewGormReceiptRepository(gormDB)
+	tenantId, _ := shared.TenantFromContext(tenantCtx)
+	clientId := ulid.Make()
+	dueDate := time.Now().AddDate(0, 0, 30)
+
+	err := gormDB.Exec(`INSERT INTO clients (id, name, last_name, document, entity_type, birth_day, tenant_id)
+		VALUES (?, 'John', 'Doe', '61824136030', 'PF', '2000-01-01', ?)`,
+		clientId.String(), tenantId.String()).Error
+	s.Require().NoError(err)
+
+	d := &debt.Debt{
+		Id:           ulid.Make(),
+		Description:  "Test Debt",
+		TotalValue:   money.FromCents(10000),
+		DueDate:      &dueDate,
+		UserClientId: clientId,
+		Status:       debt.Pending,
+		Intallments: []debt.Installment{
+			{
+				Id:          ulid.Make(),
+				Description: "First Installment",
+				Value:       money.FromCents(10000),
+				DueDate:     &dueDate,
+				Status:      debt.Pending,
+				Number:      1,
+			},
+		},
+	}
+	err = repo.Save(tenantCtx, d)
+	s.Require().NoError(err)
+
+	newReceipt := func() *receipt.Receipt {
+		return receipt.New(receipt.Payment{
+			Id:                ulid.Make(),
+			DebtId:            d.Id,
+			InstallmentId:     d.Intallments[0].Id,
+			ClientId:          clientId,
+			Description:       d.Description,
+			InstallmentNumber: 1,
+			Installments:      1,
+			Amount:            money.FromCents(10000),
+			Method:            "cash",
+			PaidAt:            time.Now(),
+		}, receipt.Party{Name: "Maria Silva"}, receipt.Party{Name: "John Doe"}, time.Now())
+	}
+
+	d.Intallments[0].Status = debt.Paid
+	refused := newReceipt()
+	err = repo.UpdateWithPayment(tenantCtx, d, debt.PaymentWrite{
+		Wallet:  &debt.WalletChange{Type: client.DebitEntry, Amount: money.FromCents(10000), Description: "settlement"},
+		Receipt: refused,
+	})
+	s.Assert().EqualError(err, "insufficient credit balance")
+
+	found, err := receipts.FindById(tenantCtx, refused.Id)
+	s.Require().NoError(err)
+	s.Assert().Nil(found)
+
+	issued := newReceipt()
+	err = repo.UpdateWithPayment(tenantCtx, d, debt.PaymentWrite{Receipt: issued})
+	s.Require().NoError(err)
+	s.Assert().Equal(int64(1), issued.Number)
+
+	found, err = receipts.FindById(tenantCtx, issued.Id)
+	s.Require().NoError(err)
+	s.Require().NotNil(found)
+	s.Assert().Equal(int64(1), found.Number)
+
+	savedDebt, err := repo.GetDebt(tenantCtx, d.Id)
+	s.Require().NoError(err)
+	s.Assert().Equal(debt.Paid, savedDebt.Intallments[0].Status)
+}
@@ -0,0 +1,100 @@
+package debt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+)
+
+type itemType int
+
+const (
+	ProductItem itemType = iota
+	ServiceItem
+)
+
+var itemTypeString = map[itemType]string{
+	ProductItem: "product",
+	ServiceItem: "service",
+}
+
+var ItemTypeValue = map[string]itemType{
+	"product": ProductItem,
+	"service": ServiceItem,
+}
+
+func (t itemType) String() string {
+	if int(t) >= 0 && int(t) < len(itemTypeString) {
+		return itemTypeString[t]
+	}
+
+	return "unknown"
+}
+
+func (t *itemType) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return err
+	}
+
+	if val, ok := ItemTypeValue[str]; ok {
+		*t = val
+		return nil
+	}
+
+	return fmt.Errorf("invalid item type: %s", str)
+}
+
+// DebtItem is a product or service sold in a debt. Description and UnitPrice
+// are a snapshot taken at the moment of the sale, so later changes to the
+// product or service do not change what was charged.
+type DebtItem struct {
+	Id          ulid.ULID
+	Type        itemType
+	ReferenceId ulid.ULID
+	Description string
+	Quantity    int
+	UnitPrice   money.Money
+	Discount    money.Money
+	Total       money.Money
+}
+
+func (i *DebtItem) Validate() error {
+	if i.ReferenceId == (ulid.ULID{}) {
+		return errors.New("item must reference a product or service")
+	}
+
+	if i.Description == "" {
+		return errors.New("item description is required")
+	}
+
+	if i.Quantity <= 0 {
+		return errors.New("item quantity must be greater than 0")
+	}
+
+	if i.UnitPrice.IsNegative() {
+		return errors.New("item unit price must not be negative")
+	}
+
+	if i.Discount.IsNegative() {
+		return errors.New("item discount must not be negative")
+	}
+
+	if i.Discount.GreaterThan(i.Subtotal()) {
+		return errors.New("item discount must not be greater than its subtotal")
+	}
+
+	return nil
+}
+
+// Subtotal is the quantity times the unit price, before the discount.
+func (i *DebtItem) Subtotal() money.Money {
+	return i.UnitPrice.Mul(int64(i.Quantity))
+}
+
+func (i *DebtItem) CalculateTotal() {
+	i.Total = i.Subtotal().Sub(i.Discount)
+}
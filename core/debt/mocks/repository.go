@@ -12,8 +12,13 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	client "github.com/henriquerocha2004/quem-me-deve-api/core/client"
 	debt "github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	receipt "github.com/henriquerocha2004/quem-me-deve-api/core/receipt"
+	user "github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	money "github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
 	paginate "github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
 	ulid "github.com/oklog/ulid/v2"
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +78,21 @@ func (mr *MockReaderMockRecorder) DebtInstallments(ctx, debtId any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DebtInstallments", reflect.TypeOf((*MockReader)(nil).DebtInstallments), ctx, debtId)
 }
 
+// DueSoonInstallments mocks base method.
+func (m *MockReader) DueSoonInstallments(ctx context.Context, from, to time.Time) ([]*debt.TenantInstallment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DueSoonInstallments", ctx, from, to)
+	ret0, _ := ret[0].([]*debt.TenantInstallment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DueSoonInstallments indicates an expected call of DueSoonInstallments.
+func (mr *MockReaderMockRecorder) DueSoonInstallments(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DueSoonInstallments", reflect.TypeOf((*MockReader)(nil).DueSoonInstallments), ctx, from, to)
+}
+
 // GetDebt mocks base method.
 func (m *MockReader) GetDebt(ctx context.Context, debtId ulid.ULID) (*debt.Debt, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +123,66 @@ func (mr *MockReaderMockRecorder) GetDebts(ctx, pagData any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebts", reflect.TypeOf((*MockReader)(nil).GetDebts), ctx, pagData)
 }
 
+// InstallmentDebt mocks base method.
+func (m *MockReader) InstallmentDebt(ctx context.Context, installmentId ulid.ULID) (*debt.Debt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallmentDebt", ctx, installmentId)
+	ret0, _ := ret[0].(*debt.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstallmentDebt indicates an expected call of InstallmentDebt.
+func (mr *MockReaderMockRecorder) InstallmentDebt(ctx, installmentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallmentDebt", reflect.TypeOf((*MockReader)(nil).InstallmentDebt), ctx, installmentId)
+}
+
+// OriginDebt mocks base method.
+func (m *MockReader) OriginDebt(ctx context.Context, renegotiatedDebtId ulid.ULID) (*debt.Debt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OriginDebt", ctx, renegotiatedDebtId)
+	ret0, _ := ret[0].(*debt.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OriginDebt indicates an expected call of OriginDebt.
+func (mr *MockReaderMockRecorder) OriginDebt(ctx, renegotiatedDebtId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OriginDebt", reflect.TypeOf((*MockReader)(nil).OriginDebt), ctx, renegotiatedDebtId)
+}
+
+// OverdueInstallments mocks base method.
+func (m *MockReader) OverdueInstallments(ctx context.Context, ref time.Time) ([]*debt.OverdueInstallment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverdueInstallments", ctx, ref)
+	ret0, _ := ret[0].([]*debt.OverdueInstallment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OverdueInstallments indicates an expected call of OverdueInstallments.
+func (mr *MockReaderMockRecorder) OverdueInstallments(ctx, ref any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverdueInstallments", reflect.TypeOf((*MockReader)(nil).OverdueInstallments), ctx, ref)
+}
+
+// RenegotiatedDebt mocks base method.
+func (m *MockReader) RenegotiatedDebt(ctx context.Context, originDebtId ulid.ULID) (*debt.Debt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenegotiatedDebt", ctx, originDebtId)
+	ret0, _ := ret[0].(*debt.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenegotiatedDebt indicates an expected call of RenegotiatedDebt.
+func (mr *MockReaderMockRecorder) RenegotiatedDebt(ctx, originDebtId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenegotiatedDebt", reflect.TypeOf((*MockReader)(nil).RenegotiatedDebt), ctx, originDebtId)
+}
+
 // MockWriter is a mock of Writer interface.
 type MockWriter struct {
 	ctrl     *gomock.Controller
@@ -127,6 +207,21 @@ func (m *MockWriter) EXPECT() *MockWriterMockRecorder {
 	return m.recorder
 }
 
+// MarkOverdue mocks base method.
+func (m *MockWriter) MarkOverdue(ctx context.Context, ref time.Time) ([]*debt.TenantInstallment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOverdue", ctx, ref)
+	ret0, _ := ret[0].([]*debt.TenantInstallment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkOverdue indicates an expected call of MarkOverdue.
+func (mr *MockWriterMockRecorder) MarkOverdue(ctx, ref any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOverdue", reflect.TypeOf((*MockWriter)(nil).MarkOverdue), ctx, ref)
+}
+
 // Save mocks base method.
 func (m *MockWriter) Save(ctx context.Context, arg1 *debt.Debt) error {
 	m.ctrl.T.Helper()
@@ -141,6 +236,20 @@ func (mr *MockWriterMockRecorder) Save(ctx, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockWriter)(nil).Save), ctx, arg1)
 }
 
+// SaveRenegotiation mocks base method.
+func (m *MockWriter) SaveRenegotiation(ctx context.Context, original, renegotiated *debt.Debt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRenegotiation", ctx, original, renegotiated)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRenegotiation indicates an expected call of SaveRenegotiation.
+func (mr *MockWriterMockRecorder) SaveRenegotiation(ctx, original, renegotiated any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRenegotiation", reflect.TypeOf((*MockWriter)(nil).SaveRenegotiation), ctx, original, renegotiated)
+}
+
 // Update mocks base method.
 func (m *MockWriter) Update(ctx context.Context, arg1 *debt.Debt) error {
 	m.ctrl.T.Helper()
@@ -155,6 +264,20 @@ func (mr *MockWriterMockRecorder) Update(ctx, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWriter)(nil).Update), ctx, arg1)
 }
 
+// UpdateWithPayment mocks base method.
+func (m *MockWriter) UpdateWithPayment(ctx context.Context, arg1 *debt.Debt, write debt.PaymentWrite) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithPayment", ctx, arg1, write)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWithPayment indicates an expected call of UpdateWithPayment.
+func (mr *MockWriterMockRecorder) UpdateWithPayment(ctx, arg1, write any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithPayment", reflect.TypeOf((*MockWriter)(nil).UpdateWithPayment), ctx, arg1, write)
+}
+
 // MockRepository is a mock of Repository interface.
 type MockRepository struct {
 	ctrl     *gomock.Controller
@@ -209,6 +332,21 @@ func (mr *MockRepositoryMockRecorder) DebtInstallments(ctx, debtId any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DebtInstallments", reflect.TypeOf((*MockRepository)(nil).DebtInstallments), ctx, debtId)
 }
 
+// DueSoonInstallments mocks base method.
+func (m *MockRepository) DueSoonInstallments(ctx context.Context, from, to time.Time) ([]*debt.TenantInstallment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DueSoonInstallments", ctx, from, to)
+	ret0, _ := ret[0].([]*debt.TenantInstallment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DueSoonInstallments indicates an expected call of DueSoonInstallments.
+func (mr *MockRepositoryMockRecorder) DueSoonInstallments(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DueSoonInstallments", reflect.TypeOf((*MockRepository)(nil).DueSoonInstallments), ctx, from, to)
+}
+
 // GetDebt mocks base method.
 func (m *MockRepository) GetDebt(ctx context.Context, debtId ulid.ULID) (*debt.Debt, error) {
 	m.ctrl.T.Helper()
@@ -239,6 +377,81 @@ func (mr *MockRepositoryMockRecorder) GetDebts(ctx, pagData any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebts", reflect.TypeOf((*MockRepository)(nil).GetDebts), ctx, pagData)
 }
 
+// InstallmentDebt mocks base method.
+func (m *MockRepository) InstallmentDebt(ctx context.Context, installmentId ulid.ULID) (*debt.Debt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallmentDebt", ctx, installmentId)
+	ret0, _ := ret[0].(*debt.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstallmentDebt indicates an expected call of InstallmentDebt.
+func (mr *MockRepositoryMockRecorder) InstallmentDebt(ctx, installmentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallmentDebt", reflect.TypeOf((*MockRepository)(nil).InstallmentDebt), ctx, installmentId)
+}
+
+// MarkOverdue mocks base method.
+func (m *MockRepository) MarkOverdue(ctx context.Context, ref time.Time) ([]*debt.TenantInstallment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOverdue", ctx, ref)
+	ret0, _ := ret[0].([]*debt.TenantInstallment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkOverdue indicates an expected call of MarkOverdue.
+func (mr *MockRepositoryMockRecorder) MarkOverdue(ctx, ref any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOverdue", reflect.TypeOf((*MockRepository)(nil).MarkOverdue), ctx, ref)
+}
+
+// OriginDebt mocks base method.
+func (m *MockRepository) OriginDebt(ctx context.Context, renegotiatedDebtId ulid.ULID) (*debt.Debt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OriginDebt", ctx, renegotiatedDebtId)
+	ret0, _ := ret[0].(*debt.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OriginDebt indicates an expected call of OriginDebt.
+func (mr *MockRepositoryMockRecorder) OriginDebt(ctx, renegotiatedDebtId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OriginDebt", reflect.TypeOf((*MockRepository)(nil).OriginDebt), ctx, renegotiatedDebtId)
+}
+
+// OverdueInstallments mocks base method.
+func (m *MockRepository) OverdueInstallments(ctx context.Context, ref time.Time) ([]*debt.OverdueInstallment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverdueInstallments", ctx, ref)
+	ret0, _ := ret[0].([]*debt.OverdueInstallment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OverdueInstallments indicates an expected call of OverdueInstallments.
+func (mr *MockRepositoryMockRecorder) OverdueInstallments(ctx, ref any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverdueInstallments", reflect.TypeOf((*MockRepository)(nil).OverdueInstallments), ctx, ref)
+}
+
+// RenegotiatedDebt mocks base method.
+func (m *MockRepository) RenegotiatedDebt(ctx context.Context, originDebtId ulid.ULID) (*debt.Debt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenegotiatedDebt", ctx, originDebtId)
+	ret0, _ := ret[0].(*debt.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenegotiatedDebt indicates an expected call of RenegotiatedDebt.
+func (mr *MockRepositoryMockRecorder) RenegotiatedDebt(ctx, originDebtId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenegotiatedDebt", reflect.TypeOf((*MockRepository)(nil).RenegotiatedDebt), ctx, originDebtId)
+}
+
 // Save mocks base method.
 func (m *MockRepository) Save(ctx context.Context, arg1 *debt.Debt) error {
 	m.ctrl.T.Helper()
@@ -253,6 +466,20 @@ func (mr *MockRepositoryMockRecorder) Save(ctx, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockRepository)(nil).Save), ctx, arg1)
 }
 
+// SaveRenegotiation mocks base method.
+func (m *MockRepository) SaveRenegotiation(ctx context.Context, original, renegotiated *debt.Debt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRenegotiation", ctx, original, renegotiated)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRenegotiation indicates an expected call of SaveRenegotiation.
+func (mr *MockRepositoryMockRecorder) SaveRenegotiation(ctx, original, renegotiated any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRenegotiation", reflect.TypeOf((*MockRepository)(nil).SaveRenegotiation), ctx, original, renegotiated)
+}
+
 // Update mocks base method.
 func (m *MockRepository) Update(ctx context.Context, arg1 *debt.Debt) error {
 	m.ctrl.T.Helper()
@@ -267,6 +494,20 @@ func (mr *MockRepositoryMockRecorder) Update(ctx, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, arg1)
 }
 
+// UpdateWithPayment mocks base method.
+func (m *MockRepository) UpdateWithPayment(ctx context.Context, arg1 *debt.Debt, write debt.PaymentWrite) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithPayment", ctx, arg1, write)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWithPayment indicates an expected call of UpdateWithPayment.
+func (mr *MockRepositoryMockRecorder) UpdateWithPayment(ctx, arg1, write any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithPayment", reflect.TypeOf((*MockRepository)(nil).UpdateWithPayment), ctx, arg1, write)
+}
+
 // MockClientReader is a mock of ClientReader interface.
 type MockClientReader struct {
 	ctrl     *gomock.Controller
@@ -305,3 +546,252 @@ func (mr *MockClientReaderMockRecorder) ClientExists(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientExists", reflect.TypeOf((*MockClientReader)(nil).ClientExists), ctx, id)
 }
+
+// MockCatalogReader is a mock of CatalogReader interface.
+type MockCatalogReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockCatalogReaderMockRecorder is the mock recorder for MockCatalogReader.
+type MockCatalogReaderMockRecorder struct {
+	mock *MockCatalogReader
+}
+
+// NewMockCatalogReader creates a new mock instance.
+func NewMockCatalogReader(ctrl *gomock.Controller) *MockCatalogReader {
+	mock := &MockCatalogReader{ctrl: ctrl}
+	mock.recorder = &MockCatalogReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogReader) EXPECT() *MockCatalogReaderMockRecorder {
+	return m.recorder
+}
+
+// ActiveProductExists mocks base method.
+func (m *MockCatalogReader) ActiveProductExists(ctx context.Context, id ulid.ULID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveProductExists", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveProductExists indicates an expected call of ActiveProductExists.
+func (mr *MockCatalogReaderMockRecorder) ActiveProductExists(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveProductExists", reflect.TypeOf((*MockCatalogReader)(nil).ActiveProductExists), ctx, id)
+}
+
+// ActiveServiceExists mocks base method.
+func (m *MockCatalogReader) ActiveServiceExists(ctx context.Context, id ulid.ULID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveServiceExists", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveServiceExists indicates an expected call of ActiveServiceExists.
+func (mr *MockCatalogReaderMockRecorder) ActiveServiceExists(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveServiceExists", reflect.TypeOf((*MockCatalogReader)(nil).ActiveServiceExists), ctx, id)
+}
+
+// MockCreditWallet is a mock of CreditWallet interface.
+type MockCreditWallet struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreditWalletMockRecorder
+	isgomock struct{}
+}
+
+// MockCreditWalletMockRecorder is the mock recorder for MockCreditWallet.
+type MockCreditWalletMockRecorder struct {
+	mock *MockCreditWallet
+}
+
+// NewMockCreditWallet creates a new mock instance.
+func NewMockCreditWallet(ctrl *gomock.Controller) *MockCreditWallet {
+	mock := &MockCreditWallet{ctrl: ctrl}
+	mock.recorder = &MockCreditWalletMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreditWallet) EXPECT() *MockCreditWalletMockRecorder {
+	return m.recorder
+}
+
+// Balance mocks base method.
+func (m *MockCreditWallet) Balance(ctx context.Context, clientId ulid.ULID) (money.Money, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Balance", ctx, clientId)
+	ret0, _ := ret[0].(money.Money)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Balance indicates an expected call of Balance.
+func (mr *MockCreditWalletMockRecorder) Balance(ctx, clientId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Balance", reflect.TypeOf((*MockCreditWallet)(nil).Balance), ctx, clientId)
+}
+
+// MockAccountReader is a mock of AccountReader interface.
+type MockAccountReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockAccountReaderMockRecorder is the mock recorder for MockAccountReader.
+type MockAccountReaderMockRecorder struct {
+	mock *MockAccountReader
+}
+
+// NewMockAccountReader creates a new mock instance.
+func NewMockAccountReader(ctrl *gomock.Controller) *MockAccountReader {
+	mock := &MockAccountReader{ctrl: ctrl}
+	mock.recorder = &MockAccountReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountReader) EXPECT() *MockAccountReaderMockRecorder {
+	return m.recorder
+}
+
+// FindById mocks base method.
+func (m *MockAccountReader) FindById(ctx context.Context, id ulid.ULID) (*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockAccountReaderMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockAccountReader)(nil).FindById), ctx, id)
+}
+
+// MockClientFinder is a mock of ClientFinder interface.
+type MockClientFinder struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientFinderMockRecorder
+	isgomock struct{}
+}
+
+// MockClientFinderMockRecorder is the mock recorder for MockClientFinder.
+type MockClientFinderMockRecorder struct {
+	mock *MockClientFinder
+}
+
+// NewMockClientFinder creates a new mock instance.
+func NewMockClientFinder(ctrl *gomock.Controller) *MockClientFinder {
+	mock := &MockClientFinder{ctrl: ctrl}
+	mock.recorder = &MockClientFinderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientFinder) EXPECT() *MockClientFinderMockRecorder {
+	return m.recorder
+}
+
+// FindById mocks base method.
+func (m *MockClientFinder) FindById(ctx context.Context, id ulid.ULID) (*client.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*client.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockClientFinderMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockClientFinder)(nil).FindById), ctx, id)
+}
+
+// MockBoletoNumbers is a mock of BoletoNumbers interface.
+type MockBoletoNumbers struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoletoNumbersMockRecorder
+	isgomock struct{}
+}
+
+// MockBoletoNumbersMockRecorder is the mock recorder for MockBoletoNumbers.
+type MockBoletoNumbersMockRecorder struct {
+	mock *MockBoletoNumbers
+}
+
+// NewMockBoletoNumbers creates a new mock instance.
+func NewMockBoletoNumbers(ctrl *gomock.Controller) *MockBoletoNumbers {
+	mock := &MockBoletoNumbers{ctrl: ctrl}
+	mock.recorder = &MockBoletoNumbersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoletoNumbers) EXPECT() *MockBoletoNumbersMockRecorder {
+	return m.recorder
+}
+
+// OurNumber mocks base method.
+func (m *MockBoletoNumbers) OurNumber(ctx context.Context, installmentId ulid.ULID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OurNumber", ctx, installmentId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OurNumber indicates an expected call of OurNumber.
+func (mr *MockBoletoNumbersMockRecorder) OurNumber(ctx, installmentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OurNumber", reflect.TypeOf((*MockBoletoNumbers)(nil).OurNumber), ctx, installmentId)
+}
+
+// MockReceiptIssuer is a mock of ReceiptIssuer interface.
+type MockReceiptIssuer struct {
+	ctrl     *gomock.Controller
+	recorder *MockReceiptIssuerMockRecorder
+	isgomock struct{}
+}
+
+// MockReceiptIssuerMockRecorder is the mock recorder for MockReceiptIssuer.
+type MockReceiptIssuerMockRecorder struct {
+	mock *MockReceiptIssuer
+}
+
+// NewMockReceiptIssuer creates a new mock instance.
+func NewMockReceiptIssuer(ctrl *gomock.Controller) *MockReceiptIssuer {
+	mock := &MockReceiptIssuer{ctrl: ctrl}
+	mock.recorder = &MockReceiptIssuerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReceiptIssuer) EXPECT() *MockReceiptIssuerMockRecorder {
+	return m.recorder
+}
+
+// Prepare mocks base method.
+func (m *MockReceiptIssuer) Prepare(ctx context.Context, payment receipt.Payment) (*receipt.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Prepare", ctx, payment)
+	ret0, _ := ret[0].(*receipt.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Prepare indicates an expected call of Prepare.
+func (mr *MockReceiptIssuerMockRecorder) Prepare(ctx, payment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prepare", reflect.TypeOf((*MockReceiptIssuer)(nil).Prepare), ctx, payment)
+}
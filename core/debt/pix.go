@@ -0,0 +1,23 @@
+package debt
+
+import "github.com/oklog/ulid/v2"
+
+// PixQRCodeScale is the size in pixels of each module of the Pix QR codes.
+const PixQRCodeScale = 8
+
+// PixTxId returns the txid of the Pix charges of an installment. A txid has
+// up to 25 characters, so the ULID of the installment is written without its
+// first character, which is 0 for every id made before the year 3084.
+func PixTxId(installmentId ulid.ULID) string {
+	return installmentId.String()[1:]
+}
+
+// InstallmentIdFromTxId returns the installment the Pix charge with the txid
+// was made for.
+func InstallmentIdFromTxId(txId string) (ulid.ULID, error) {
+	if len(txId) != ulid.EncodedSize-1 {
+		return ulid.ULID{}, ulid.ErrDataSize
+	}
+
+	return ulid.ParseStrict("0" + txId)
+}
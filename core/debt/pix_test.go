@@ -0,0 +1,30 @@
+package debt_test
+
+import (
+	"testing"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/pix"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPixTxId(t *testing.T) {
+	t.Run("Deve recuperar a parcela pelo txid da cobranca pix", func(t *testing.T) {
+		installmentId := ulid.Make()
+
+		txId := debt.PixTxId(installmentId)
+		assert.LessOrEqual(t, len(txId), pix.MaxTxId)
+
+		parsed, err := debt.InstallmentIdFromTxId(txId)
+		assert.NoError(t, err)
+		assert.Equal(t, installmentId, parsed)
+	})
+
+	t.Run("Deve rejeitar um txid que nao foi gerado para uma parcela", func(t *testing.T) {
+		for _, txId := range []string{"", "***", "TX1", ulid.Make().String(), "UUUUUUUUUUUUUUUUUUUUUUUUU"} {
+			_, err := debt.InstallmentIdFromTxId(txId)
+			assert.Error(t, err, txId)
+		}
+	})
+}
@@ -2,7 +2,12 @@ package debt
 
 import (
 	"context"
+	"time"
 
+	"github.com/henriquerocha2004/quem-me-deve-api/core/client"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/receipt"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
 	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
 	"github.com/oklog/ulid/v2"
 )
@@ -12,11 +17,26 @@ type Reader interface {
 	DebtInstallments(ctx context.Context, debtId ulid.ULID) ([]*Installment, error)
 	GetDebts(ctx context.Context, pagData paginate.SearchDto) (*PaginationResult, error)
 	GetDebt(ctx context.Context, debtId ulid.ULID) (*Debt, error)
+	RenegotiatedDebt(ctx context.Context, originDebtId ulid.ULID) (*Debt, error)
+	OriginDebt(ctx context.Context, renegotiatedDebtId ulid.ULID) (*Debt, error)
+	InstallmentDebt(ctx context.Context, installmentId ulid.ULID) (*Debt, error)
+	OverdueInstallments(ctx context.Context, ref time.Time) ([]*OverdueInstallment, error)
+	// DueSoonInstallments returns, across every tenant, the open installments
+	// not yet overdue due between from and to.
+	DueSoonInstallments(ctx context.Context, from, to time.Time) ([]*TenantInstallment, error)
 }
 
 type Writer interface {
 	Save(ctx context.Context, debt *Debt) error
 	Update(ctx context.Context, debt *Debt) error
+	// UpdateWithPayment writes the debt along with what its payment changed
+	// elsewhere, in a single transaction.
+	UpdateWithPayment(ctx context.Context, debt *Debt, write PaymentWrite) error
+	SaveRenegotiation(ctx context.Context, original, renegotiated *Debt) error
+	// MarkOverdue sets, across every tenant, the status of the installments
+	// open after their due date and of their debts to overdue. It returns the
+	// installments marked.
+	MarkOverdue(ctx context.Context, ref time.Time) ([]*TenantInstallment, error)
 }
 
 type Repository interface {
@@ -27,3 +47,56 @@ type Repository interface {
 type ClientReader interface {
 	ClientExists(ctx context.Context, id ulid.ULID) (bool, error)
 }
+
+type CatalogReader interface {
+	ActiveProductExists(ctx context.Context, id ulid.ULID) (bool, error)
+	ActiveServiceExists(ctx context.Context, id ulid.ULID) (bool, error)
+}
+
+// CreditWallet reads the credit balance of the clients. The wallet is only
+// changed along with the debt, through UpdateWithPayment.
+type CreditWallet interface {
+	Balance(ctx context.Context, clientId ulid.ULID) (money.Money, error)
+}
+
+// WalletChange is a credit to, or a debit from, the wallet of the client of
+// a debt: the surplus of a payment or a settlement with credit.
+type WalletChange struct {
+	Type        client.WalletEntryType
+	Amount      money.Money
+	Description string
+}
+
+// PaymentWrite is what a payment changes besides the debt: the wallet of the
+// client and the receipt of the payment, both optional. The receipt is
+// numbered when it is stored.
+type PaymentWrite struct {
+	Wallet  *WalletChange
+	Receipt *receipt.Receipt
+}
+
+// AccountReader finds the professional that owns the account, who receives
+// the Pix payments of the debts.
+type AccountReader interface {
+	FindById(ctx context.Context, id ulid.ULID) (*user.User, error)
+}
+
+// ClientFinder finds the client charged, printed as the payer of the boletos.
+type ClientFinder interface {
+	FindById(ctx context.Context, id ulid.ULID) (*client.Client, error)
+}
+
+// BoletoNumbers keeps the nosso número of the boletos of each installment,
+// so that every boleto of an installment is told back the same way by the
+// bank.
+type BoletoNumbers interface {
+	// OurNumber returns the nosso número of the installment, taking the next
+	// one of the account the first time a boleto is made for it.
+	OurNumber(ctx context.Context, installmentId ulid.ULID) (int64, error)
+}
+
+// ReceiptIssuer prepares the receipt of each payment received, stored and
+// numbered along with the payment.
+type ReceiptIssuer interface {
+	Prepare(ctx context.Context, payment receipt.Payment) (*receipt.Receipt, error)
+}
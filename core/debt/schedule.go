@@ -0,0 +1,109 @@
+package debt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/holiday"
+)
+
+type scheduleFrequency int
+
+const (
+	MonthlySchedule scheduleFrequency = iota
+	IntervalSchedule
+	WeeklySchedule
+	BiweeklySchedule
+)
+
+var scheduleFrequencyString = map[scheduleFrequency]string{
+	MonthlySchedule:  "monthly",
+	IntervalSchedule: "interval",
+	WeeklySchedule:   "weekly",
+	BiweeklySchedule: "biweekly",
+}
+
+var ScheduleFrequencyValue = map[string]scheduleFrequency{
+	"monthly":  MonthlySchedule,
+	"interval": IntervalSchedule,
+	"weekly":   WeeklySchedule,
+	"biweekly": BiweeklySchedule,
+}
+
+func (f scheduleFrequency) String() string {
+	if int(f) >= 0 && int(f) < len(scheduleFrequencyString) {
+		return scheduleFrequencyString[f]
+	}
+
+	return "unknown"
+}
+
+func (f *scheduleFrequency) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return err
+	}
+
+	if val, ok := ScheduleFrequencyValue[str]; ok {
+		*f = val
+		return nil
+	}
+
+	return fmt.Errorf("invalid schedule frequency: %s", str)
+}
+
+// Schedule decides the due dates of the installments after the first one.
+// Monthly schedules keep the day of the first due date, clamped to the last
+// day of shorter months. With NextBusinessDay set, due dates that fall on a
+// weekend or national holiday move to the next business day.
+type Schedule struct {
+	Frequency       scheduleFrequency
+	IntervalDays    int
+	NextBusinessDay bool
+}
+
+func (s Schedule) Validate() error {
+	if s.Frequency == IntervalSchedule && s.IntervalDays <= 0 {
+		return errors.New("intervalDays must be greater than 0 for interval schedules")
+	}
+
+	return nil
+}
+
+// DueDates returns the due dates of quantity installments, starting at first.
+func (s Schedule) DueDates(first time.Time, quantity int) []time.Time {
+	var dates []time.Time
+
+	for i := range quantity {
+		date := s.nominalDate(first, i)
+		if s.NextBusinessDay {
+			date = holiday.NextBusinessDay(date)
+		}
+
+		dates = append(dates, date)
+	}
+
+	return dates
+}
+
+// nominalDate is always computed from the first due date, so that clamping
+// or a business day shift never drifts into the following installments.
+func (s Schedule) nominalDate(first time.Time, index int) time.Time {
+	switch s.Frequency {
+	case IntervalSchedule:
+		return first.AddDate(0, 0, s.IntervalDays*index)
+	case WeeklySchedule:
+		return first.AddDate(0, 0, 7*index)
+	case BiweeklySchedule:
+		return first.AddDate(0, 0, 14*index)
+	}
+
+	year, month, day := first.Date()
+	target := time.Date(year, month+time.Month(index), 1, 0, 0, 0, 0, first.Location())
+	lastDay := target.AddDate(0, 1, -1).Day()
+
+	return time.Date(target.Year(), target.Month(), min(day, lastDay),
+		first.Hour(), first.Minute(), first.Second(), first.Nanosecond(), first.Location())
+}
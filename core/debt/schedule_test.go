@@ -0,0 +1,61 @@
+package debt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	"github.com/stretchr/testify/assert"
+)
+
+func formatDates(dates []time.Time) []string {
+	var formatted []string
+	for _, date := range dates {
+		formatted = append(formatted, date.Format(time.DateOnly))
+	}
+	return formatted
+}
+
+func TestSchedule(t *testing.T) {
+	t.Run("Deve manter o dia do mes limitando ao ultimo dia dos meses mais curtos", func(t *testing.T) {
+		first := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+		schedule := debt.Schedule{Frequency: debt.MonthlySchedule}
+
+		dates := schedule.DueDates(first, 4)
+
+		assert.Equal(t, []string{"2025-01-31", "2025-02-28", "2025-03-31", "2025-04-30"}, formatDates(dates))
+	})
+
+	t.Run("Deve gerar vencimentos a cada N dias", func(t *testing.T) {
+		first := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+		schedule := debt.Schedule{Frequency: debt.IntervalSchedule, IntervalDays: 10}
+
+		dates := schedule.DueDates(first, 3)
+
+		assert.Equal(t, []string{"2025-03-10", "2025-03-20", "2025-03-30"}, formatDates(dates))
+	})
+
+	t.Run("Deve gerar vencimentos semanais e quinzenais", func(t *testing.T) {
+		first := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+		weekly := debt.Schedule{Frequency: debt.WeeklySchedule}.DueDates(first, 3)
+		biweekly := debt.Schedule{Frequency: debt.BiweeklySchedule}.DueDates(first, 3)
+
+		assert.Equal(t, []string{"2025-03-10", "2025-03-17", "2025-03-24"}, formatDates(weekly))
+		assert.Equal(t, []string{"2025-03-10", "2025-03-24", "2025-04-07"}, formatDates(biweekly))
+	})
+
+	t.Run("Deve mover vencimentos em fins de semana e feriados para o proximo dia util", func(t *testing.T) {
+		first := time.Date(2025, 3, 21, 0, 0, 0, 0, time.UTC)
+		schedule := debt.Schedule{Frequency: debt.MonthlySchedule, NextBusinessDay: true}
+
+		dates := schedule.DueDates(first, 4)
+
+		assert.Equal(t, []string{"2025-03-21", "2025-04-22", "2025-05-21", "2025-06-23"}, formatDates(dates))
+	})
+
+	t.Run("Deve retornar erro para intervalo sem quantidade de dias", func(t *testing.T) {
+		schedule := debt.Schedule{Frequency: debt.IntervalSchedule}
+		assert.EqualError(t, schedule.Validate(), "intervalDays must be greater than 0 for interval schedules")
+	})
+}
@@ -2,11 +2,20 @@ package debt
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/henriquerocha2004/quem-me-deve-api/core/client"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/plan"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/receipt"
 	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/boleto"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
 	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/pix"
 	"github.com/oklog/ulid/v2"
 )
 
@@ -18,56 +27,146 @@ type Service interface {
 	GetDebtInstallments(ctx context.Context, clientId, debtId ulid.ULID) shared.ServiceResponse
 	Debts(ctx context.Context, params paginate.PaginateRequest) shared.ServiceResponse
 	PayInstallment(ctx context.Context, pgInfo *PaymentInfoDto) shared.ServiceResponse
+	SettleWithCredit(ctx context.Context, settlement *CreditSettlementDto) shared.ServiceResponse
+	RenegotiateDebt(ctx context.Context, renegotiation *RenegotiationDto) shared.ServiceResponse
+	OverdueInstallments(ctx context.Context) shared.ServiceResponse
+	InstallmentPix(ctx context.Context, clientId, debtId, installmentId ulid.ULID, dynamic bool) shared.ServiceResponse
+	InstallmentBoleto(ctx context.Context, clientId, debtId, installmentId ulid.ULID) shared.ServiceResponse
 }
 
 type debtService struct {
-	debtRepo   Repository
-	clientRepo ClientReader
+	debtRepo          Repository
+	clientRepo        ClientReader
+	catalog           CatalogReader
+	wallet            CreditWallet
+	limits            plan.LimitChecker
+	events            shared.EventPublisher
+	accounts          AccountReader
+	pixLocation       string
+	clients           ClientFinder
+	boletoNumbers     BoletoNumbers
+	receipts          ReceiptIssuer
+	autoCreditSurplus bool
 }
 
-func NewDebtService(debtRepo Repository, cliRepo ClientReader) *debtService {
+// DueSoonDays is how many days before the due date an installment is
+// announced as due soon.
+const DueSoonDays = 3
+
+func NewDebtService(debtRepo Repository, cliRepo ClientReader, catalog CatalogReader, wallet CreditWallet) *debtService {
 	return &debtService{
 		debtRepo:   debtRepo,
 		clientRepo: cliRepo,
+		catalog:    catalog,
+		wallet:     wallet,
 	}
 }
 
+// WithAutoCreditSurplus makes every overpayment go to the client credit
+// wallet, without the operator having to ask for it on each payment.
+func (s *debtService) WithAutoCreditSurplus(enabled bool) *debtService {
+	s.autoCreditSurplus = enabled
+	return s
+}
+
+// WithPlanLimits makes debts be created only while the account is below the
+// debt limit of its plan.
+func (s *debtService) WithPlanLimits(limits plan.LimitChecker) *debtService {
+	s.limits = limits
+	return s
+}
+
+// WithEvents publishes the payments received, the debts paid and the
+// installments due soon or overdue to the publisher.
+func (s *debtService) WithEvents(events shared.EventPublisher) *debtService {
+	s.events = events
+	return s
+}
+
+// WithPix lets the installments be charged by Pix, to the key of the
+// professional that owns the account. Dynamic charges are served by the PSP at
+// pixLocation followed by the txid, and are only available when it is set.
+func (s *debtService) WithPix(accounts AccountReader, pixLocation string) *debtService {
+	s.accounts = accounts
+	s.pixLocation = strings.TrimSuffix(pixLocation, "/")
+	return s
+}
+
+// WithBoleto lets the installments be charged by boleto, to the bank account
+// of the professional that owns the account, with the client as the payer.
+func (s *debtService) WithBoleto(accounts AccountReader, clients ClientFinder, numbers BoletoNumbers) *debtService {
+	s.accounts = accounts
+	s.clients = clients
+	s.boletoNumbers = numbers
+	return s
+}
+
+// WithReceipts issues a numbered receipt for every installment payment.
+func (s *debtService) WithReceipts(receipts ReceiptIssuer) *debtService {
+	s.receipts = receipts
+	return s
+}
+
 func (s *debtService) CreateDebt(ctx context.Context, d *DebtDto) shared.ServiceResponse {
+	release, response, reached := s.reservePlanLimit(ctx)
+	if reached {
+		return response
+	}
+	defer release()
+
 	now := time.Now()
 	dueDate, _ := time.Parse(time.DateOnly, d.DueDate)
 	clientUserId, _ := ulid.Parse(d.UserClientId)
 
-	serviceIds, err := s.putServiceIds(d.ServiceIds)
+	items, err := s.putItems(d.Items)
 	if err != nil {
-		log.Println("Error parsing service IDs:", err)
+		log.Println("Error parsing debt items:", err)
 		return shared.ServiceResponse{
 			Status:  "error",
-			Message: "invalid service IDs",
+			Message: "invalid product or service IDs",
 		}
 	}
 
-	productIds, err := s.putProductIds(d.ProductIds)
+	plannedInstallments, err := s.putPlannedInstallments(d.CustomInstallments)
 	if err != nil {
-		log.Println("Error parsing product IDs:", err)
+		log.Println("Error parsing custom installments:", err)
 		return shared.ServiceResponse{
 			Status:  "error",
-			Message: "invalid product IDs",
+			Message: "invalid custom installments",
 		}
 	}
 
 	debt := &Debt{
 		Description:          d.Description,
 		Id:                   ulid.Make(),
-		TotalValue:           d.TotalValue,
 		DueDate:              &dueDate,
 		Status:               Pending,
 		UserClientId:         clientUserId,
 		InstallmentsQuantity: d.InstallmentsQuantity,
-		ServiceIds:           serviceIds,
-		ProductIds:           productIds,
+		Items:                items,
 		DebtDate:             &now,
+		ChargePolicy: ChargePolicy{
+			FinePercentage: d.FinePercentage,
+			InterestRate:   d.InterestRate,
+			InterestPeriod: InterestPeriodValue[d.InterestPeriod],
+		},
+		Schedule: Schedule{
+			Frequency:       ScheduleFrequencyValue[d.Schedule],
+			IntervalDays:    d.IntervalDays,
+			NextBusinessDay: d.NextBusinessDay,
+		},
+		PlannedInstallments: plannedInstallments,
 	}
 
+	if d.DownPayment.IsPositive() {
+		debt.DownPayment = &DownPayment{
+			Amount:        d.DownPayment,
+			PaymentMethod: d.DownPaymentMethod,
+		}
+	}
+
+	debt.CalculateTotalValue()
+
 	validationErrors := debt.Validate()
 	if len(validationErrors.Errors) > 0 {
 		log.Println("Validation errors:", validationErrors)
@@ -78,6 +177,10 @@ func (s *debtService) CreateDebt(ctx context.Context, d *DebtDto) shared.Service
 		}
 	}
 
+	if response, ok := s.checkCatalogItems(ctx, debt.Items); !ok {
+		return response
+	}
+
 	err = debt.GenerateInstallments()
 	if err != nil {
 		log.Println("Error generating installments:", err)
@@ -87,6 +190,8 @@ func (s *debtService) CreateDebt(ctx context.Context, d *DebtDto) shared.Service
 		}
 	}
 
+	debt.RegisterSale()
+
 	err = s.debtRepo.Save(ctx, debt)
 	if err != nil {
 		log.Println("Error saving debt:", err)
@@ -203,6 +308,57 @@ func (s *debtService) ReverseDebt(ctx context.Context, reverseInfo *ReversalInfo
 	}
 }
 
+func (s *debtService) RenegotiateDebt(ctx context.Context, renegotiation *RenegotiationDto) shared.ServiceResponse {
+	debtId, err := ulid.Parse(renegotiation.DebtId)
+	if err != nil {
+		log.Println("Error parsing debt ID:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "invalid debt ID",
+		}
+	}
+
+	debt, err := s.debtRepo.GetDebt(ctx, debtId)
+	if err != nil {
+		log.Println("Error retrieving debt:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error retrieving debt",
+		}
+	}
+
+	if debt == nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "debt not found",
+		}
+	}
+
+	renegotiated, err := debt.Renegotiate(renegotiation)
+	if err != nil {
+		log.Println("Error renegotiating debt:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: err.Error(),
+		}
+	}
+
+	err = s.debtRepo.SaveRenegotiation(ctx, debt, renegotiated)
+	if err != nil {
+		log.Println("Error saving renegotiation:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error saving renegotiation",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "debt renegotiated successfully",
+		Data:    s.convertToDebtDto([]*Debt{renegotiated})[0],
+	}
+}
+
 func (s *debtService) GetUserDebts(ctx context.Context, userId ulid.ULID) shared.ServiceResponse {
 	debts, err := s.debtRepo.ClientUserDebts(ctx, userId)
 	if err != nil {
@@ -258,23 +414,7 @@ func (s *debtService) GetDebtInstallments(ctx context.Context, clientId, debtId
 	var installmentsDto []InstallmentDto
 
 	for _, installment := range installments {
-		paymentDate := ""
-
-		if installment.PaymentDate != nil {
-			paymentDate = installment.PaymentDate.Format(time.DateOnly)
-		}
-
-		installmentsDto = append(installmentsDto, InstallmentDto{
-			Id:            installment.Id.String(),
-			Description:   installment.Description,
-			Value:         installment.Value,
-			DueDate:       installment.DueDate.Format(time.DateOnly),
-			DebDate:       installment.DebDate.Format(time.DateOnly),
-			Status:        installment.Status.String(),
-			PaymentDate:   paymentDate,
-			PaymentMethod: installment.PaymentMethod,
-			Number:        installment.Number,
-		})
+		installmentsDto = append(installmentsDto, s.convertToInstallmentDto(installment))
 	}
 
 	return shared.ServiceResponse{
@@ -284,6 +424,335 @@ func (s *debtService) GetDebtInstallments(ctx context.Context, clientId, debtId
 	}
 }
 
+// OverdueInstallments lists the installments past their due date from every
+// client of the account, the oldest first.
+func (s *debtService) OverdueInstallments(ctx context.Context) shared.ServiceResponse {
+	now := time.Now()
+
+	installments, err := s.debtRepo.OverdueInstallments(ctx, now)
+	if err != nil {
+		log.Println("Error retrieving overdue installments:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error retrieving overdue installments",
+		}
+	}
+
+	installmentsDto := []OverdueInstallmentDto{}
+	for _, installment := range installments {
+		installmentDto := s.convertToInstallmentDto(&installment.Installment)
+		installmentDto.Status = Overdue.String()
+
+		installmentsDto = append(installmentsDto, OverdueInstallmentDto{
+			InstallmentDto: installmentDto,
+			DebtId:         installment.DebtId.String(),
+			ClientId:       installment.UserClientId.String(),
+			DaysLate:       daysBetween(installment.DueDate, now),
+		})
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "overdue installments retrieved",
+		Data:    installmentsDto,
+	}
+}
+
+// InstallmentPix writes the Pix charge of the balance of an open installment,
+// as the BR Code payload and its QR code.
+func (s *debtService) InstallmentPix(ctx context.Context, clientId, debtId, installmentId ulid.ULID, dynamic bool) shared.ServiceResponse {
+	if s.accounts == nil || (dynamic && s.pixLocation == "") {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "pix charges are not available",
+		}
+	}
+
+	debt, installment, response, ok := s.openInstallment(ctx, clientId, debtId, installmentId)
+	if !ok {
+		return response
+	}
+
+	amount, err := debt.InstallmentBalance(installmentId.String(), time.Now())
+	if err != nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: err.Error(),
+		}
+	}
+
+	tenantId, _ := shared.TenantFromContext(ctx)
+	account, err := s.accounts.FindById(ctx, tenantId)
+	if err != nil {
+		log.Println("Error retrieving account:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error retrieving pix account",
+		}
+	}
+
+	if account == nil || account.Pix == nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "pix account not set",
+		}
+	}
+
+	txId := PixTxId(installmentId)
+	charge := pix.Payload{
+		Key:          account.Pix.Key,
+		MerchantName: account.Pix.MerchantName,
+		MerchantCity: account.Pix.MerchantCity,
+		Amount:       amount,
+		TxId:         txId,
+		Description:  fmt.Sprintf("Parcela %d", installment.Number),
+	}
+
+	if dynamic {
+		charge.Key = ""
+		charge.URL = s.pixLocation + "/" + txId
+	}
+
+	payload, err := charge.Encode()
+	if err != nil {
+		log.Println("Error encoding pix payload:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error generating pix charge",
+		}
+	}
+
+	qrCode, err := pix.QRCode(payload, PixQRCodeScale)
+	if err != nil {
+		log.Println("Error encoding pix qr code:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error generating pix charge",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "pix charge generated",
+		Data: InstallmentPixDto{
+			TxId:    txId,
+			Amount:  amount,
+			Payload: payload,
+			QRCode:  qrCode,
+		},
+	}
+}
+
+// InstallmentBoleto writes the boleto of the balance of an open installment,
+// with the client as the payer, as its barcode, digitable line and printable
+// slip.
+func (s *debtService) InstallmentBoleto(ctx context.Context, clientId, debtId, installmentId ulid.ULID) shared.ServiceResponse {
+	if s.accounts == nil || s.clients == nil || s.boletoNumbers == nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "boletos are not available",
+		}
+	}
+
+	debt, installment, response, ok := s.openInstallment(ctx, clientId, debtId, installmentId)
+	if !ok {
+		return response
+	}
+
+	now := time.Now()
+	amount, err := debt.InstallmentBalance(installmentId.String(), now)
+	if err != nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: err.Error(),
+		}
+	}
+
+	tenantId, _ := shared.TenantFromContext(ctx)
+	account, err := s.accounts.FindById(ctx, tenantId)
+	if err != nil {
+		log.Println("Error retrieving account:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error retrieving boleto account",
+		}
+	}
+
+	if account == nil || account.Boleto == nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "boleto account not set",
+		}
+	}
+
+	bank, err := boleto.NewBank(account.Boleto.Account)
+	if err != nil {
+		log.Println("Error reading boleto account:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "boleto account not set",
+		}
+	}
+
+	payer, err := s.clients.FindById(ctx, clientId)
+	if err != nil || payer == nil {
+		log.Println("Error retrieving client:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error retrieving client",
+		}
+	}
+
+	ourNumber, err := s.boletoNumbers.OurNumber(ctx, installmentId)
+	if err != nil {
+		log.Println("Error taking boleto number:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error generating boleto",
+		}
+	}
+
+	slip := boleto.Boleto{
+		Bank:           bank,
+		OurNumber:      ourNumber,
+		DocumentNumber: fmt.Sprintf("%d/%d", installment.Number, len(debt.Intallments)),
+		IssuedAt:       now,
+		DueDate:        boletoDueDate(installment, now),
+		Amount:         amount,
+		Beneficiary: boleto.Beneficiary{
+			Name:     account.Boleto.BeneficiaryName,
+			Document: account.Boleto.BeneficiaryDocument,
+		},
+		Payer:        boletoPayer(payer),
+		Instructions: boletoInstructions(debt, installment),
+	}
+
+	barcode, err := slip.Barcode()
+	if err != nil {
+		log.Println("Error writing boleto barcode:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error generating boleto",
+		}
+	}
+
+	image, err := barcode.PNG(BoletoBarcodeScale, BoletoBarcodeHeight)
+	if err != nil {
+		log.Println("Error encoding boleto barcode:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error generating boleto",
+		}
+	}
+
+	document, err := slip.PDF()
+	if err != nil {
+		log.Println("Error writing boleto slip:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error generating boleto",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "boleto generated",
+		Data: InstallmentBoletoDto{
+			OurNumber:     bank.OurNumber(ourNumber),
+			Barcode:       string(barcode),
+			DigitableLine: barcode.FormattedDigitableLine(),
+			Amount:        amount,
+			DueDate:       slip.DueDate.Format(time.DateOnly),
+			BarcodeImage:  image,
+			PDF:           document,
+		},
+	}
+}
+
+// openInstallment finds the open installment of an open debt of the client,
+// to be charged.
+func (s *debtService) openInstallment(ctx context.Context, clientId, debtId, installmentId ulid.ULID) (*Debt, *Installment, shared.ServiceResponse, bool) {
+	debt, err := s.debtRepo.GetDebt(ctx, debtId)
+	if err != nil {
+		log.Println("Error retrieving debt:", err)
+		return nil, nil, shared.ServiceResponse{
+			Status:  "error",
+			Message: "error retrieving debt",
+		}, false
+	}
+
+	if debt == nil || debt.UserClientId != clientId {
+		return nil, nil, shared.ServiceResponse{
+			Status:  "error",
+			Message: "debt not found",
+		}, false
+	}
+
+	if !debt.isOpen() {
+		return nil, nil, shared.ServiceResponse{
+			Status:  "error",
+			Message: "debt is not open for payment",
+		}, false
+	}
+
+	var installment *Installment
+	for i := range debt.Intallments {
+		if debt.Intallments[i].Id == installmentId {
+			installment = &debt.Intallments[i]
+		}
+	}
+
+	if installment == nil {
+		return nil, nil, shared.ServiceResponse{
+			Status:  "error",
+			Message: "installment not found",
+		}, false
+	}
+
+	if !installment.IsOpen() {
+		return nil, nil, shared.ServiceResponse{
+			Status:  "error",
+			Message: "installment is not open for payment",
+		}, false
+	}
+
+	return debt, installment, shared.ServiceResponse{}, true
+}
+
+// MarkOverdue is run by the overdue job, for every account.
+func (s *debtService) MarkOverdue(ctx context.Context, now time.Time) error {
+	marked, err := s.debtRepo.MarkOverdue(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	if len(marked) > 0 {
+		log.Println("Installments marked as overdue:", len(marked))
+	}
+
+	for _, installment := range marked {
+		s.publishInstallment(ctx, shared.InstallmentOverdue, installment, now)
+	}
+
+	return nil
+}
+
+// NotifyDueSoon is run by the due soon job, for every account. An
+// installment is announced on every run until its due date: the subscribers
+// keep only the first.
+func (s *debtService) NotifyDueSoon(ctx context.Context, now time.Time) error {
+	installments, err := s.debtRepo.DueSoonInstallments(ctx, now, now.AddDate(0, 0, DueSoonDays))
+	if err != nil {
+		return err
+	}
+
+	for _, installment := range installments {
+		s.publishInstallment(ctx, shared.InstallmentDueSoon, installment, now)
+	}
+
+	return nil
+}
+
 func (s *debtService) Debts(ctx context.Context, params paginate.PaginateRequest) shared.ServiceResponse {
 	pagDto := paginate.SearchDto{
 		Limit:         params.Limit,
@@ -343,7 +812,15 @@ func (s *debtService) PayInstallment(ctx context.Context, pgInfo *PaymentInfoDto
 		}
 	}
 
-	err = debt.PayInstallment(pgInfo)
+	if pgInfo.Reference != "" && debt.HasPayment(pgInfo.Reference) {
+		return shared.ServiceResponse{
+			Status:  "success",
+			Message: "payment already registered",
+			Data:    PaymentResultDto{},
+		}
+	}
+
+	surplus, err := s.installmentSurplus(debt, pgInfo)
 	if err != nil {
 		log.Println("Error paying installment:", err)
 		return shared.ServiceResponse{
@@ -352,7 +829,44 @@ func (s *debtService) PayInstallment(ctx context.Context, pgInfo *PaymentInfoDto
 		}
 	}
 
-	err = s.debtRepo.Update(ctx, debt)
+	payInfo := *pgInfo
+	if surplus.IsPositive() {
+		payInfo.Amount = pgInfo.Amount.Sub(surplus)
+	}
+
+	err = debt.PayInstallment(&payInfo)
+	if err != nil {
+		log.Println("Error paying installment:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: err.Error(),
+		}
+	}
+
+	issued, err := s.prepareReceipt(ctx, debt, pgInfo)
+	if err != nil {
+		log.Println("Error issuing receipt:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error issuing receipt",
+		}
+	}
+
+	write := PaymentWrite{Receipt: issued}
+	if surplus.IsPositive() {
+		write.Wallet = &WalletChange{
+			Type:        client.CreditEntry,
+			Amount:      surplus,
+			Description: "overpayment of installment " + pgInfo.InstallmentId,
+		}
+	}
+
+	if write.Wallet != nil || write.Receipt != nil {
+		err = s.debtRepo.UpdateWithPayment(ctx, debt, write)
+	} else {
+		err = s.debtRepo.Update(ctx, debt)
+	}
+
 	if err != nil {
 		log.Println("Error updating debt:", err)
 		return shared.ServiceResponse{
@@ -361,72 +875,411 @@ func (s *debtService) PayInstallment(ctx context.Context, pgInfo *PaymentInfoDto
 		}
 	}
 
+	s.publishPayment(ctx, debt, pgInfo.InstallmentId, pgInfo.Amount)
+
+	result := PaymentResultDto{CreditedSurplus: surplus}
+	if issued != nil {
+		result.ReceiptId = issued.Id.String()
+		result.ReceiptNumber = issued.Number
+	}
+
 	return shared.ServiceResponse{
 		Status:  "success",
 		Message: "installment paid successfully",
+		Data:    result,
+	}
+}
+
+func (s *debtService) SettleWithCredit(ctx context.Context, settlement *CreditSettlementDto) shared.ServiceResponse {
+	debtId, err := ulid.Parse(settlement.DebtId)
+	if err != nil {
+		log.Println("Error parsing debt ID:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "invalid debt ID",
+		}
+	}
+
+	debt, err := s.debtRepo.GetDebt(ctx, debtId)
+	if err != nil {
+		log.Println("Error retrieving debt:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error retrieving debt",
+		}
+	}
+
+	if debt == nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "debt not found",
+		}
+	}
+
+	balance, err := debt.InstallmentBalance(settlement.InstallmentId, time.Now())
+	if err != nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: err.Error(),
+		}
+	}
+
+	credit, err := s.wallet.Balance(ctx, debt.UserClientId)
+	if err != nil {
+		log.Println("Error retrieving credit balance:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error retrieving credit balance",
+		}
+	}
+
+	amount := settlement.Amount
+	if amount.IsZero() {
+		amount = money.Min(balance, credit)
+	}
+
+	// The balance is checked again under the lock of the wallet, when it is
+	// debited: it may be spent by another settlement in between.
+	if amount.GreaterThan(credit) {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "insufficient credit balance",
+		}
+	}
+
+	if amount.GreaterThan(balance) {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "amount exceeds the installment balance",
+		}
+	}
+
+	err = debt.PayInstallment(&PaymentInfoDto{
+		DebtId:        settlement.DebtId,
+		InstallmentId: settlement.InstallmentId,
+		Amount:        amount,
+		PaymentMethod: CreditPaymentMethod,
+	})
+	if err != nil {
+		log.Println("Error paying installment:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: err.Error(),
+		}
+	}
+
+	err = s.debtRepo.UpdateWithPayment(ctx, debt, PaymentWrite{
+		Wallet: &WalletChange{
+			Type:        client.DebitEntry,
+			Amount:      amount,
+			Description: "settlement of installment " + settlement.InstallmentId,
+		},
+	})
+	if errors.Is(err, client.ErrInsufficientCredit) {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "insufficient credit balance",
+		}
+	}
+
+	if err != nil {
+		log.Println("Error updating debt:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error updating debt",
+		}
+	}
+
+	s.publishPayment(ctx, debt, settlement.InstallmentId, amount)
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "installment settled with credit successfully",
+	}
+}
+
+// publishPayment announces the payment of the installment and, when it was
+// the last one open, the debt paid.
+func (s *debtService) publishPayment(ctx context.Context, debt *Debt, installmentId string, amount money.Money) {
+	now := time.Now()
+
+	for _, installment := range debt.Intallments {
+		if installment.Id.String() != installmentId {
+			continue
+		}
+
+		s.publish(ctx, shared.Event{
+			Name:              shared.PaymentReceived,
+			DebtId:            debt.Id,
+			InstallmentId:     installment.Id,
+			ClientId:          debt.UserClientId,
+			InstallmentNumber: installment.Number,
+			Amount:            amount,
+			DueDate:           dueDate(installment.DueDate),
+			OccurredAt:        now,
+		})
+	}
+
+	if debt.Status == Paid {
+		s.publish(ctx, shared.Event{
+			Name:       shared.DebtPaid,
+			DebtId:     debt.Id,
+			ClientId:   debt.UserClientId,
+			Amount:     debt.TotalValue,
+			DueDate:    dueDate(debt.DueDate),
+			OccurredAt: now,
+		})
+	}
+}
+
+// publishInstallment announces an installment found by a job in the tenant
+// it belongs to.
+func (s *debtService) publishInstallment(ctx context.Context, name shared.EventName, installment *TenantInstallment, now time.Time) {
+	s.publish(shared.WithTenant(ctx, installment.TenantId), shared.Event{
+		Name:              name,
+		DebtId:            installment.DebtId,
+		InstallmentId:     installment.Id,
+		ClientId:          installment.UserClientId,
+		InstallmentNumber: installment.Number,
+		Amount:            installment.Value,
+		DueDate:           dueDate(installment.DueDate),
+		OccurredAt:        now,
+	})
+}
+
+// prepareReceipt makes the receipt of the last payment of the installment,
+// for the whole amount received, surplus included. It is numbered and stored
+// along with the payment, which is refused when the receipt can not be made.
+func (s *debtService) prepareReceipt(ctx context.Context, debt *Debt, pgInfo *PaymentInfoDto) (*receipt.Receipt, error) {
+	if s.receipts == nil {
+		return nil, nil
+	}
+
+	for _, installment := range debt.Intallments {
+		if installment.Id.String() != pgInfo.InstallmentId || len(installment.Payments) == 0 {
+			continue
+		}
+
+		payment := installment.Payments[len(installment.Payments)-1]
+		paidAt := time.Now()
+		if payment.PaymentDate != nil {
+			paidAt = *payment.PaymentDate
+		}
+
+		return s.receipts.Prepare(ctx, receipt.Payment{
+			Id:                payment.Id,
+			DebtId:            debt.Id,
+			InstallmentId:     installment.Id,
+			ClientId:          debt.UserClientId,
+			Description:       debt.Description,
+			InstallmentNumber: installment.Number,
+			Installments:      len(debt.Intallments),
+			Amount:            pgInfo.Amount,
+			Method:            payment.Method,
+			PaidAt:            paidAt,
+		})
+	}
+
+	return nil, nil
+}
+
+// publish does not fail the operation that raised the event: it already
+// happened.
+func (s *debtService) publish(ctx context.Context, event shared.Event) {
+	if s.events == nil {
+		return
+	}
+
+	if err := s.events.Publish(ctx, event); err != nil {
+		log.Println("Error publishing event", event.Name, ":", err)
 	}
 }
 
-func (s *debtService) putServiceIds(serviceids []string) ([]ulid.ULID, error) {
+func dueDate(date *time.Time) time.Time {
+	if date == nil {
+		return time.Time{}
+	}
+
+	return *date
+}
+
+// installmentSurplus returns the part of the payment that exceeds the
+// installment balance when it should go to the client credit wallet.
+func (s *debtService) installmentSurplus(debt *Debt, pgInfo *PaymentInfoDto) (money.Money, error) {
+	if !s.autoCreditSurplus && !pgInfo.CreditSurplus {
+		return money.Money{}, nil
+	}
+
+	balance, err := debt.InstallmentBalance(pgInfo.InstallmentId, pgInfo.PaymentDate())
+	if err != nil {
+		return money.Money{}, err
+	}
 
-	if len(serviceids) == 0 {
-		return []ulid.ULID{}, nil
+	if balance.IsZero() || !pgInfo.Amount.GreaterThan(balance) {
+		return money.Money{}, nil
 	}
 
-	var ids []ulid.ULID
-	for _, id := range serviceids {
-		ulidId, err := ulid.Parse(id)
+	return pgInfo.Amount.Sub(balance), nil
+}
+
+// checkCatalogItems makes sure every item references a product or service
+// that exists in the catalog and can still be sold.
+func (s *debtService) checkCatalogItems(ctx context.Context, items []DebtItem) (shared.ServiceResponse, bool) {
+	for _, item := range items {
+		exists := s.catalog.ActiveProductExists
+		if item.Type == ServiceItem {
+			exists = s.catalog.ActiveServiceExists
+		}
+
+		found, err := exists(ctx, item.ReferenceId)
 		if err != nil {
-			return nil, err
+			log.Println("Error checking catalog item:", err)
+			return shared.ServiceResponse{
+				Status:  "error",
+				Message: "error in validate products and services provided",
+			}, false
+		}
+
+		if !found {
+			return shared.ServiceResponse{
+				Status:  "error",
+				Message: item.Type.String() + " " + item.ReferenceId.String() + " not found or inactive",
+			}, false
 		}
-		ids = append(ids, ulidId)
 	}
 
-	return ids, nil
+	return shared.ServiceResponse{}, true
 }
 
-func (s *debtService) putProductIds(productids []string) ([]ulid.ULID, error) {
+func (s *debtService) putItems(itemsDto []DebtItemDto) ([]DebtItem, error) {
+	var items []DebtItem
+
+	for _, itemDto := range itemsDto {
+		item := DebtItem{
+			Id:          ulid.Make(),
+			Type:        ProductItem,
+			Description: itemDto.Description,
+			Quantity:    itemDto.Quantity,
+			UnitPrice:   itemDto.UnitPrice,
+			Discount:    itemDto.Discount,
+		}
 
-	if len(productids) == 0 {
-		return []ulid.ULID{}, nil
+		referenceId := itemDto.ProductId
+		if referenceId == "" {
+			item.Type = ServiceItem
+			referenceId = itemDto.ServiceId
+		}
+
+		if referenceId != "" {
+			id, err := ulid.Parse(referenceId)
+			if err != nil {
+				return nil, err
+			}
+			item.ReferenceId = id
+		}
+
+		items = append(items, item)
 	}
 
-	var ids []ulid.ULID
-	for _, id := range productids {
-		ulidId, err := ulid.Parse(id)
+	return items, nil
+}
+
+func (s *debtService) putPlannedInstallments(installments []CustomInstallmentDto) ([]PlannedInstallment, error) {
+	var planned []PlannedInstallment
+
+	for _, installment := range installments {
+		dueDate, err := time.Parse(time.DateOnly, installment.DueDate)
 		if err != nil {
 			return nil, err
 		}
-		ids = append(ids, ulidId)
+
+		planned = append(planned, PlannedInstallment{
+			Value:   installment.Value,
+			DueDate: dueDate,
+		})
 	}
 
-	return ids, nil
+	return planned, nil
 }
 
-func (s *debtService) getProductIds(productIds []ulid.ULID) []string {
-	var ids []string
+func (s *debtService) getItems(items []DebtItem) []DebtItemDto {
+	itemsDto := []DebtItemDto{}
+
+	for _, item := range items {
+		itemDto := DebtItemDto{
+			Id:          item.Id.String(),
+			Description: item.Description,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			Discount:    item.Discount,
+			Total:       item.Total,
+		}
+
+		if item.Type == ServiceItem {
+			itemDto.ServiceId = item.ReferenceId.String()
+		} else {
+			itemDto.ProductId = item.ReferenceId.String()
+		}
+
+		itemsDto = append(itemsDto, itemDto)
+	}
+
+	return itemsDto
+}
 
-	if len(productIds) == 0 {
-		return []string{}
+func (s *debtService) getOriginDebtId(d *Debt) string {
+	if d.OriginDebtId == nil {
+		return ""
 	}
 
-	for _, id := range productIds {
-		ids = append(ids, id.String())
+	return d.OriginDebtId.String()
+}
+
+func (s *debtService) getRenegotiatedDebtId(d *Debt) string {
+	if d.RenegotiationInfo == nil {
+		return ""
 	}
-	return ids
+
+	return d.RenegotiationInfo.RenegotiatedDebtId.String()
 }
 
-func (s *debtService) getServiceIds(serviceIds []ulid.ULID) []string {
-	var ids []string
+func (s *debtService) convertToInstallmentDto(installment *Installment) InstallmentDto {
+	paymentDate := ""
 
-	if len(serviceIds) == 0 {
-		return []string{}
+	if installment.PaymentDate != nil {
+		paymentDate = installment.PaymentDate.Format(time.DateOnly)
 	}
 
-	for _, id := range serviceIds {
-		ids = append(ids, id.String())
+	return InstallmentDto{
+		Id:            installment.Id.String(),
+		Description:   installment.Description,
+		Value:         installment.Value,
+		DueDate:       installment.DueDate.Format(time.DateOnly),
+		DebDate:       installment.DebDate.Format(time.DateOnly),
+		Status:        installment.Status.String(),
+		PaymentDate:   paymentDate,
+		PaymentMethod: installment.PaymentMethod,
+		Number:        installment.Number,
+		Fine:          installment.Charges.Fine,
+		Interest:      installment.Charges.Interest,
+		PaidAmount:    installment.PaidAmount(),
+		Payments:      s.convertToPaymentDto(installment.Payments),
 	}
-	return ids
+}
+
+func (s *debtService) convertToPaymentDto(payments []Payment) []PaymentDto {
+	var paymentsDto []PaymentDto
+
+	for _, payment := range payments {
+		paymentsDto = append(paymentsDto, PaymentDto{
+			Id:            payment.Id.String(),
+			Amount:        payment.Amount,
+			PaymentMethod: payment.Method,
+			PaymentDate:   payment.PaymentDate.Format(time.DateOnly),
+		})
+	}
+
+	return paymentsDto
 }
 
 func (s *debtService) convertToDebtDto(debts []*Debt) []DebtDto {
@@ -441,10 +1294,44 @@ func (s *debtService) convertToDebtDto(debts []*Debt) []DebtDto {
 			InstallmentsQuantity: d.InstallmentsQuantity,
 			Status:               d.Status.String(),
 			UserClientId:         d.UserClientId.String(),
-			ProductIds:           s.getProductIds(d.ProductIds),
-			ServiceIds:           s.getServiceIds(d.ServiceIds),
+			Items:                s.getItems(d.Items),
+			FinePercentage:       d.ChargePolicy.FinePercentage,
+			InterestRate:         d.ChargePolicy.InterestRate,
+			InterestPeriod:       d.ChargePolicy.InterestPeriod.String(),
+			Schedule:             d.Schedule.Frequency.String(),
+			IntervalDays:         d.Schedule.IntervalDays,
+			NextBusinessDay:      d.Schedule.NextBusinessDay,
+			OriginDebtId:         s.getOriginDebtId(d),
+			RenegotiatedDebtId:   s.getRenegotiatedDebtId(d),
 		})
 	}
 
 	return debtsDto
 }
+
+// reservePlanLimit holds the plan usage until the returned release is
+// called, after the debt is stored.
+func (s *debtService) reservePlanLimit(ctx context.Context) (func(), shared.ServiceResponse, bool) {
+	if s.limits == nil {
+		return func() {}, shared.ServiceResponse{}, false
+	}
+
+	release, err := s.limits.ReserveLimit(ctx, plan.FeatureDebt)
+	if err == nil {
+		return release, shared.ServiceResponse{}, false
+	}
+
+	if errors.Is(err, plan.ErrLimitReached) {
+		return nil, shared.ServiceResponse{
+			Status:  "error",
+			Message: err.Error(),
+			Code:    plan.LimitReachedCode,
+		}, true
+	}
+
+	log.Println("Error checking plan limit:", err)
+	return nil, shared.ServiceResponse{
+		Status:  "error",
+		Message: "error in create debt",
+	}, true
+}
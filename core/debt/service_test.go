@@ -2,11 +2,22 @@ package debt_test
 
 import (
 	context "context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/henriquerocha2004/quem-me-deve-api/core/client"
 	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
 	"github.com/henriquerocha2004/quem-me-deve-api/core/debt/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/plan"
+	planMocks "github.com/henriquerocha2004/quem-me-deve-api/core/plan/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/receipt"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	sharedMocks "github.com/henriquerocha2004/quem-me-deve-api/core/shared/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/boleto"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
 	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
 	"github.com/henriquerocha2004/quem-me-deve-api/pkg/validateErrors"
 	"github.com/oklog/ulid/v2"
@@ -23,15 +34,15 @@ func TestDebtServiceTests(t *testing.T) {
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
 		debtRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		catalogReader := mocks.NewMockCatalogReader(ctrl)
+		catalogReader.EXPECT().ActiveProductExists(gomock.Any(), gomock.Any()).Return(true, nil)
+		service := debt.NewDebtService(debtRepo, cliRepo, catalogReader, mocks.NewMockCreditWallet(ctrl))
 		d := &debt.DebtDto{
 			Description:          "Test Debt",
-			TotalValue:           1000,
 			DueDate:              dueDate.Format(time.DateOnly),
 			InstallmentsQuantity: 12,
 			UserClientId:         "01F8Z5G4J6K7N3J4X2G4J6K7N3",
-			ProductIds:           []string{"01F8Z5G4J6K7N3J4X2G4J6K7N3"},
-			ServiceIds:           []string{"01F8Z5G4J6K7N3J4X2G4J6K7N3"},
+			Items:                []debt.DebtItemDto{{ProductId: "01F8Z5G4J6K7N3J4X2G4J6K7N3", Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 		}
 
 		ctx := context.Background()
@@ -42,6 +53,88 @@ func TestDebtServiceTests(t *testing.T) {
 		assert.Equal(t, "debt created successfully", response.Message)
 	})
 
+	t.Run("Should not create a debt with an inactive product", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dueDate := time.Now().AddDate(0, 0, 1)
+		debtRepo := mocks.NewMockRepository(ctrl)
+		debtRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+		catalogReader := mocks.NewMockCatalogReader(ctrl)
+		catalogReader.EXPECT().ActiveProductExists(gomock.Any(), gomock.Any()).Return(true, nil)
+		catalogReader.EXPECT().ActiveServiceExists(gomock.Any(), gomock.Any()).Return(false, nil)
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), catalogReader, mocks.NewMockCreditWallet(ctrl))
+
+		response := service.CreateDebt(context.Background(), &debt.DebtDto{
+			Description:          "Test Debt",
+			DueDate:              dueDate.Format(time.DateOnly),
+			InstallmentsQuantity: 1,
+			UserClientId:         "01F8Z5G4J6K7N3J4X2G4J6K7N3",
+			Items: []debt.DebtItemDto{
+				{ProductId: "01F8Z5G4J6K7N3J4X2G4J6K7N3", Description: "Camisa", Quantity: 1, UnitPrice: money.FromCents(5000)},
+				{ServiceId: "01F8Z5G4J6K7N3J4X2G4J6K7N4", Description: "Ajuste", Quantity: 1, UnitPrice: money.FromCents(2000)},
+			},
+		})
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "service 01F8Z5G4J6K7N3J4X2G4J6K7N4 not found or inactive", response.Message)
+	})
+
+	t.Run("Should not create a debt when the plan limit was reached", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dueDate := time.Now().AddDate(0, 0, 1)
+		debtRepo := mocks.NewMockRepository(ctrl)
+		debtRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+		limits := planMocks.NewMockLimitChecker(ctrl)
+		limits.EXPECT().ReserveLimit(gomock.Any(), plan.FeatureDebt).Return(nil, plan.ErrLimitReached)
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl)).
+			WithPlanLimits(limits)
+
+		response := service.CreateDebt(context.Background(), &debt.DebtDto{
+			Description:          "Test Debt",
+			DueDate:              dueDate.Format(time.DateOnly),
+			InstallmentsQuantity: 1,
+			UserClientId:         "01F8Z5G4J6K7N3J4X2G4J6K7N3",
+			Items:                []debt.DebtItemDto{{ProductId: "01F8Z5G4J6K7N3J4X2G4J6K7N3", Description: "Camisa", Quantity: 1, UnitPrice: money.FromCents(5000)}},
+		})
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "plan limit reached", response.Message)
+		assert.Equal(t, plan.LimitReachedCode, response.Code)
+	})
+
+	t.Run("Deve manter o limite do plano reservado ate salvar a divida", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dueDate := time.Now().AddDate(0, 0, 1)
+		released := false
+		debtRepo := mocks.NewMockRepository(ctrl)
+		debtRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ *debt.Debt) error {
+			assert.False(t, released)
+			return nil
+		})
+		catalogReader := mocks.NewMockCatalogReader(ctrl)
+		catalogReader.EXPECT().ActiveProductExists(gomock.Any(), gomock.Any()).Return(true, nil)
+		limits := planMocks.NewMockLimitChecker(ctrl)
+		limits.EXPECT().ReserveLimit(gomock.Any(), plan.FeatureDebt).Return(func() { released = true }, nil)
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), catalogReader, mocks.NewMockCreditWallet(ctrl)).
+			WithPlanLimits(limits)
+
+		response := service.CreateDebt(context.Background(), &debt.DebtDto{
+			Description:          "Test Debt",
+			DueDate:              dueDate.Format(time.DateOnly),
+			InstallmentsQuantity: 1,
+			UserClientId:         "01F8Z5G4J6K7N3J4X2G4J6K7N3",
+			Items:                []debt.DebtItemDto{{ProductId: "01F8Z5G4J6K7N3J4X2G4J6K7N3", Description: "Camisa", Quantity: 1, UnitPrice: money.FromCents(5000)}},
+		})
+
+		assert.Equal(t, "success", response.Status)
+		assert.True(t, released)
+	})
+
 	t.Run("Should return error if provided invalid total value", func(t *testing.T) {
 
 		ctrl := gomock.NewController(t)
@@ -50,15 +143,13 @@ func TestDebtServiceTests(t *testing.T) {
 		dueDate := time.Now().AddDate(0, 0, 1)
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		d := &debt.DebtDto{
 			Description:          "Test Debt",
-			TotalValue:           -1000,
 			DueDate:              dueDate.Format(time.DateOnly),
 			InstallmentsQuantity: 12,
 			UserClientId:         "01F8Z5G4J6K7N3J4X2G4J6K7N3",
-			ProductIds:           []string{"01F8Z5G4J6K7N3J4X2G4J6K7N3"},
-			ServiceIds:           []string{"01F8Z5G4J6K7N3J4X2G4J6K7N3"},
+			Items:                []debt.DebtItemDto{{ProductId: "01F8Z5G4J6K7N3J4X2G4J6K7N3", Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(0)}},
 		}
 
 		ctx := context.Background()
@@ -70,6 +161,59 @@ func TestDebtServiceTests(t *testing.T) {
 
 	})
 
+	t.Run("Should create installments following the weekly schedule", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dueDate := time.Now().AddDate(0, 0, 1)
+		debtRepo := mocks.NewMockRepository(ctrl)
+		cliRepo := mocks.NewMockClientReader(ctrl)
+		catalogReader := mocks.NewMockCatalogReader(ctrl)
+		catalogReader.EXPECT().ActiveProductExists(gomock.Any(), gomock.Any()).Return(true, nil)
+		service := debt.NewDebtService(debtRepo, cliRepo, catalogReader, mocks.NewMockCreditWallet(ctrl))
+		d := &debt.DebtDto{
+			Description:          "Test Debt",
+			DueDate:              dueDate.Format(time.DateOnly),
+			InstallmentsQuantity: 3,
+			UserClientId:         "01F8Z5G4J6K7N3J4X2G4J6K7N3",
+			Items:                []debt.DebtItemDto{{ProductId: "01F8Z5G4J6K7N3J4X2G4J6K7N3", Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(30000)}},
+			Schedule:             "weekly",
+		}
+
+		debtRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, saved *debt.Debt) error {
+			assert.Equal(t, debt.WeeklySchedule, saved.Schedule.Frequency)
+			assert.Equal(t, 7, int(saved.Intallments[1].DueDate.Sub(*saved.Intallments[0].DueDate).Hours()/24))
+			assert.Equal(t, 7, int(saved.Intallments[2].DueDate.Sub(*saved.Intallments[1].DueDate).Hours()/24))
+			return nil
+		})
+
+		response := service.CreateDebt(context.Background(), d)
+		assert.Equal(t, "success", response.Status)
+	})
+
+	t.Run("Should return error if interval schedule has no interval days", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dueDate := time.Now().AddDate(0, 0, 1)
+		debtRepo := mocks.NewMockRepository(ctrl)
+		cliRepo := mocks.NewMockClientReader(ctrl)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
+		d := &debt.DebtDto{
+			Description:          "Test Debt",
+			DueDate:              dueDate.Format(time.DateOnly),
+			InstallmentsQuantity: 3,
+			UserClientId:         "01F8Z5G4J6K7N3J4X2G4J6K7N3",
+			Items:                []debt.DebtItemDto{{ProductId: "01F8Z5G4J6K7N3J4X2G4J6K7N3", Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(30000)}},
+			Schedule:             "interval",
+		}
+
+		response := service.CreateDebt(context.Background(), d)
+		errorMessage := response.Data.(validateErrors.ValidationErrors)
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "schedule", errorMessage.Errors[0].Field)
+	})
+
 	t.Run("Should return error if provided invalid due date", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -77,15 +221,13 @@ func TestDebtServiceTests(t *testing.T) {
 		dueDate := time.Now().AddDate(0, 0, -1)
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		d := &debt.DebtDto{
 			Description:          "Test Debt",
-			TotalValue:           1000,
 			DueDate:              dueDate.Format(time.DateOnly),
 			InstallmentsQuantity: 12,
 			UserClientId:         "01F8Z5G4J6K7N3J4X2G4J6K7N3",
-			ProductIds:           []string{"01F8Z5G4J6K7N3J4X2G4J6K7N3"},
-			ServiceIds:           []string{"01F8Z5G4J6K7N3J4X2G4J6K7N3"},
+			Items:                []debt.DebtItemDto{{ProductId: "01F8Z5G4J6K7N3J4X2G4J6K7N3", Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 		}
 
 		ctx := context.Background()
@@ -103,15 +245,13 @@ func TestDebtServiceTests(t *testing.T) {
 		dueDate := time.Now().AddDate(0, 0, 1)
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		d := &debt.DebtDto{
 			Description:          "Test Debt",
-			TotalValue:           1000,
 			DueDate:              dueDate.Format(time.DateOnly),
 			InstallmentsQuantity: 12,
 			UserClientId:         "",
-			ProductIds:           []string{"01F8Z5G4J6K7N3J4X2G4J6K7N3"},
-			ServiceIds:           []string{"01F8Z5G4J6K7N3J4X2G4J6K7N3"},
+			Items:                []debt.DebtItemDto{{ProductId: "01F8Z5G4J6K7N3J4X2G4J6K7N3", Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 		}
 
 		ctx := context.Background()
@@ -129,15 +269,12 @@ func TestDebtServiceTests(t *testing.T) {
 		dueDate := time.Now().AddDate(0, 0, 1)
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		d := &debt.DebtDto{
 			Description:          "Test Debt",
-			TotalValue:           1000,
 			DueDate:              dueDate.Format(time.DateOnly),
 			InstallmentsQuantity: 12,
 			UserClientId:         "01F8Z5G4J6K7N3J4X2G4J6K7N3",
-			ProductIds:           []string{},
-			ServiceIds:           []string{},
 		}
 
 		ctx := context.Background()
@@ -145,7 +282,10 @@ func TestDebtServiceTests(t *testing.T) {
 		response := service.CreateDebt(ctx, d)
 		errorMessage := response.Data.(validateErrors.ValidationErrors)
 		assert.Equal(t, "error", response.Status)
-		assert.Equal(t, "at least one productId or serviceId is required", errorMessage.Errors[0].Message)
+		assert.Contains(t, errorMessage.Errors, validateErrors.ValidationError{
+			Field:   "serviceOrProduct",
+			Message: "at least one product or service item is required",
+		})
 	})
 
 	t.Run("Deve retornar a lista de debitos de um cliente", func(t *testing.T) {
@@ -154,7 +294,7 @@ func TestDebtServiceTests(t *testing.T) {
 
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 
 		clientId, _ := ulid.Parse("01F8Z5G4J6K7N3J4X2G4J6K7N3")
 		ctx := context.Background()
@@ -165,13 +305,12 @@ func TestDebtServiceTests(t *testing.T) {
 			{
 				Description:          "Test Debt",
 				Id:                   ulid.Make(),
-				TotalValue:           1000,
+				TotalValue:           money.FromCents(100000),
 				DueDate:              &duedate,
 				Status:               debt.Pending,
 				UserClientId:         clientId,
 				InstallmentsQuantity: 2,
-				ServiceIds:           []ulid.ULID{ulid.Make()},
-				ProductIds:           []ulid.ULID{ulid.Make()},
+				Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 				DebtDate:             &now,
 			},
 		}
@@ -183,7 +322,7 @@ func TestDebtServiceTests(t *testing.T) {
 		assert.Equal(t, "debts retrieved successfully", response.Message)
 		assert.Len(t, response.Data, 1)
 		assert.Equal(t, "Test Debt", response.Data.([]debt.DebtDto)[0].Description)
-		assert.Equal(t, 1000.0, response.Data.([]debt.DebtDto)[0].TotalValue)
+		assert.Equal(t, money.FromCents(100000), response.Data.([]debt.DebtDto)[0].TotalValue)
 		assert.Equal(t, "2023-10-01", response.Data.([]debt.DebtDto)[0].DueDate)
 		assert.Equal(t, 2, response.Data.([]debt.DebtDto)[0].InstallmentsQuantity)
 		assert.Equal(t, debt.Pending.String(), response.Data.([]debt.DebtDto)[0].Status)
@@ -196,7 +335,7 @@ func TestDebtServiceTests(t *testing.T) {
 
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 
 		clientId, _ := ulid.Parse("01F8Z5G4J6K7N3J4X2G4J6K7N3")
 		ctx := context.Background()
@@ -217,7 +356,7 @@ func TestDebtServiceTests(t *testing.T) {
 		clientId, _ := ulid.Parse("01F8Z5G4J6K7N3J4X2G4J6K7N3")
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		duedateFirstInstallment, _ := time.Parse(time.DateOnly, "2025-05-25")
 		duedateSecondInstallment, _ := time.Parse(time.DateOnly, "2025-06-25")
 		ctx := context.Background()
@@ -226,7 +365,7 @@ func TestDebtServiceTests(t *testing.T) {
 			{
 				Id:            ulid.Make(),
 				Description:   "Referente a compra de CD",
-				Value:         600,
+				Value:         money.FromCents(60000),
 				DueDate:       &duedateFirstInstallment,
 				DebDate:       &duedateFirstInstallment,
 				Status:        debt.Pending,
@@ -237,7 +376,7 @@ func TestDebtServiceTests(t *testing.T) {
 			{
 				Id:            ulid.Make(),
 				Description:   "Referente a compra de CD",
-				Value:         600,
+				Value:         money.FromCents(60000),
 				DueDate:       &duedateSecondInstallment,
 				DebDate:       &duedateSecondInstallment,
 				Status:        debt.Pending,
@@ -262,7 +401,7 @@ func TestDebtServiceTests(t *testing.T) {
 		ctx := context.Background()
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		cliRepo.EXPECT().ClientExists(gomock.Any(), clientId).Return(false, nil)
 		debtRepo.EXPECT().DebtInstallments(gomock.Any(), debtId).Times(0)
 		response := service.GetDebtInstallments(ctx, clientId, debtId)
@@ -278,7 +417,7 @@ func TestDebtServiceTests(t *testing.T) {
 		ctx := context.Background()
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 
 		pgRequest := paginate.PaginateRequest{
 			Page:  1,
@@ -297,13 +436,12 @@ func TestDebtServiceTests(t *testing.T) {
 			{
 				Description:          "Test Debt",
 				Id:                   ulid.Make(),
-				TotalValue:           1000,
+				TotalValue:           money.FromCents(100000),
 				DueDate:              &dueDate,
 				Status:               debt.Pending,
 				UserClientId:         ulid.Make(),
 				InstallmentsQuantity: 2,
-				ServiceIds:           []ulid.ULID{ulid.Make()},
-				ProductIds:           []ulid.ULID{ulid.Make()},
+				Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 			},
 		}
 
@@ -331,15 +469,13 @@ func TestDebtServiceTests(t *testing.T) {
 		ctx := context.Background()
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			UserClientId:         ulid.Make(),
 			Status:               debt.Pending,
 			DueDate:              nil,
-			ProductIds:           []ulid.ULID{},
-			ServiceIds:           []ulid.ULID{},
 			InstallmentsQuantity: 1,
 		}
 
@@ -348,7 +484,7 @@ func TestDebtServiceTests(t *testing.T) {
 		paymentInfo := &debt.PaymentInfoDto{
 			DebtId:        d.Id.String(),
 			InstallmentId: d.Intallments[0].Id.String(),
-			Amount:        500,
+			Amount:        money.FromCents(50000),
 			PaymentMethod: "Credit Card",
 		}
 
@@ -363,6 +499,41 @@ func TestDebtServiceTests(t *testing.T) {
 		assert.Equal(t, "installment paid successfully", response.Message)
 	})
 
+	t.Run("Deve ignorar um pagamento com referencia ja registrada", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		debtRepo := mocks.NewMockRepository(ctrl)
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Status:               debt.Pending,
+			InstallmentsQuantity: 2,
+			TotalValue:           money.FromCents(20000),
+		}
+		d.GenerateInstallments()
+
+		paymentInfo := &debt.PaymentInfoDto{
+			DebtId:        d.Id.String(),
+			InstallmentId: d.Intallments[0].Id.String(),
+			Amount:        money.FromCents(4000),
+			PaymentMethod: debt.PixPaymentMethod,
+			Reference:     "E0001",
+		}
+		assert.NoError(t, d.PayInstallment(paymentInfo))
+
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+		debtRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+
+		response := service.PayInstallment(context.Background(), paymentInfo)
+
+		assert.Equal(t, "success", response.Status)
+		assert.Equal(t, "payment already registered", response.Message)
+		assert.Len(t, d.Intallments[0].Payments, 1)
+	})
+
 	t.Run("Deve retornar um erro caso seja informado um debtoId inválido", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -370,12 +541,12 @@ func TestDebtServiceTests(t *testing.T) {
 		ctx := context.Background()
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 
 		paymentInfo := &debt.PaymentInfoDto{
 			DebtId:        "invalid-debt-id",
 			InstallmentId: "01F8Z5G4J6K7N3J4X2G4J6K7N3",
-			Amount:        500,
+			Amount:        money.FromCents(50000),
 			PaymentMethod: "Credit Card",
 		}
 
@@ -392,12 +563,12 @@ func TestDebtServiceTests(t *testing.T) {
 		ctx := context.Background()
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 
 		paymentInfo := &debt.PaymentInfoDto{
 			DebtId:        "01F8Z5G4J6K7N3J4X2G4J6K7N3",
 			InstallmentId: "01F8Z5G4J6K7N3J4X2G4J6K7N3",
-			Amount:        500,
+			Amount:        money.FromCents(50000),
 			PaymentMethod: "Credit Card",
 		}
 
@@ -410,6 +581,299 @@ func TestDebtServiceTests(t *testing.T) {
 		assert.Equal(t, "debt not found", response.Message)
 	})
 
+	t.Run("Deve creditar o troco na carteira do cliente quando solicitado", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		dueDate := time.Now().AddDate(0, 0, 1)
+		debtRepo := mocks.NewMockRepository(ctrl)
+		cliRepo := mocks.NewMockClientReader(ctrl)
+		wallet := mocks.NewMockCreditWallet(ctrl)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), wallet)
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Status:               debt.Pending,
+			TotalValue:           money.FromCents(10000),
+			DueDate:              &dueDate,
+			InstallmentsQuantity: 1,
+		}
+
+		d.GenerateInstallments()
+
+		paymentInfo := &debt.PaymentInfoDto{
+			DebtId:        d.Id.String(),
+			InstallmentId: d.Intallments[0].Id.String(),
+			Amount:        money.FromCents(13000),
+			PaymentMethod: "cash",
+			CreditSurplus: true,
+		}
+
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+		debtRepo.EXPECT().UpdateWithPayment(gomock.Any(), d, gomock.Any()).DoAndReturn(func(_ context.Context, _ *debt.Debt, write debt.PaymentWrite) error {
+			change := write.Wallet
+			assert.Equal(t, client.CreditEntry, change.Type)
+			assert.Equal(t, money.FromCents(3000), change.Amount)
+			return nil
+		})
+
+		response := service.PayInstallment(ctx, paymentInfo)
+
+		assert.Equal(t, "success", response.Status)
+		assert.Equal(t, debt.Paid, d.Intallments[0].Status)
+		assert.Equal(t, money.FromCents(10000), d.Intallments[0].PaidAmount())
+	})
+
+	t.Run("Deve creditar o troco automaticamente quando configurado", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		dueDate := time.Now().AddDate(0, 0, 1)
+		debtRepo := mocks.NewMockRepository(ctrl)
+		cliRepo := mocks.NewMockClientReader(ctrl)
+		wallet := mocks.NewMockCreditWallet(ctrl)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), wallet).WithAutoCreditSurplus(true)
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Status:               debt.Pending,
+			TotalValue:           money.FromCents(10000),
+			DueDate:              &dueDate,
+			InstallmentsQuantity: 2,
+		}
+
+		d.GenerateInstallments()
+
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+		debtRepo.EXPECT().UpdateWithPayment(gomock.Any(), d, gomock.Any()).DoAndReturn(func(_ context.Context, _ *debt.Debt, write debt.PaymentWrite) error {
+			change := write.Wallet
+			assert.Equal(t, client.CreditEntry, change.Type)
+			assert.Equal(t, money.FromCents(1000), change.Amount)
+			return nil
+		})
+
+		response := service.PayInstallment(ctx, &debt.PaymentInfoDto{
+			DebtId:        d.Id.String(),
+			InstallmentId: d.Intallments[0].Id.String(),
+			Amount:        money.FromCents(6000),
+			PaymentMethod: "cash",
+		})
+
+		assert.Equal(t, "success", response.Status)
+		assert.Equal(t, money.FromCents(5000), d.Intallments[0].PaidAmount())
+	})
+
+	t.Run("Deve emitir o recibo do pagamento da parcela", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		dueDate := time.Now().AddDate(0, 0, 1)
+		debtRepo := mocks.NewMockRepository(ctrl)
+		receipts := mocks.NewMockReceiptIssuer(ctrl)
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl)).
+			WithReceipts(receipts)
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Description:          "Corte e barba",
+			Status:               debt.Pending,
+			TotalValue:           money.FromCents(10000),
+			DueDate:              &dueDate,
+			InstallmentsQuantity: 2,
+		}
+
+		d.GenerateInstallments()
+
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+
+		receiptId := ulid.Make()
+		receipts.EXPECT().Prepare(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, payment receipt.Payment) (*receipt.Receipt, error) {
+			assert.Equal(t, d.Id, payment.DebtId)
+			assert.Equal(t, d.Intallments[0].Id, payment.InstallmentId)
+			assert.Equal(t, d.UserClientId, payment.ClientId)
+			assert.Equal(t, "Corte e barba", payment.Description)
+			assert.Equal(t, 1, payment.InstallmentNumber)
+			assert.Equal(t, 2, payment.Installments)
+			assert.Equal(t, money.FromCents(5000), payment.Amount)
+			assert.Equal(t, "pix", payment.Method)
+			return &receipt.Receipt{Id: receiptId}, nil
+		})
+		debtRepo.EXPECT().UpdateWithPayment(gomock.Any(), d, gomock.Any()).DoAndReturn(func(_ context.Context, _ *debt.Debt, write debt.PaymentWrite) error {
+			assert.Nil(t, write.Wallet)
+			assert.Equal(t, receiptId, write.Receipt.Id)
+			write.Receipt.Number = 3
+			return nil
+		})
+
+		response := service.PayInstallment(ctx, &debt.PaymentInfoDto{
+			DebtId:        d.Id.String(),
+			InstallmentId: d.Intallments[0].Id.String(),
+			Amount:        money.FromCents(5000),
+			PaymentMethod: "pix",
+		})
+
+		assert.Equal(t, "success", response.Status)
+		result := response.Data.(debt.PaymentResultDto)
+		assert.Equal(t, receiptId.String(), result.ReceiptId)
+		assert.Equal(t, int64(3), result.ReceiptNumber)
+	})
+
+	t.Run("Deve recusar o pagamento quando o recibo não puder ser emitido", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		dueDate := time.Now().AddDate(0, 0, 1)
+		debtRepo := mocks.NewMockRepository(ctrl)
+		receipts := mocks.NewMockReceiptIssuer(ctrl)
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl)).
+			WithReceipts(receipts)
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Status:               debt.Pending,
+			TotalValue:           money.FromCents(10000),
+			DueDate:              &dueDate,
+			InstallmentsQuantity: 1,
+		}
+
+		d.GenerateInstallments()
+
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+		receipts.EXPECT().Prepare(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+		debtRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+		debtRepo.EXPECT().UpdateWithPayment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		response := service.PayInstallment(ctx, &debt.PaymentInfoDto{
+			DebtId:        d.Id.String(),
+			InstallmentId: d.Intallments[0].Id.String(),
+			Amount:        money.FromCents(10000),
+			PaymentMethod: "cash",
+		})
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "error issuing receipt", response.Message)
+	})
+
+	t.Run("Deve quitar uma parcela com o saldo de credito do cliente", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		dueDate := time.Now().AddDate(0, 0, 1)
+		debtRepo := mocks.NewMockRepository(ctrl)
+		cliRepo := mocks.NewMockClientReader(ctrl)
+		wallet := mocks.NewMockCreditWallet(ctrl)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), wallet)
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Status:               debt.Pending,
+			TotalValue:           money.FromCents(10000),
+			DueDate:              &dueDate,
+			InstallmentsQuantity: 2,
+		}
+
+		d.GenerateInstallments()
+
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+		wallet.EXPECT().Balance(gomock.Any(), d.UserClientId).Return(money.FromCents(3000), nil)
+		debtRepo.EXPECT().UpdateWithPayment(gomock.Any(), d, gomock.Any()).DoAndReturn(func(_ context.Context, _ *debt.Debt, write debt.PaymentWrite) error {
+			change := write.Wallet
+			assert.Equal(t, client.DebitEntry, change.Type)
+			assert.Equal(t, money.FromCents(3000), change.Amount)
+			return nil
+		})
+
+		response := service.SettleWithCredit(ctx, &debt.CreditSettlementDto{
+			DebtId:        d.Id.String(),
+			InstallmentId: d.Intallments[0].Id.String(),
+		})
+
+		assert.Equal(t, "success", response.Status)
+		assert.Equal(t, debt.PartiallyPaid, d.Intallments[0].Status)
+		assert.Equal(t, debt.CreditPaymentMethod, d.Intallments[0].Payments[0].Method)
+	})
+
+	t.Run("Deve retornar erro ao quitar com credito acima do saldo da carteira", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		dueDate := time.Now().AddDate(0, 0, 1)
+		debtRepo := mocks.NewMockRepository(ctrl)
+		cliRepo := mocks.NewMockClientReader(ctrl)
+		wallet := mocks.NewMockCreditWallet(ctrl)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), wallet)
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Status:               debt.Pending,
+			TotalValue:           money.FromCents(10000),
+			DueDate:              &dueDate,
+			InstallmentsQuantity: 1,
+		}
+
+		d.GenerateInstallments()
+
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+		wallet.EXPECT().Balance(gomock.Any(), d.UserClientId).Return(money.FromCents(3000), nil)
+		debtRepo.EXPECT().UpdateWithPayment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		response := service.SettleWithCredit(ctx, &debt.CreditSettlementDto{
+			DebtId:        d.Id.String(),
+			InstallmentId: d.Intallments[0].Id.String(),
+			Amount:        money.FromCents(5000),
+		})
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "insufficient credit balance", response.Message)
+	})
+
+	t.Run("Deve recusar a quitação quando o credito for gasto antes da transação", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		dueDate := time.Now().AddDate(0, 0, 1)
+		debtRepo := mocks.NewMockRepository(ctrl)
+		wallet := mocks.NewMockCreditWallet(ctrl)
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), wallet)
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Status:               debt.Pending,
+			TotalValue:           money.FromCents(10000),
+			DueDate:              &dueDate,
+			InstallmentsQuantity: 1,
+		}
+
+		d.GenerateInstallments()
+
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+		wallet.EXPECT().Balance(gomock.Any(), d.UserClientId).Return(money.FromCents(3000), nil)
+		debtRepo.EXPECT().UpdateWithPayment(gomock.Any(), d, gomock.Any()).Return(client.ErrInsufficientCredit)
+
+		response := service.SettleWithCredit(ctx, &debt.CreditSettlementDto{
+			DebtId:        d.Id.String(),
+			InstallmentId: d.Intallments[0].Id.String(),
+			Amount:        money.FromCents(3000),
+		})
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "insufficient credit balance", response.Message)
+	})
+
 	t.Run("Deve realizar o cancelamento de uma divida", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -417,15 +881,13 @@ func TestDebtServiceTests(t *testing.T) {
 		ctx := context.Background()
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			UserClientId:         ulid.Make(),
 			Status:               debt.Pending,
 			DueDate:              nil,
-			ProductIds:           []ulid.ULID{},
-			ServiceIds:           []ulid.ULID{},
 			InstallmentsQuantity: 1,
 		}
 
@@ -454,7 +916,7 @@ func TestDebtServiceTests(t *testing.T) {
 		ctx := context.Background()
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 
 		cancelInfo := &debt.CancelInfoDto{
 			DebtId:      "invalid-debt-id",
@@ -475,7 +937,7 @@ func TestDebtServiceTests(t *testing.T) {
 		ctx := context.Background()
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 
 		cancelInfo := &debt.CancelInfoDto{
 			DebtId:      "01F8Z5G4J6K7N3J4X2G4J6K7N3",
@@ -499,15 +961,13 @@ func TestDebtServiceTests(t *testing.T) {
 		ctx := context.Background()
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 
 		d := &debt.Debt{
 			Id:                   ulid.Make(),
 			UserClientId:         ulid.Make(),
 			Status:               debt.Pending,
 			DueDate:              nil,
-			ProductIds:           []ulid.ULID{},
-			ServiceIds:           []ulid.ULID{},
 			InstallmentsQuantity: 1,
 		}
 
@@ -536,7 +996,7 @@ func TestDebtServiceTests(t *testing.T) {
 		ctx := context.Background()
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 
 		cancelInfo := &debt.ReversalInfoDto{
 			DebtId:     "invalid-debt-id",
@@ -557,7 +1017,7 @@ func TestDebtServiceTests(t *testing.T) {
 		ctx := context.Background()
 		debtRepo := mocks.NewMockRepository(ctrl)
 		cliRepo := mocks.NewMockClientReader(ctrl)
-		service := debt.NewDebtService(debtRepo, cliRepo)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 
 		cancelInfo := &debt.ReversalInfoDto{
 			DebtId:     "01F8Z5G4J6K7N3J4X2G4J6K7N3",
@@ -573,4 +1033,475 @@ func TestDebtServiceTests(t *testing.T) {
 		assert.Equal(t, "error", response.Status)
 		assert.Equal(t, "debt not found", response.Message)
 	})
+
+	t.Run("Deve renegociar uma divida", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		debtRepo := mocks.NewMockRepository(ctrl)
+		cliRepo := mocks.NewMockClientReader(ctrl)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
+
+		dueDate := time.Now().Add(24 * time.Hour)
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Status:               debt.Pending,
+			TotalValue:           money.FromCents(60000),
+			DueDate:              &dueDate,
+			InstallmentsQuantity: 1,
+		}
+		d.GenerateInstallments()
+
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+		debtRepo.EXPECT().SaveRenegotiation(gomock.Any(), d, gomock.Any()).Return(nil)
+
+		response := service.RenegotiateDebt(ctx, &debt.RenegotiationDto{
+			DebtId:               d.Id.String(),
+			InstallmentsQuantity: 2,
+			FirstDueDate:         time.Now().AddDate(0, 1, 0).Format(time.DateOnly),
+			RenegotiatedBy:       ulid.Make(),
+		})
+
+		assert.Equal(t, "success", response.Status)
+		assert.Equal(t, debt.Renegotiated, d.Status)
+		assert.Equal(t, d.Id.String(), response.Data.(debt.DebtDto).OriginDebtId)
+		assert.Equal(t, money.FromCents(60000), response.Data.(debt.DebtDto).TotalValue)
+	})
+
+	t.Run("Deve retornar um erro caso o debito não seja encontrado para renegociacao", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		debtRepo := mocks.NewMockRepository(ctrl)
+		cliRepo := mocks.NewMockClientReader(ctrl)
+		service := debt.NewDebtService(debtRepo, cliRepo, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
+
+		debtId := ulid.Make()
+		debtRepo.EXPECT().GetDebt(gomock.Any(), debtId).Return(nil, nil)
+		debtRepo.EXPECT().SaveRenegotiation(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		response := service.RenegotiateDebt(ctx, &debt.RenegotiationDto{
+			DebtId:               debtId.String(),
+			InstallmentsQuantity: 2,
+			FirstDueDate:         time.Now().AddDate(0, 1, 0).Format(time.DateOnly),
+		})
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "debt not found", response.Message)
+	})
+
+	t.Run("Deve listar as parcelas em atraso de todos os clientes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		debtRepo := mocks.NewMockRepository(ctrl)
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
+
+		dueDate := time.Now().AddDate(0, 0, -3)
+		debtDate := time.Now().AddDate(0, -1, 0)
+		overdue := &debt.OverdueInstallment{
+			Installment: debt.Installment{
+				Id:      ulid.Make(),
+				Value:   money.FromCents(10000),
+				DueDate: &dueDate,
+				DebDate: &debtDate,
+				Status:  debt.Pending,
+			},
+			DebtId:       ulid.Make(),
+			UserClientId: ulid.Make(),
+		}
+		debtRepo.EXPECT().OverdueInstallments(gomock.Any(), gomock.Any()).Return([]*debt.OverdueInstallment{overdue}, nil)
+
+		response := service.OverdueInstallments(context.Background())
+
+		assert.Equal(t, "success", response.Status)
+		installments := response.Data.([]debt.OverdueInstallmentDto)
+		assert.Len(t, installments, 1)
+		assert.Equal(t, "overdue", installments[0].Status)
+		assert.Equal(t, 3, installments[0].DaysLate)
+		assert.Equal(t, overdue.UserClientId.String(), installments[0].ClientId)
+		assert.Equal(t, overdue.DebtId.String(), installments[0].DebtId)
+	})
+
+	t.Run("Deve marcar as parcelas em atraso", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		now := time.Now()
+		dueDate := now.AddDate(0, 0, -3)
+		marked := []*debt.TenantInstallment{
+			{
+				Installment:  debt.Installment{Id: ulid.Make(), Number: 1, Value: money.FromCents(5000), DueDate: &dueDate, Status: debt.Overdue},
+				DebtId:       ulid.Make(),
+				UserClientId: ulid.Make(),
+				TenantId:     ulid.Make(),
+			},
+		}
+
+		debtRepo := mocks.NewMockRepository(ctrl)
+		debtRepo.EXPECT().MarkOverdue(gomock.Any(), now).Return(marked, nil)
+		events := sharedMocks.NewMockEventPublisher(ctrl)
+		events.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, event shared.Event) error {
+			tenantId, _ := shared.TenantFromContext(ctx)
+			assert.Equal(t, marked[0].TenantId, tenantId)
+			assert.Equal(t, shared.InstallmentOverdue, event.Name)
+			assert.Equal(t, marked[0].Id, event.InstallmentId)
+			assert.Equal(t, marked[0].UserClientId, event.ClientId)
+			assert.Equal(t, dueDate, event.DueDate)
+			return nil
+		})
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl)).
+			WithEvents(events)
+
+		err := service.MarkOverdue(context.Background(), now)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Deve anunciar as parcelas a vencer", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		now := time.Now()
+		dueDate := now.AddDate(0, 0, 2)
+		dueSoon := []*debt.TenantInstallment{
+			{
+				Installment: debt.Installment{Id: ulid.Make(), Number: 2, Value: money.FromCents(5000), DueDate: &dueDate, Status: debt.Pending},
+				DebtId:      ulid.Make(),
+				TenantId:    ulid.Make(),
+			},
+		}
+
+		debtRepo := mocks.NewMockRepository(ctrl)
+		debtRepo.EXPECT().DueSoonInstallments(gomock.Any(), now, now.AddDate(0, 0, debt.DueSoonDays)).Return(dueSoon, nil)
+		events := sharedMocks.NewMockEventPublisher(ctrl)
+		events.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event shared.Event) error {
+			assert.Equal(t, shared.InstallmentDueSoon, event.Name)
+			assert.Equal(t, 2, event.InstallmentNumber)
+			return nil
+		})
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl)).
+			WithEvents(events)
+
+		err := service.NotifyDueSoon(context.Background(), now)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Deve anunciar o pagamento recebido e a divida quitada", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Status:               debt.Pending,
+			TotalValue:           money.FromCents(50000),
+			InstallmentsQuantity: 1,
+		}
+		d.GenerateInstallments()
+
+		debtRepo := mocks.NewMockRepository(ctrl)
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+		debtRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		var published []shared.Event
+		events := sharedMocks.NewMockEventPublisher(ctrl)
+		events.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event shared.Event) error {
+			published = append(published, event)
+			return errors.New("inbox unavailable")
+		}).Times(2)
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl)).
+			WithEvents(events)
+
+		response := service.PayInstallment(context.Background(), &debt.PaymentInfoDto{
+			DebtId:        d.Id.String(),
+			InstallmentId: d.Intallments[0].Id.String(),
+			Amount:        money.FromCents(50000),
+			PaymentMethod: "pix",
+		})
+
+		assert.Equal(t, "success", response.Status)
+		assert.Len(t, published, 2)
+		assert.Equal(t, shared.PaymentReceived, published[0].Name)
+		assert.Equal(t, d.Intallments[0].Id, published[0].InstallmentId)
+		assert.Equal(t, money.FromCents(50000), published[0].Amount)
+		assert.Equal(t, shared.DebtPaid, published[1].Name)
+		assert.Equal(t, ulid.ULID{}, published[1].InstallmentId)
+	})
+
+	t.Run("Deve gerar a cobranca pix de uma parcela em aberto", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dueDate := time.Now().AddDate(0, 1, 0)
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Status:               debt.Pending,
+			DueDate:              &dueDate,
+			TotalValue:           money.FromCents(50000),
+			InstallmentsQuantity: 2,
+		}
+		d.GenerateInstallments()
+		installment := d.Intallments[1]
+
+		professional, _ := user.NewUser("Maria", "maria@email.com", "12345678")
+		professional.Pix = &user.PixAccount{Key: "maria@email.com", MerchantName: "Maria Silva", MerchantCity: "Salvador"}
+
+		debtRepo := mocks.NewMockRepository(ctrl)
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+		accounts := mocks.NewMockAccountReader(ctrl)
+		accounts.EXPECT().FindById(gomock.Any(), professional.Id).Return(professional, nil)
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl)).
+			WithPix(accounts, "")
+
+		ctx := shared.WithTenant(context.Background(), professional.Id)
+		response := service.InstallmentPix(ctx, d.UserClientId, d.Id, installment.Id, false)
+
+		assert.Equal(t, "success", response.Status)
+		charge := response.Data.(debt.InstallmentPixDto)
+		assert.Equal(t, installment.Id.String()[1:], charge.TxId)
+		assert.Equal(t, installment.Value, charge.Amount)
+		assert.Contains(t, charge.Payload, "0115maria@email.com")
+		assert.Contains(t, charge.Payload, "54"+fmt.Sprintf("%02d", len(installment.Value.String()))+installment.Value.String())
+		assert.Contains(t, charge.Payload, "0525"+charge.TxId)
+		assert.Equal(t, []byte("\x89PNG"), charge.QRCode[:4])
+	})
+
+	t.Run("Deve gerar a cobranca pix dinamica de uma parcela", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dueDate := time.Now().AddDate(0, 1, 0)
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Status:               debt.Pending,
+			DueDate:              &dueDate,
+			TotalValue:           money.FromCents(50000),
+			InstallmentsQuantity: 1,
+		}
+		d.GenerateInstallments()
+
+		professional, _ := user.NewUser("Maria", "maria@email.com", "12345678")
+		professional.Pix = &user.PixAccount{Key: "maria@email.com", MerchantName: "Maria Silva", MerchantCity: "Salvador"}
+
+		debtRepo := mocks.NewMockRepository(ctrl)
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+		accounts := mocks.NewMockAccountReader(ctrl)
+		accounts.EXPECT().FindById(gomock.Any(), professional.Id).Return(professional, nil)
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl)).
+			WithPix(accounts, "pix.psp.com/qr/")
+
+		ctx := shared.WithTenant(context.Background(), professional.Id)
+		response := service.InstallmentPix(ctx, d.UserClientId, d.Id, d.Intallments[0].Id, true)
+
+		assert.Equal(t, "success", response.Status)
+		charge := response.Data.(debt.InstallmentPixDto)
+		assert.Contains(t, charge.Payload, "010212")
+		assert.Contains(t, charge.Payload, "pix.psp.com/qr/"+charge.TxId)
+		assert.NotContains(t, charge.Payload, "maria@email.com")
+	})
+
+	t.Run("Deve retornar erro ao gerar pix sem conta pix cadastrada", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Status:               debt.Pending,
+			TotalValue:           money.FromCents(50000),
+			InstallmentsQuantity: 1,
+		}
+		d.GenerateInstallments()
+
+		professional, _ := user.NewUser("Maria", "maria@email.com", "12345678")
+
+		debtRepo := mocks.NewMockRepository(ctrl)
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+		accounts := mocks.NewMockAccountReader(ctrl)
+		accounts.EXPECT().FindById(gomock.Any(), professional.Id).Return(professional, nil)
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl)).
+			WithPix(accounts, "")
+
+		ctx := shared.WithTenant(context.Background(), professional.Id)
+		response := service.InstallmentPix(ctx, d.UserClientId, d.Id, d.Intallments[0].Id, false)
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "pix account not set", response.Message)
+	})
+
+	t.Run("Deve retornar erro ao gerar pix de uma parcela paga ou de outro cliente", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Status:               debt.Pending,
+			TotalValue:           money.FromCents(50000),
+			InstallmentsQuantity: 2,
+		}
+		d.GenerateInstallments()
+		d.Intallments[0].Status = debt.Paid
+
+		debtRepo := mocks.NewMockRepository(ctrl)
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil).Times(2)
+		accounts := mocks.NewMockAccountReader(ctrl)
+		accounts.EXPECT().FindById(gomock.Any(), gomock.Any()).Times(0)
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl)).
+			WithPix(accounts, "")
+
+		response := service.InstallmentPix(context.Background(), d.UserClientId, d.Id, d.Intallments[0].Id, false)
+		assert.Equal(t, "installment is not open for payment", response.Message)
+
+		response = service.InstallmentPix(context.Background(), ulid.Make(), d.Id, d.Intallments[1].Id, false)
+		assert.Equal(t, "debt not found", response.Message)
+	})
+
+	t.Run("Deve gerar o boleto de uma parcela em aberto para um cliente PJ", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dueDate := time.Now().AddDate(0, 1, 0)
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			Description:          "Fornecimento de pães",
+			UserClientId:         ulid.Make(),
+			Status:               debt.Pending,
+			DueDate:              &dueDate,
+			TotalValue:           money.FromCents(50000),
+			InstallmentsQuantity: 2,
+			ChargePolicy:         debt.ChargePolicy{FinePercentage: 2, InterestRate: 1},
+		}
+		d.GenerateInstallments()
+		installment := d.Intallments[1]
+
+		professional, _ := user.NewUser("Maria", "maria@email.com", "12345678")
+		professional.Boleto = &user.BoletoAccount{
+			Account:             boleto.Account{Bank: boleto.BancoDoBrasilCode, Agency: "1234-5", Number: "12345-6", Wallet: "17", Agreement: "1234567"},
+			BeneficiaryName:     "Maria Silva ME",
+			BeneficiaryDocument: "11222333000181",
+		}
+
+		payer := &client.Client{
+			Id:         d.UserClientId,
+			Name:       "Padaria Pão Quente",
+			EntityType: client.LegalEntity,
+			Document:   "11444777000161",
+			Addresses: []client.Address{
+				{Street: "Rua das Flores, 10", Neighborhood: "Centro", City: "Salvador", State: "BA", ZipCode: "40000000"},
+			},
+		}
+
+		debtRepo := mocks.NewMockRepository(ctrl)
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+		accounts := mocks.NewMockAccountReader(ctrl)
+		accounts.EXPECT().FindById(gomock.Any(), professional.Id).Return(professional, nil)
+		clients := mocks.NewMockClientFinder(ctrl)
+		clients.EXPECT().FindById(gomock.Any(), d.UserClientId).Return(payer, nil)
+		numbers := mocks.NewMockBoletoNumbers(ctrl)
+		numbers.EXPECT().OurNumber(gomock.Any(), installment.Id).Return(int64(42), nil)
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl)).
+			WithBoleto(accounts, clients, numbers)
+
+		ctx := shared.WithTenant(context.Background(), professional.Id)
+		response := service.InstallmentBoleto(ctx, d.UserClientId, d.Id, installment.Id)
+
+		assert.Equal(t, "success", response.Status)
+		slip := response.Data.(debt.InstallmentBoletoDto)
+		assert.Equal(t, "12345670000000042", slip.OurNumber)
+		assert.Equal(t, installment.Value, slip.Amount)
+		assert.Equal(t, installment.DueDate.Format(time.DateOnly), slip.DueDate)
+
+		barcode, err := boleto.ParseDigitableLine(slip.DigitableLine)
+		assert.NoError(t, err)
+		assert.Equal(t, slip.Barcode, string(barcode))
+		assert.Equal(t, "0000001234567000000004217", barcode.FreeField())
+		assert.Equal(t, []byte("\x89PNG"), slip.BarcodeImage[:4])
+		assert.Equal(t, []byte("%PDF"), slip.PDF[:4])
+		assert.Contains(t, string(slip.PDF), "11.444.777/0001-61")
+		assert.Contains(t, string(slip.PDF), "multa de 2,00%")
+	})
+
+	t.Run("Deve gerar o boleto de uma parcela vencida com vencimento no dia", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dueDate := time.Now().AddDate(0, -1, 0)
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Status:               debt.Overdue,
+			DueDate:              &dueDate,
+			TotalValue:           money.FromCents(50000),
+			InstallmentsQuantity: 1,
+			ChargePolicy:         debt.ChargePolicy{FinePercentage: 2},
+		}
+		d.GenerateInstallments()
+		d.Intallments[0].Status = debt.Overdue
+
+		professional, _ := user.NewUser("Maria", "maria@email.com", "12345678")
+		professional.Boleto = &user.BoletoAccount{
+			Account:             boleto.Account{Bank: boleto.BradescoCode, Agency: "1234-5", Number: "98765-4", Wallet: "09"},
+			BeneficiaryName:     "Maria Silva ME",
+			BeneficiaryDocument: "11222333000181",
+		}
+
+		debtRepo := mocks.NewMockRepository(ctrl)
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+		accounts := mocks.NewMockAccountReader(ctrl)
+		accounts.EXPECT().FindById(gomock.Any(), professional.Id).Return(professional, nil)
+		clients := mocks.NewMockClientFinder(ctrl)
+		clients.EXPECT().FindById(gomock.Any(), d.UserClientId).Return(&client.Client{Name: "Pedro", Document: "52998224725"}, nil)
+		numbers := mocks.NewMockBoletoNumbers(ctrl)
+		numbers.EXPECT().OurNumber(gomock.Any(), d.Intallments[0].Id).Return(int64(7), nil)
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl)).
+			WithBoleto(accounts, clients, numbers)
+
+		ctx := shared.WithTenant(context.Background(), professional.Id)
+		response := service.InstallmentBoleto(ctx, d.UserClientId, d.Id, d.Intallments[0].Id)
+
+		assert.Equal(t, "success", response.Status)
+		slip := response.Data.(debt.InstallmentBoletoDto)
+		assert.Equal(t, time.Now().Format(time.DateOnly), slip.DueDate)
+		assert.Equal(t, money.FromCents(51000), slip.Amount)
+		assert.Equal(t, "09/00000000007-", slip.OurNumber[:15])
+	})
+
+	t.Run("Deve retornar erro ao gerar boleto sem conta de boleto cadastrada", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Status:               debt.Pending,
+			TotalValue:           money.FromCents(50000),
+			InstallmentsQuantity: 1,
+		}
+		d.GenerateInstallments()
+
+		professional, _ := user.NewUser("Maria", "maria@email.com", "12345678")
+
+		debtRepo := mocks.NewMockRepository(ctrl)
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+		accounts := mocks.NewMockAccountReader(ctrl)
+		accounts.EXPECT().FindById(gomock.Any(), professional.Id).Return(professional, nil)
+		numbers := mocks.NewMockBoletoNumbers(ctrl)
+		numbers.EXPECT().OurNumber(gomock.Any(), gomock.Any()).Times(0)
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl)).
+			WithBoleto(accounts, mocks.NewMockClientFinder(ctrl), numbers)
+
+		ctx := shared.WithTenant(context.Background(), professional.Id)
+		response := service.InstallmentBoleto(ctx, d.UserClientId, d.Id, d.Intallments[0].Id)
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "boleto account not set", response.Message)
+	})
 }
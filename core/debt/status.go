@@ -12,19 +12,28 @@ const (
 	Paid
 	Canceled
 	Reversed
+	PartiallyPaid
+	Renegotiated
+	Overdue
 )
 
 var statusString = map[status]string{
-	Pending:  "pending",
-	Paid:     "paid",
-	Canceled: "canceled",
-	Reversed: "reversed",
+	Pending:       "pending",
+	Paid:          "paid",
+	Canceled:      "canceled",
+	Reversed:      "reversed",
+	PartiallyPaid: "partially_paid",
+	Renegotiated:  "renegotiated",
+	Overdue:       "overdue",
 }
 var StatusValue = map[string]status{
-	"pending":  Pending,
-	"paid":     Paid,
-	"canceled": Canceled,
-	"reversed": Reversed,
+	"pending":        Pending,
+	"paid":           Paid,
+	"canceled":       Canceled,
+	"reversed":       Reversed,
+	"partially_paid": PartiallyPaid,
+	"renegotiated":   Renegotiated,
+	"overdue":        Overdue,
 }
 
 func (s status) String() string {
@@ -0,0 +1,62 @@
+package debt
+
+import "github.com/oklog/ulid/v2"
+
+type stockMovementType int
+
+const (
+	SaleMovement stockMovementType = iota
+	ReturnMovement
+)
+
+var stockMovementTypeString = map[stockMovementType]string{
+	SaleMovement:   "sale",
+	ReturnMovement: "return",
+}
+
+func (t stockMovementType) String() string {
+	if int(t) >= 0 && int(t) < len(stockMovementTypeString) {
+		return stockMovementTypeString[t]
+	}
+
+	return "unknown"
+}
+
+// StockMovement is a change in the stock of a product caused by the debt.
+// Quantity is negative when the product leaves the stock. Movements are not
+// loaded back with the debt: they are produced by its operations and written
+// by the repository in the same transaction as the debt itself.
+type StockMovement struct {
+	Id          ulid.ULID
+	ProductId   ulid.ULID
+	Type        stockMovementType
+	Quantity    int
+	Description string
+}
+
+// RegisterSale takes the products sold in the debt out of the stock.
+func (d *Debt) RegisterSale() {
+	d.addStockMovements(SaleMovement, -1, "sale of debt "+d.Id.String())
+}
+
+// restoreStock puts the products of a cancelled or reversed debt back in
+// the stock.
+func (d *Debt) restoreStock() {
+	d.addStockMovements(ReturnMovement, 1, "return of debt "+d.Id.String())
+}
+
+func (d *Debt) addStockMovements(movementType stockMovementType, sign int, description string) {
+	for _, item := range d.Items {
+		if item.Type != ProductItem {
+			continue
+		}
+
+		d.StockMovements = append(d.StockMovements, StockMovement{
+			Id:          ulid.Make(),
+			ProductId:   item.ReferenceId,
+			Type:        movementType,
+			Quantity:    sign * item.Quantity,
+			Description: description,
+		})
+	}
+}
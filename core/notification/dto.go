@@ -0,0 +1,23 @@
+package notification
+
+type NotificationDto struct {
+	Id            string `json:"id"`
+	Kind          string `json:"kind"`
+	Title         string `json:"title"`
+	Body          string `json:"body"`
+	DebtId        string `json:"debt_id"`
+	InstallmentId string `json:"installment_id,omitempty"`
+	ClientId      string `json:"client_id"`
+	Read          bool   `json:"read"`
+	ReadAt        string `json:"read_at,omitempty"`
+	CreatedAt     string `json:"created_at"`
+}
+
+type UnreadCountDto struct {
+	Unread int64 `json:"unread"`
+}
+
+type PaginationResult struct {
+	TotalRecords int             `json:"total_records"`
+	Data         []*Notification `json:"data"`
+}
@@ -0,0 +1,33 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type Notification struct {
+	ID            string     `gorm:"column:id;primaryKey;type:char(26)"`
+	UserID        string     `gorm:"column:user_id;type:char(26);not null"`
+	Kind          string     `gorm:"column:kind;type:varchar(40);not null"`
+	Title         string     `gorm:"column:title;type:text;not null"`
+	Body          string     `gorm:"column:body;type:text;not null"`
+	DebtID        string     `gorm:"column:debt_id;type:char(26);not null"`
+	InstallmentID *string    `gorm:"column:installment_id;type:char(26)"`
+	ClientID      string     `gorm:"column:client_id;type:char(26);not null"`
+	ReadAt        *time.Time `gorm:"column:read_at;type:timestamp"`
+	CreatedAt     *time.Time `gorm:"column:created_at;type:timestamp;not null"`
+	TenantID      string     `gorm:"column:tenant_id;type:char(26);not null"`
+}
+
+func (d *Notification) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == "" {
+		d.ID = ulid.Make().String()
+	}
+	return nil
+}
+
+func (d *Notification) TableName() string {
+	return "notifications"
+}
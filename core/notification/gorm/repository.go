@@ -0,0 +1,125 @@
+package gorm
+
+import (
+	"context"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/notification"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GormNotificationRepository struct {
+	db *gorm.DB
+}
+
+func NewGormNotificationRepository(db *gorm.DB) *GormNotificationRepository {
+	return &GormNotificationRepository{db: db}
+}
+
+// Create leaves out, through the unique index of the installment
+// notifications, a notification the user already has.
+func (g *GormNotificationRepository) Create(ctx context.Context, n *notification.Notification) error {
+	model := Notification{
+		ID:        n.Id.String(),
+		UserID:    n.UserId.String(),
+		Kind:      string(n.Kind),
+		Title:     n.Title,
+		Body:      n.Body,
+		DebtID:    n.DebtId.String(),
+		ClientID:  n.ClientId.String(),
+		ReadAt:    n.ReadAt,
+		CreatedAt: &n.CreatedAt,
+	}
+
+	if n.InstallmentId != nil {
+		installmentId := n.InstallmentId.String()
+		model.InstallmentID = &installmentId
+	}
+
+	return g.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&model).Error
+}
+
+func (g *GormNotificationRepository) Notifications(ctx context.Context, userId ulid.ULID, criteria paginate.SearchDto) (*notification.PaginationResult, error) {
+	var models []Notification
+	var total int64
+
+	err := g.db.WithContext(ctx).Model(&Notification{}).
+		Where("user_id = ?", userId.String()).
+		Count(&total).
+		Offset(criteria.Offset()).
+		Limit(criteria.Limit).
+		Order("created_at DESC, id DESC").
+		Find(&models).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	var notifications []*notification.Notification
+	for _, model := range models {
+		notifications = append(notifications, g.convertModelToNotification(model))
+	}
+
+	return &notification.PaginationResult{
+		TotalRecords: int(total),
+		Data:         notifications,
+	}, nil
+}
+
+func (g *GormNotificationRepository) UnreadCount(ctx context.Context, userId ulid.ULID) (int64, error) {
+	var unread int64
+
+	err := g.db.WithContext(ctx).Model(&Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userId.String()).
+		Count(&unread).Error
+
+	return unread, err
+}
+
+// MarkRead keeps the time a notification was first read.
+func (g *GormNotificationRepository) MarkRead(ctx context.Context, userId, id ulid.ULID, at time.Time) (bool, error) {
+	result := g.db.WithContext(ctx).Model(&Notification{}).
+		Where("id = ? AND user_id = ?", id.String(), userId.String()).
+		Update("read_at", gorm.Expr("COALESCE(read_at, ?)", at))
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (g *GormNotificationRepository) MarkAllRead(ctx context.Context, userId ulid.ULID, at time.Time) (int64, error) {
+	result := g.db.WithContext(ctx).Model(&Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userId.String()).
+		Update("read_at", at)
+
+	return result.RowsAffected, result.Error
+}
+
+func (g *GormNotificationRepository) convertModelToNotification(model Notification) *notification.Notification {
+	n := &notification.Notification{
+		Id:       ulid.MustParse(model.ID),
+		UserId:   ulid.MustParse(model.UserID),
+		Kind:     shared.EventName(model.Kind),
+		Title:    model.Title,
+		Body:     model.Body,
+		DebtId:   ulid.MustParse(model.DebtID),
+		ClientId: ulid.MustParse(model.ClientID),
+		ReadAt:   model.ReadAt,
+	}
+
+	if model.InstallmentID != nil {
+		installmentId := ulid.MustParse(*model.InstallmentID)
+		n.InstallmentId = &installmentId
+	}
+
+	if model.CreatedAt != nil {
+		n.CreatedAt = *model.CreatedAt
+	}
+
+	return n
+}
@@ -0,0 +1,141 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	setupdbtests "github.com/henriquerocha2004/quem-me-deve-api/config/setupDbTests"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/notification"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	ormdb "github.com/henriquerocha2004/quem-me-deve-api/core/shared/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/helpers"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/joho/godotenv"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/suite"
+	orm "gorm.io/gorm"
+)
+
+var gormDB *orm.DB = nil
+
+// userId is also the tenant of the tests, as every user is an account of
+// its own.
+var userId = ulid.Make()
+
+var tenantCtx = shared.WithTenant(context.Background(), userId)
+
+func TestMain(m *testing.M) {
+	envPath := helpers.ProjetctRoot() + ".env.testing"
+	err := godotenv.Overload(envPath)
+	if err != nil {
+		log.Println(err)
+		panic("Error loading .env file")
+	}
+
+	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
+		os.Getenv("DB_USER"),
+		os.Getenv("DB_PASSWORD"),
+		os.Getenv("DB_HOST"),
+		os.Getenv("DB_PORT"),
+		os.Getenv("DB_NAME"),
+	)
+
+	gormDB, err = ormdb.NewGorm(dsn)
+	if err != nil {
+		log.Println(err)
+		panic(err)
+	}
+	sql, err := gormDB.DB()
+	if err != nil {
+		log.Println(err)
+		panic(err)
+	}
+
+	sql.SetMaxIdleConns(10)
+	sql.SetMaxOpenConns(100)
+	sql.SetConnMaxLifetime(30 * time.Minute)
+
+	defer sql.Close()
+	m.Run()
+}
+
+type NotificationRepositorySuiteTest struct {
+	suite.Suite
+}
+
+func (s *NotificationRepositorySuiteTest) TearDownTest() {
+	err := setupdbtests.TruncateTables(gormDB)
+	if err != nil {
+		s.Fail("Failed to truncate tables: %v", err)
+	}
+}
+
+func TestNotificationRepositorySuite(t *testing.T) {
+	suite.Run(t, new(NotificationRepositorySuiteTest))
+}
+
+func (s *NotificationRepositorySuiteTest) newNotification(name shared.EventName, installmentId ulid.ULID, occurredAt time.Time) *notification.Notification {
+	n, _ := notification.New(userId, shared.Event{
+		Name:          name,
+		DebtId:        ulid.Make(),
+		InstallmentId: installmentId,
+		ClientId:      ulid.Make(),
+		OccurredAt:    occurredAt,
+	})
+
+	return n
+}
+
+func (s *NotificationRepositorySuiteTest) TestShouldNotifyInstallmentOnlyOncePerKind() {
+	repo := NewGormNotificationRepository(gormDB)
+	installmentId := ulid.Make()
+	now := time.Now()
+
+	s.NoError(repo.Create(tenantCtx, s.newNotification(shared.InstallmentDueSoon, installmentId, now)))
+	s.NoError(repo.Create(tenantCtx, s.newNotification(shared.InstallmentDueSoon, installmentId, now.Add(time.Hour))))
+	s.NoError(repo.Create(tenantCtx, s.newNotification(shared.InstallmentOverdue, installmentId, now.Add(2*time.Hour))))
+	s.NoError(repo.Create(tenantCtx, s.newNotification(shared.PaymentReceived, installmentId, now.Add(3*time.Hour))))
+	s.NoError(repo.Create(tenantCtx, s.newNotification(shared.PaymentReceived, installmentId, now.Add(4*time.Hour))))
+
+	criteria := paginate.SearchDto{Limit: 3}
+	criteria.SetPage(1)
+	result, err := repo.Notifications(tenantCtx, userId, criteria)
+	s.NoError(err)
+	s.Equal(4, result.TotalRecords)
+	s.Len(result.Data, 3)
+	s.Equal(shared.PaymentReceived, result.Data[0].Kind)
+	s.Equal(installmentId, *result.Data[0].InstallmentId)
+}
+
+func (s *NotificationRepositorySuiteTest) TestShouldMarkNotificationsOfUserAsRead() {
+	repo := NewGormNotificationRepository(gormDB)
+	first := s.newNotification(shared.DebtPaid, ulid.ULID{}, time.Now())
+	s.NoError(repo.Create(tenantCtx, first))
+	s.NoError(repo.Create(tenantCtx, s.newNotification(shared.DebtPaid, ulid.ULID{}, time.Now())))
+
+	otherUserId := ulid.Make()
+	otherCtx := shared.WithTenant(context.Background(), otherUserId)
+	found, err := repo.MarkRead(otherCtx, otherUserId, first.Id, time.Now())
+	s.NoError(err)
+	s.False(found)
+
+	found, err = repo.MarkRead(tenantCtx, userId, first.Id, time.Now())
+	s.NoError(err)
+	s.True(found)
+
+	unread, err := repo.UnreadCount(tenantCtx, userId)
+	s.NoError(err)
+	s.Equal(int64(1), unread)
+
+	marked, err := repo.MarkAllRead(tenantCtx, userId, time.Now())
+	s.NoError(err)
+	s.Equal(int64(1), marked)
+
+	unread, err = repo.UnreadCount(tenantCtx, userId)
+	s.NoError(err)
+	s.Zero(unread)
+}
@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notification/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=notification/repository.go -destination=notification/mocks/repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	notification "github.com/henriquerocha2004/quem-me-deve-api/core/notification"
+	paginate "github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	ulid "github.com/oklog/ulid/v2"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(ctx context.Context, arg1 *notification.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, arg1)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockRepository) MarkAllRead(ctx context.Context, userId ulid.ULID, at time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", ctx, userId, at)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockRepositoryMockRecorder) MarkAllRead(ctx, userId, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockRepository)(nil).MarkAllRead), ctx, userId, at)
+}
+
+// MarkRead mocks base method.
+func (m *MockRepository) MarkRead(ctx context.Context, userId, id ulid.ULID, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, userId, id, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockRepositoryMockRecorder) MarkRead(ctx, userId, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockRepository)(nil).MarkRead), ctx, userId, id, at)
+}
+
+// Notifications mocks base method.
+func (m *MockRepository) Notifications(ctx context.Context, userId ulid.ULID, criteria paginate.SearchDto) (*notification.PaginationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notifications", ctx, userId, criteria)
+	ret0, _ := ret[0].(*notification.PaginationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Notifications indicates an expected call of Notifications.
+func (mr *MockRepositoryMockRecorder) Notifications(ctx, userId, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notifications", reflect.TypeOf((*MockRepository)(nil).Notifications), ctx, userId, criteria)
+}
+
+// UnreadCount mocks base method.
+func (m *MockRepository) UnreadCount(ctx context.Context, userId ulid.ULID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnreadCount", ctx, userId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnreadCount indicates an expected call of UnreadCount.
+func (mr *MockRepositoryMockRecorder) UnreadCount(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnreadCount", reflect.TypeOf((*MockRepository)(nil).UnreadCount), ctx, userId)
+}
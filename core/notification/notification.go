@@ -0,0 +1,77 @@
+package notification
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/oklog/ulid/v2"
+)
+
+// Notification is an entry of the inbox of a user, shown in the app. Its
+// kind is the name of the event that raised it.
+type Notification struct {
+	Id            ulid.ULID
+	UserId        ulid.ULID
+	Kind          shared.EventName
+	Title         string
+	Body          string
+	DebtId        ulid.ULID
+	InstallmentId *ulid.ULID
+	ClientId      ulid.ULID
+	ReadAt        *time.Time
+	CreatedAt     time.Time
+}
+
+// New writes the notification of the event to the user. It returns false for
+// events that are not notified.
+func New(userId ulid.ULID, event shared.Event) (*Notification, bool) {
+	title, body, ok := describe(event)
+	if !ok {
+		return nil, false
+	}
+
+	notification := &Notification{
+		Id:        ulid.Make(),
+		UserId:    userId,
+		Kind:      event.Name,
+		Title:     title,
+		Body:      body,
+		DebtId:    event.DebtId,
+		ClientId:  event.ClientId,
+		CreatedAt: event.OccurredAt,
+	}
+
+	if event.InstallmentId != (ulid.ULID{}) {
+		installmentId := event.InstallmentId
+		notification.InstallmentId = &installmentId
+	}
+
+	return notification, true
+}
+
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+func describe(event shared.Event) (string, string, bool) {
+	amount := event.Amount.Format()
+	dueDate := event.DueDate.Format("02/01/2006")
+
+	switch event.Name {
+	case shared.InstallmentDueSoon:
+		return "Parcela a vencer",
+			fmt.Sprintf("A parcela %d, no valor de %s, vence em %s.", event.InstallmentNumber, amount, dueDate), true
+	case shared.InstallmentOverdue:
+		return "Parcela em atraso",
+			fmt.Sprintf("A parcela %d, no valor de %s, venceu em %s e não foi paga.", event.InstallmentNumber, amount, dueDate), true
+	case shared.PaymentReceived:
+		return "Pagamento recebido",
+			fmt.Sprintf("Recebido o pagamento de %s da parcela %d.", amount, event.InstallmentNumber), true
+	case shared.DebtPaid:
+		return "Dívida quitada",
+			fmt.Sprintf("A dívida no valor de %s foi quitada.", amount), true
+	}
+
+	return "", "", false
+}
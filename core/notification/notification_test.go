@@ -0,0 +1,70 @@
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+)
+
+func TestShouldDescribeEvents(t *testing.T) {
+	dueDate := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		event shared.EventName
+		title string
+		body  string
+	}{
+		{"due soon", shared.InstallmentDueSoon, "Parcela a vencer", "A parcela 2, no valor de R$ 1.234,50, vence em 10/03/2025."},
+		{"overdue", shared.InstallmentOverdue, "Parcela em atraso", "A parcela 2, no valor de R$ 1.234,50, venceu em 10/03/2025 e não foi paga."},
+		{"payment received", shared.PaymentReceived, "Pagamento recebido", "Recebido o pagamento de R$ 1.234,50 da parcela 2."},
+		{"debt paid", shared.DebtPaid, "Dívida quitada", "A dívida no valor de R$ 1.234,50 foi quitada."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notification, ok := New(ulid.Make(), shared.Event{
+				Name:              tt.event,
+				DebtId:            ulid.Make(),
+				InstallmentId:     ulid.Make(),
+				InstallmentNumber: 2,
+				Amount:            money.FromCents(123450),
+				DueDate:           dueDate,
+				OccurredAt:        time.Now(),
+			})
+			if !ok {
+				t.Fatalf("New() did not notify %s", tt.event)
+			}
+
+			if notification.Title != tt.title {
+				t.Errorf("Title = %q, want %q", notification.Title, tt.title)
+			}
+
+			if notification.Body != tt.body {
+				t.Errorf("Body = %q, want %q", notification.Body, tt.body)
+			}
+
+			if notification.IsRead() {
+				t.Errorf("IsRead() = true for a new notification")
+			}
+		})
+	}
+}
+
+func TestShouldLeaveInstallmentOutOfDebtNotification(t *testing.T) {
+	notification, ok := New(ulid.Make(), shared.Event{Name: shared.DebtPaid, DebtId: ulid.Make()})
+	if !ok {
+		t.Fatal("New() did not notify the debt paid")
+	}
+
+	if notification.InstallmentId != nil {
+		t.Errorf("InstallmentId = %v, want nil", notification.InstallmentId)
+	}
+
+	if _, ok := New(ulid.Make(), shared.Event{Name: "debt_created"}); ok {
+		t.Errorf("New() notified an unknown event")
+	}
+}
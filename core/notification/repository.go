@@ -0,0 +1,21 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+)
+
+type Repository interface {
+	// Create stores the notification, unless the user was already notified
+	// of the same kind for the installment.
+	Create(ctx context.Context, notification *Notification) error
+	Notifications(ctx context.Context, userId ulid.ULID, criteria paginate.SearchDto) (*PaginationResult, error)
+	UnreadCount(ctx context.Context, userId ulid.ULID) (int64, error)
+	// MarkRead reports false when the user has no such notification.
+	MarkRead(ctx context.Context, userId, id ulid.ULID, at time.Time) (bool, error)
+	// MarkAllRead returns how many notifications were unread.
+	MarkAllRead(ctx context.Context, userId ulid.ULID, at time.Time) (int64, error)
+}
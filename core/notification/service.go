@@ -0,0 +1,190 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+)
+
+type Service interface {
+	Notifications(ctx context.Context, criteria *paginate.PaginateRequest) shared.ServiceResponse
+	UnreadCount(ctx context.Context) shared.ServiceResponse
+	MarkRead(ctx context.Context, id ulid.ULID) shared.ServiceResponse
+	MarkAllRead(ctx context.Context) shared.ServiceResponse
+}
+
+type NotificationService struct {
+	notifications Repository
+}
+
+func NewNotificationService(notifications Repository) *NotificationService {
+	return &NotificationService{
+		notifications: notifications,
+	}
+}
+
+var errNoTenant = errors.New("event published without a tenant")
+
+// Publish puts the notification of the event in the inbox of the account
+// the event happened in. Every user is an account of its own, so the user to
+// notify is the tenant of the event.
+func (s *NotificationService) Publish(ctx context.Context, event shared.Event) error {
+	tenantId, ok := shared.TenantFromContext(ctx)
+	if !ok {
+		return errNoTenant
+	}
+
+	notification, ok := New(tenantId, event)
+	if !ok {
+		return nil
+	}
+
+	return s.notifications.Create(ctx, notification)
+}
+
+func (s *NotificationService) Notifications(ctx context.Context, criteria *paginate.PaginateRequest) shared.ServiceResponse {
+	userId, ok := user.IdFromContext(ctx)
+	if !ok {
+		return s.userNotFound()
+	}
+
+	pagDto := paginate.SearchDto{
+		Limit:         criteria.Limit,
+		SortField:     criteria.SortField,
+		TermSearch:    criteria.SearchTerm,
+		SortDirection: criteria.SortDirection,
+	}
+
+	pagDto.SetPage(criteria.Page)
+
+	result, err := s.notifications.Notifications(ctx, userId, pagDto)
+	if err != nil {
+		log.Println("Error getting notifications:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in get notifications",
+		}
+	}
+
+	notificationsDto := []NotificationDto{}
+	for _, notification := range result.Data {
+		notificationsDto = append(notificationsDto, s.convertToNotificationDto(notification))
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "notifications retrieved successfully",
+		Data: paginate.Result{
+			TotalRecords: result.TotalRecords,
+			Data:         notificationsDto,
+		},
+	}
+}
+
+func (s *NotificationService) UnreadCount(ctx context.Context) shared.ServiceResponse {
+	userId, ok := user.IdFromContext(ctx)
+	if !ok {
+		return s.userNotFound()
+	}
+
+	unread, err := s.notifications.UnreadCount(ctx, userId)
+	if err != nil {
+		log.Println("Error counting unread notifications:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in count unread notifications",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "unread notifications counted",
+		Data:    UnreadCountDto{Unread: unread},
+	}
+}
+
+func (s *NotificationService) MarkRead(ctx context.Context, id ulid.ULID) shared.ServiceResponse {
+	userId, ok := user.IdFromContext(ctx)
+	if !ok {
+		return s.userNotFound()
+	}
+
+	found, err := s.notifications.MarkRead(ctx, userId, id, time.Now())
+	if err != nil {
+		log.Println("Error marking notification as read:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in mark notification as read",
+		}
+	}
+
+	if !found {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "notification not found",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "notification marked as read",
+	}
+}
+
+func (s *NotificationService) MarkAllRead(ctx context.Context) shared.ServiceResponse {
+	userId, ok := user.IdFromContext(ctx)
+	if !ok {
+		return s.userNotFound()
+	}
+
+	marked, err := s.notifications.MarkAllRead(ctx, userId, time.Now())
+	if err != nil {
+		log.Println("Error marking notifications as read:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in mark notifications as read",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "notifications marked as read",
+		Data:    map[string]int64{"marked": marked},
+	}
+}
+
+func (s *NotificationService) userNotFound() shared.ServiceResponse {
+	return shared.ServiceResponse{
+		Status:  "error",
+		Message: "user not found in context",
+	}
+}
+
+func (s *NotificationService) convertToNotificationDto(notification *Notification) NotificationDto {
+	notificationDto := NotificationDto{
+		Id:        notification.Id.String(),
+		Kind:      string(notification.Kind),
+		Title:     notification.Title,
+		Body:      notification.Body,
+		DebtId:    notification.DebtId.String(),
+		ClientId:  notification.ClientId.String(),
+		Read:      notification.IsRead(),
+		CreatedAt: notification.CreatedAt.Format(time.DateTime),
+	}
+
+	if notification.InstallmentId != nil {
+		notificationDto.InstallmentId = notification.InstallmentId.String()
+	}
+
+	if notification.ReadAt != nil {
+		notificationDto.ReadAt = notification.ReadAt.Format(time.DateTime)
+	}
+
+	return notificationDto
+}
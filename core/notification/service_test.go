@@ -0,0 +1,124 @@
+package notification_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/notification"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/notification/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNotificationService(t *testing.T) {
+	userId := ulid.Make()
+	userCtx := user.WithUserId(shared.WithTenant(context.Background(), userId), userId)
+
+	t.Run("should notify the user of the tenant the event happened in", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		installmentId := ulid.Make()
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n *notification.Notification) error {
+			assert.Equal(t, userId, n.UserId)
+			assert.Equal(t, shared.InstallmentOverdue, n.Kind)
+			assert.Equal(t, installmentId, *n.InstallmentId)
+			return nil
+		})
+
+		service := notification.NewNotificationService(repo)
+		err := service.Publish(shared.WithTenant(context.Background(), userId), shared.Event{
+			Name:          shared.InstallmentOverdue,
+			DebtId:        ulid.Make(),
+			InstallmentId: installmentId,
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should refuse an event published without a tenant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		service := notification.NewNotificationService(repo)
+		err := service.Publish(context.Background(), shared.Event{Name: shared.DebtPaid})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should list the notifications of the user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		n, _ := notification.New(userId, shared.Event{Name: shared.DebtPaid, DebtId: ulid.Make()})
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().Notifications(gomock.Any(), userId, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ ulid.ULID, criteria paginate.SearchDto) (*notification.PaginationResult, error) {
+				assert.Equal(t, 10, criteria.Offset())
+				return &notification.PaginationResult{TotalRecords: 11, Data: []*notification.Notification{n}}, nil
+			})
+
+		service := notification.NewNotificationService(repo)
+		response := service.Notifications(userCtx, &paginate.PaginateRequest{Page: 2, Limit: 10})
+
+		assert.Equal(t, "success", response.Status)
+		result := response.Data.(paginate.Result)
+		assert.Equal(t, 11, result.TotalRecords)
+		notifications := result.Data.([]notification.NotificationDto)
+		assert.Len(t, notifications, 1)
+		assert.Equal(t, "debt_paid", notifications[0].Kind)
+		assert.False(t, notifications[0].Read)
+		assert.Empty(t, notifications[0].InstallmentId)
+	})
+
+	t.Run("should count the unread notifications", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().UnreadCount(gomock.Any(), userId).Return(int64(4), nil)
+
+		service := notification.NewNotificationService(repo)
+		response := service.UnreadCount(userCtx)
+
+		assert.Equal(t, "success", response.Status)
+		assert.Equal(t, notification.UnreadCountDto{Unread: 4}, response.Data)
+	})
+
+	t.Run("should not mark as read a notification of another user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		id := ulid.Make()
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().MarkRead(gomock.Any(), userId, id, gomock.Any()).Return(false, nil)
+
+		service := notification.NewNotificationService(repo)
+		response := service.MarkRead(userCtx, id)
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "notification not found", response.Message)
+	})
+
+	t.Run("should mark every notification of the user as read", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().MarkAllRead(gomock.Any(), userId, gomock.Any()).Return(int64(3), nil)
+
+		service := notification.NewNotificationService(repo)
+		response := service.MarkAllRead(userCtx)
+
+		assert.Equal(t, "success", response.Status)
+		assert.Equal(t, map[string]int64{"marked": 3}, response.Data)
+	})
+}
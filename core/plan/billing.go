@@ -0,0 +1,125 @@
+package plan
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+)
+
+// RenewSubscriptions charges the subscriptions whose trial or period is over
+// and tries the past due ones again. The ones still unpaid after the grace
+// period go back to the Free plan. A failure on one account does not stop
+// the others.
+func (s *PlanService) RenewSubscriptions(ctx context.Context, now time.Time) error {
+	due, err := s.subscriptions.DueForBilling(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	if len(due) == 0 {
+		return nil
+	}
+
+	free, err := s.plans.FindByType(ctx, Free.String())
+	if err != nil {
+		return err
+	}
+
+	for _, subscription := range due {
+		tenantCtx := shared.WithTenant(ctx, subscription.TenantId)
+		if err := s.renew(tenantCtx, subscription, free, now); err != nil {
+			log.Println("Error renewing subscription", subscription.Id, ":", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *PlanService) renew(ctx context.Context, subscription *Subscription, free *Plan, now time.Time) error {
+	plan, err := s.plans.FindById(ctx, subscription.PlanId)
+	if err != nil {
+		return err
+	}
+
+	if plan == nil || plan.Price.IsZero() {
+		subscription.Downgrade(free, Expired, now)
+		return s.subscriptions.Save(ctx, subscription)
+	}
+
+	charge := subscription.NewCharge(plan, now)
+	if err := s.collect(ctx, charge); err != nil {
+		return err
+	}
+
+	if charge.Paid {
+		subscription.Activate(charge)
+	} else {
+		subscription.MarkPastDue(now)
+		if subscription.GraceExpired(now) {
+			subscription.Downgrade(free, Expired, now)
+		}
+	}
+
+	return s.subscriptions.Save(ctx, subscription)
+}
+
+// collect sends the charge to the payment provider and records it, paid or
+// not. Only a failure to record it is returned: a declined payment is told
+// by charge.Paid. Without a provider the charge is declined.
+func (s *PlanService) collect(ctx context.Context, charge *Charge) error {
+	reference, err := "", ErrNoPaymentProvider
+	if s.provider != nil {
+		reference, err = s.provider.Charge(ctx, charge)
+	}
+
+	if err != nil {
+		log.Println("Error charging subscription:", err)
+	}
+
+	charge.Paid = err == nil
+	charge.Reference = reference
+
+	return s.subscriptions.SaveCharge(ctx, charge)
+}
+
+// ReadOnly reports whether the account uses more than its plan allows, as
+// after a downgrade to Free. Nothing is deleted then, but the account can
+// only read its data until it upgrades or gets back within the limits.
+func (s *PlanService) ReadOnly(ctx context.Context) (bool, error) {
+	_, plan, err := s.current(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	usage, err := s.planUsage(ctx, plan)
+	if err != nil {
+		return false, err
+	}
+
+	return overLimit(plan, usage), nil
+}
+
+func (s *PlanService) planUsage(ctx context.Context, plan *Plan) (map[FeatureDesc]uint, error) {
+	usage := make(map[FeatureDesc]uint)
+	for _, feature := range plan.Features {
+		used, err := s.usage.Usage(ctx, feature.Name)
+		if err != nil {
+			return nil, err
+		}
+		usage[feature.Name] = used
+	}
+
+	return usage, nil
+}
+
+func overLimit(plan *Plan, usage map[FeatureDesc]uint) bool {
+	for _, feature := range plan.Features {
+		if usage[feature.Name] > feature.ResourceLimit {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,30 @@
+package plan
+
+import "github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+
+type FeatureDto struct {
+	Name          string `json:"name"`
+	ResourceLimit uint   `json:"resource_limit"`
+	Usage         *uint  `json:"usage,omitempty"`
+}
+
+type PlanDto struct {
+	Id       string       `json:"id"`
+	Type     string       `json:"type"`
+	Price    money.Money  `json:"price"`
+	Features []FeatureDto `json:"features"`
+}
+
+type SubscriptionDto struct {
+	Plan             PlanDto `json:"plan"`
+	Status           string  `json:"status,omitempty"`
+	StartedAt        string  `json:"started_at,omitempty"`
+	TrialEndsAt      string  `json:"trial_ends_at,omitempty"`
+	CurrentPeriodEnd string  `json:"current_period_end,omitempty"`
+	GraceEndsAt      string  `json:"grace_ends_at,omitempty"`
+	ReadOnly         bool    `json:"read_only"`
+}
+
+type SubscribeDto struct {
+	PlanId string `json:"plan_id" validate:"required,ulid"`
+}
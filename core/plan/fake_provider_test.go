@@ -0,0 +1,46 @@
+package plan
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// FakePaymentProvider approves every charge, unless told to decline them.
+// It stands in for a real provider in the tests.
+type FakePaymentProvider struct {
+	mu      sync.Mutex
+	decline bool
+	charges []Charge
+}
+
+func NewFakePaymentProvider() *FakePaymentProvider {
+	return &FakePaymentProvider{}
+}
+
+func (f *FakePaymentProvider) Decline(decline bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.decline = decline
+}
+
+func (f *FakePaymentProvider) Charge(ctx context.Context, charge *Charge) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.decline {
+		return "", ErrPaymentDeclined
+	}
+
+	f.charges = append(f.charges, *charge)
+	return fmt.Sprintf("fake_%s", charge.Id), nil
+}
+
+// Charges returns the charges approved so far.
+func (f *FakePaymentProvider) Charges() []Charge {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]Charge(nil), f.charges...)
+}
@@ -0,0 +1,114 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type Plan struct {
+	ID       string      `gorm:"column:id;primaryKey;type:char(26)"`
+	Type     string      `gorm:"column:type;type:varchar(20);not null"`
+	Price    money.Money `gorm:"column:price;type:decimal(12,2);not null"`
+	Features []Feature   `gorm:"foreignKey:PlanID"`
+}
+
+func (d *Plan) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == "" {
+		d.ID = ulid.Make().String()
+	}
+	return nil
+}
+
+func (d *Plan) TableName() string {
+	return "plans"
+}
+
+type Feature struct {
+	ID            string `gorm:"column:id;primaryKey;type:char(26)"`
+	Name          string `gorm:"column:name;type:varchar(20);not null"`
+	ResourceLimit uint   `gorm:"column:resource_limit;type:int;not null"`
+	PlanID        string `gorm:"column:plan_id;type:char(26);not null"`
+}
+
+func (d *Feature) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == "" {
+		d.ID = ulid.Make().String()
+	}
+	return nil
+}
+
+func (d *Feature) TableName() string {
+	return "plan_features"
+}
+
+type Subscription struct {
+	ID                 string     `gorm:"column:id;primaryKey;type:char(26)"`
+	PlanID             string     `gorm:"column:plan_id;type:char(26);not null"`
+	Status             string     `gorm:"column:status;type:varchar(20);not null"`
+	StartedAt          *time.Time `gorm:"column:started_at;type:timestamp"`
+	TrialEndsAt        *time.Time `gorm:"column:trial_ends_at;type:timestamp"`
+	CurrentPeriodStart *time.Time `gorm:"column:current_period_start;type:timestamp"`
+	CurrentPeriodEnd   *time.Time `gorm:"column:current_period_end;type:timestamp"`
+	GraceEndsAt        *time.Time `gorm:"column:grace_ends_at;type:timestamp"`
+	LastChargeAt       *time.Time `gorm:"column:last_charge_at;type:timestamp"`
+	TenantID           string     `gorm:"column:tenant_id;type:char(26);not null"`
+}
+
+func (d *Subscription) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == "" {
+		d.ID = ulid.Make().String()
+	}
+	return nil
+}
+
+func (d *Subscription) TableName() string {
+	return "subscriptions"
+}
+
+type Charge struct {
+	ID          string      `gorm:"column:id;primaryKey;type:char(26)"`
+	PlanID      string      `gorm:"column:plan_id;type:char(26);not null"`
+	Amount      money.Money `gorm:"column:amount;type:decimal(12,2);not null"`
+	PeriodStart *time.Time  `gorm:"column:period_start;type:timestamp;not null"`
+	PeriodEnd   *time.Time  `gorm:"column:period_end;type:timestamp;not null"`
+	Paid        bool        `gorm:"column:paid;type:boolean;not null"`
+	Reference   string      `gorm:"column:reference;type:varchar(100)"`
+	CreatedAt   *time.Time  `gorm:"column:created_at;type:timestamp;not null"`
+	TenantID    string      `gorm:"column:tenant_id;type:char(26);not null"`
+}
+
+func (d *Charge) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == "" {
+		d.ID = ulid.Make().String()
+	}
+	return nil
+}
+
+func (d *Charge) TableName() string {
+	return "subscription_charges"
+}
+
+// usedClient and usedDebt map only what is needed to count the usage of an
+// account, keeping the tenant column so that the count is scoped to it.
+type usedClient struct {
+	ID        string `gorm:"column:id"`
+	TenantID  string `gorm:"column:tenant_id"`
+	DeletedAt gorm.DeletedAt
+}
+
+func (d *usedClient) TableName() string {
+	return "clients"
+}
+
+type usedDebt struct {
+	ID       string `gorm:"column:id"`
+	Status   string `gorm:"column:status"`
+	TenantID string `gorm:"column:tenant_id"`
+}
+
+func (d *usedDebt) TableName() string {
+	return "debts"
+}
@@ -0,0 +1,214 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/plan"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GormPlanRepository struct {
+	db *gorm.DB
+}
+
+func NewGormPlanRepository(db *gorm.DB) *GormPlanRepository {
+	return &GormPlanRepository{db: db}
+}
+
+func (p *GormPlanRepository) FindAll(ctx context.Context) ([]*plan.Plan, error) {
+	var models []Plan
+
+	err := p.db.WithContext(ctx).Preload("Features").Order("price").Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var plans []*plan.Plan
+	for _, model := range models {
+		plans = append(plans, p.convertModelToPlan(model))
+	}
+
+	return plans, nil
+}
+
+func (p *GormPlanRepository) FindById(ctx context.Context, id ulid.ULID) (*plan.Plan, error) {
+	return p.findBy(ctx, "id = ?", id.String())
+}
+
+func (p *GormPlanRepository) FindByType(ctx context.Context, planType string) (*plan.Plan, error) {
+	return p.findBy(ctx, "type = ?", planType)
+}
+
+func (p *GormPlanRepository) findBy(ctx context.Context, query string, arg any) (*plan.Plan, error) {
+	var model Plan
+
+	err := p.db.WithContext(ctx).Where(query, arg).Preload("Features").First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return p.convertModelToPlan(model), nil
+}
+
+func (p *GormPlanRepository) convertModelToPlan(model Plan) *plan.Plan {
+	result := &plan.Plan{
+		Id:    ulid.MustParse(model.ID),
+		Type:  plan.PlanTypeValue[model.Type],
+		Price: model.Price,
+	}
+
+	for _, feature := range model.Features {
+		result.AddFeature(plan.FeatureDesc(feature.Name), feature.ResourceLimit)
+	}
+
+	return result
+}
+
+type GormSubscriptionRepository struct {
+	db *gorm.DB
+}
+
+func NewGormSubscriptionRepository(db *gorm.DB) *GormSubscriptionRepository {
+	return &GormSubscriptionRepository{db: db}
+}
+
+func (s *GormSubscriptionRepository) Current(ctx context.Context) (*plan.Subscription, error) {
+	var model Subscription
+
+	err := s.db.WithContext(ctx).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return s.convertModelToSubscription(model), nil
+}
+
+// DueForBilling looks through every account, since it runs from the billing
+// job and not from a request. Past due subscriptions tried within the retry
+// interval are left for a later run, unless their grace period is over.
+func (s *GormSubscriptionRepository) DueForBilling(ctx context.Context, now time.Time) ([]*plan.Subscription, error) {
+	var models []Subscription
+
+	err := s.db.WithContext(shared.AcrossTenants(ctx)).
+		Where("(status IN ? AND current_period_end <= ?) OR "+
+			"(status = ? AND (last_charge_at IS NULL OR last_charge_at <= ? OR grace_ends_at <= ?))",
+			[]string{string(plan.Trialing), string(plan.Active)}, now,
+			string(plan.PastDue), now.Add(-plan.ChargeRetryInterval), now).
+		Order("id").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var subscriptions []*plan.Subscription
+	for _, model := range models {
+		subscriptions = append(subscriptions, s.convertModelToSubscription(model))
+	}
+
+	return subscriptions, nil
+}
+
+// Save keeps a single subscription per account, replacing the one already
+// there.
+func (s *GormSubscriptionRepository) Save(ctx context.Context, subscription *plan.Subscription) error {
+	model := Subscription{
+		ID:                 subscription.Id.String(),
+		PlanID:             subscription.PlanId.String(),
+		Status:             string(subscription.Status),
+		StartedAt:          subscription.StartedAt,
+		TrialEndsAt:        subscription.TrialEndsAt,
+		CurrentPeriodStart: subscription.CurrentPeriodStart,
+		CurrentPeriodEnd:   subscription.CurrentPeriodEnd,
+		GraceEndsAt:        subscription.GraceEndsAt,
+		LastChargeAt:       subscription.LastChargeAt,
+	}
+
+	return s.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "tenant_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"plan_id",
+				"status",
+				"started_at",
+				"trial_ends_at",
+				"current_period_start",
+				"current_period_end",
+				"grace_ends_at",
+				"last_charge_at",
+			}),
+		}).
+		Create(&model).Error
+}
+
+func (s *GormSubscriptionRepository) SaveCharge(ctx context.Context, charge *plan.Charge) error {
+	model := Charge{
+		ID:          charge.Id.String(),
+		PlanID:      charge.PlanId.String(),
+		Amount:      charge.Amount,
+		PeriodStart: charge.PeriodStart,
+		PeriodEnd:   charge.PeriodEnd,
+		Paid:        charge.Paid,
+		Reference:   charge.Reference,
+		CreatedAt:   charge.CreatedAt,
+	}
+
+	return s.db.WithContext(ctx).Create(&model).Error
+}
+
+func (s *GormSubscriptionRepository) convertModelToSubscription(model Subscription) *plan.Subscription {
+	return &plan.Subscription{
+		Id:                 ulid.MustParse(model.ID),
+		TenantId:           ulid.MustParse(model.TenantID),
+		PlanId:             ulid.MustParse(model.PlanID),
+		Status:             plan.SubscriptionStatus(model.Status),
+		StartedAt:          model.StartedAt,
+		TrialEndsAt:        model.TrialEndsAt,
+		CurrentPeriodStart: model.CurrentPeriodStart,
+		CurrentPeriodEnd:   model.CurrentPeriodEnd,
+		GraceEndsAt:        model.GraceEndsAt,
+		LastChargeAt:       model.LastChargeAt,
+	}
+}
+
+type UsageGormRepository struct {
+	db *gorm.DB
+}
+
+func NewUsageGormRepository(db *gorm.DB) *UsageGormRepository {
+	return &UsageGormRepository{db: db}
+}
+
+// Usage counts the clients of the account, or its debts still open.
+func (u *UsageGormRepository) Usage(ctx context.Context, feature plan.FeatureDesc) (uint, error) {
+	var count int64
+	var err error
+
+	switch feature {
+	case plan.FeatureClient:
+		err = u.db.WithContext(ctx).Model(&usedClient{}).Count(&count).Error
+	case plan.FeatureDebt:
+		err = u.db.WithContext(ctx).Model(&usedDebt{}).
+			Where("status IN ?", []string{debt.Pending.String(), debt.PartiallyPaid.String(), debt.Overdue.String()}).
+			Count(&count).Error
+	default:
+		return 0, errors.New("unknown feature: " + string(feature))
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return uint(count), nil
+}
@@ -0,0 +1,207 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	setupdbtests "github.com/henriquerocha2004/quem-me-deve-api/config/setupDbTests"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/plan"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	ormdb "github.com/henriquerocha2004/quem-me-deve-api/core/shared/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/helpers"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/joho/godotenv"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/suite"
+	orm "gorm.io/gorm"
+)
+
+var gormDB *orm.DB = nil
+
+func TestMain(m *testing.M) {
+	envPath := helpers.ProjetctRoot() + ".env.testing"
+	err := godotenv.Overload(envPath)
+	if err != nil {
+		log.Println(err)
+		panic("Error loading .env file")
+	}
+
+	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
+		os.Getenv("DB_USER"),
+		os.Getenv("DB_PASSWORD"),
+		os.Getenv("DB_HOST"),
+		os.Getenv("DB_PORT"),
+		os.Getenv("DB_NAME"),
+	)
+
+	gormDB, err = ormdb.NewGorm(dsn)
+	if err != nil {
+		log.Println(err)
+		panic(err)
+	}
+	sql, err := gormDB.DB()
+	if err != nil {
+		log.Println(err)
+		panic(err)
+	}
+
+	sql.SetMaxIdleConns(10)
+	sql.SetMaxOpenConns(100)
+	sql.SetConnMaxLifetime(30 * time.Minute)
+
+	defer sql.Close()
+	m.Run()
+}
+
+type PlanRepositorySuiteTest struct {
+	suite.Suite
+}
+
+func (s *PlanRepositorySuiteTest) TearDownTest() {
+	err := setupdbtests.TruncateTables(gormDB)
+	if err != nil {
+		s.Fail("Failed to truncate tables: %v", err)
+	}
+}
+
+func TestPlanRepositorySuite(t *testing.T) {
+	suite.Run(t, new(PlanRepositorySuiteTest))
+}
+
+func (s *PlanRepositorySuiteTest) createPlans() (*Plan, *Plan) {
+	free := &Plan{
+		ID:   ulid.Make().String(),
+		Type: "Free",
+		Features: []Feature{
+			{Name: "client", ResourceLimit: 1},
+			{Name: "debt", ResourceLimit: 2},
+		},
+	}
+	pro := &Plan{
+		ID:    ulid.Make().String(),
+		Type:  "Pro",
+		Price: money.FromCents(2990),
+	}
+
+	s.NoError(gormDB.Create(free).Error)
+	s.NoError(gormDB.Create(pro).Error)
+
+	return free, pro
+}
+
+func (s *PlanRepositorySuiteTest) TestShouldFindPlansWithFeatures() {
+	free, _ := s.createPlans()
+	repo := NewGormPlanRepository(gormDB)
+
+	plans, err := repo.FindAll(context.Background())
+	s.NoError(err)
+	s.Len(plans, 2)
+
+	found, err := repo.FindByType(context.Background(), "Free")
+	s.NoError(err)
+	s.Equal(free.ID, found.Id.String())
+
+	limit, limited := found.Limit(plan.FeatureDebt)
+	s.True(limited)
+	s.Equal(uint(2), limit)
+}
+
+func (s *PlanRepositorySuiteTest) TestShouldKeepOneSubscriptionPerTenant() {
+	free, pro := s.createPlans()
+	repo := NewGormSubscriptionRepository(gormDB)
+	ctx := shared.WithTenant(context.Background(), ulid.Make())
+	otherTenantCtx := shared.WithTenant(context.Background(), ulid.Make())
+	now := time.Now()
+
+	current, err := repo.Current(ctx)
+	s.NoError(err)
+	s.Nil(current)
+
+	err = repo.Save(ctx, &plan.Subscription{Id: ulid.Make(), PlanId: ulid.MustParse(free.ID), Status: plan.Active, StartedAt: &now})
+	s.NoError(err)
+
+	subscription := &plan.Subscription{Id: ulid.Make()}
+	subscription.StartTrial(&plan.Plan{Id: ulid.MustParse(pro.ID)}, now)
+	err = repo.Save(ctx, subscription)
+	s.NoError(err)
+
+	current, err = repo.Current(ctx)
+	s.NoError(err)
+	s.Equal(pro.ID, current.PlanId.String())
+	s.Equal(plan.Trialing, current.Status)
+	s.NotNil(current.TrialEndsAt)
+
+	other, err := repo.Current(otherTenantCtx)
+	s.NoError(err)
+	s.Nil(other)
+}
+
+func (s *PlanRepositorySuiteTest) TestShouldFindSubscriptionsDueForBillingOfEveryTenant() {
+	_, pro := s.createPlans()
+	repo := NewGormSubscriptionRepository(gormDB)
+	proPlan := &plan.Plan{Id: ulid.MustParse(pro.ID), Price: pro.Price}
+	now := time.Now()
+
+	endedTrial := &plan.Subscription{Id: ulid.Make()}
+	endedTrial.StartTrial(proPlan, now.AddDate(0, 0, -plan.TrialDays-1))
+	endedTrialTenant := ulid.Make()
+	s.NoError(repo.Save(shared.WithTenant(context.Background(), endedTrialTenant), endedTrial))
+
+	running := &plan.Subscription{Id: ulid.Make()}
+	running.StartTrial(proPlan, now)
+	s.NoError(repo.Save(shared.WithTenant(context.Background(), ulid.Make()), running))
+
+	pastDue := &plan.Subscription{Id: ulid.Make()}
+	pastDue.Activate(pastDue.NewCharge(proPlan, now.AddDate(0, -1, -1)))
+	pastDue.MarkPastDue(now.Add(-plan.ChargeRetryInterval))
+	s.NoError(repo.Save(shared.WithTenant(context.Background(), ulid.Make()), pastDue))
+
+	triedToday := &plan.Subscription{Id: ulid.Make()}
+	triedToday.Activate(triedToday.NewCharge(proPlan, now.AddDate(0, -1, -1)))
+	triedToday.MarkPastDue(now.Add(-time.Hour))
+	s.NoError(repo.Save(shared.WithTenant(context.Background(), ulid.Make()), triedToday))
+
+	due, err := repo.DueForBilling(context.Background(), now)
+	s.NoError(err)
+	s.Len(due, 2)
+	s.Equal(endedTrial.Id, due[0].Id)
+	s.Equal(endedTrialTenant, due[0].TenantId)
+	s.Equal(pastDue.Id, due[1].Id)
+}
+
+func (s *PlanRepositorySuiteTest) TestShouldSaveChargeOfTheTenant() {
+	_, pro := s.createPlans()
+	repo := NewGormSubscriptionRepository(gormDB)
+	tenantId := ulid.Make()
+	subscription := &plan.Subscription{Id: ulid.Make()}
+	charge := subscription.NewCharge(&plan.Plan{Id: ulid.MustParse(pro.ID), Price: pro.Price}, time.Now())
+	charge.Paid = true
+	charge.Reference = "fake-1"
+
+	err := repo.SaveCharge(shared.WithTenant(context.Background(), tenantId), charge)
+	s.NoError(err)
+
+	var model Charge
+	s.NoError(gormDB.WithContext(shared.WithTenant(context.Background(), tenantId)).First(&model).Error)
+	s.Equal(charge.Id.String(), model.ID)
+	s.True(model.Paid)
+}
+
+func (s *PlanRepositorySuiteTest) TestShouldCountUsageOfTheTenant() {
+	repo := NewUsageGormRepository(gormDB)
+	tenantId := ulid.Make()
+	ctx := shared.WithTenant(context.Background(), tenantId)
+
+	err := gormDB.Exec(`INSERT INTO clients (id, name, last_name, document, entity_type, birth_day, tenant_id)
+		VALUES (?, 'John', 'Doe', '61824136030', 'PF', '2000-01-01', ?), (?, 'Jane', 'Doe', '51009194003', 'PF', '2000-01-01', ?)`,
+		ulid.Make().String(), tenantId.String(), ulid.Make().String(), ulid.Make().String()).Error
+	s.NoError(err)
+
+	used, err := repo.Usage(ctx, plan.FeatureClient)
+	s.NoError(err)
+	s.Equal(uint(1), used)
+}
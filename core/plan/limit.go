@@ -0,0 +1,34 @@
+package plan
+
+import (
+	"context"
+	"errors"
+)
+
+// LimitReachedCode identifies the responses refused by a plan limit, so that
+// the app can offer an upgrade instead of showing a failure.
+const LimitReachedCode = "plan_limit_reached"
+
+var ErrLimitReached = errors.New("plan limit reached")
+
+type LimitChecker interface {
+	// ReserveLimit returns ErrLimitReached when the account already uses all
+	// the resources of the feature its plan allows. Otherwise the usage of
+	// the feature is held until release is called, once the new resource is
+	// stored, so that concurrent creates are counted one after the other.
+	ReserveLimit(ctx context.Context, feature FeatureDesc) (release func(), err error)
+}
+
+// Locker holds a lock shared by every API instance, waiting for it while it
+// is taken.
+type Locker interface {
+	Lock(ctx context.Context, name string) (unlock func(), err error)
+}
+
+// ReadOnlyCode identifies the writes refused because the account uses more
+// than its plan allows, as after going back to Free.
+const ReadOnlyCode = "plan_read_only"
+
+type ReadOnlyChecker interface {
+	ReadOnly(ctx context.Context) (bool, error)
+}
@@ -0,0 +1,279 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: plan/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=plan/repository.go -destination=plan/mocks/repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	plan "github.com/henriquerocha2004/quem-me-deve-api/core/plan"
+	ulid "github.com/oklog/ulid/v2"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReader is a mock of Reader interface.
+type MockReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockReaderMockRecorder is the mock recorder for MockReader.
+type MockReaderMockRecorder struct {
+	mock *MockReader
+}
+
+// NewMockReader creates a new mock instance.
+func NewMockReader(ctrl *gomock.Controller) *MockReader {
+	mock := &MockReader{ctrl: ctrl}
+	mock.recorder = &MockReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReader) EXPECT() *MockReaderMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockReader) FindAll(ctx context.Context) ([]*plan.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*plan.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockReaderMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockReader)(nil).FindAll), ctx)
+}
+
+// FindById mocks base method.
+func (m *MockReader) FindById(ctx context.Context, id ulid.ULID) (*plan.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*plan.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockReaderMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockReader)(nil).FindById), ctx, id)
+}
+
+// FindByType mocks base method.
+func (m *MockReader) FindByType(ctx context.Context, planType string) (*plan.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByType", ctx, planType)
+	ret0, _ := ret[0].(*plan.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByType indicates an expected call of FindByType.
+func (mr *MockReaderMockRecorder) FindByType(ctx, planType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByType", reflect.TypeOf((*MockReader)(nil).FindByType), ctx, planType)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockRepository) FindAll(ctx context.Context) ([]*plan.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*plan.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockRepository)(nil).FindAll), ctx)
+}
+
+// FindById mocks base method.
+func (m *MockRepository) FindById(ctx context.Context, id ulid.ULID) (*plan.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*plan.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockRepository)(nil).FindById), ctx, id)
+}
+
+// FindByType mocks base method.
+func (m *MockRepository) FindByType(ctx context.Context, planType string) (*plan.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByType", ctx, planType)
+	ret0, _ := ret[0].(*plan.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByType indicates an expected call of FindByType.
+func (mr *MockRepositoryMockRecorder) FindByType(ctx, planType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByType", reflect.TypeOf((*MockRepository)(nil).FindByType), ctx, planType)
+}
+
+// MockSubscriptionRepository is a mock of SubscriptionRepository interface.
+type MockSubscriptionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscriptionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSubscriptionRepositoryMockRecorder is the mock recorder for MockSubscriptionRepository.
+type MockSubscriptionRepositoryMockRecorder struct {
+	mock *MockSubscriptionRepository
+}
+
+// NewMockSubscriptionRepository creates a new mock instance.
+func NewMockSubscriptionRepository(ctrl *gomock.Controller) *MockSubscriptionRepository {
+	mock := &MockSubscriptionRepository{ctrl: ctrl}
+	mock.recorder = &MockSubscriptionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubscriptionRepository) EXPECT() *MockSubscriptionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Current mocks base method.
+func (m *MockSubscriptionRepository) Current(ctx context.Context) (*plan.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", ctx)
+	ret0, _ := ret[0].(*plan.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockSubscriptionRepositoryMockRecorder) Current(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockSubscriptionRepository)(nil).Current), ctx)
+}
+
+// DueForBilling mocks base method.
+func (m *MockSubscriptionRepository) DueForBilling(ctx context.Context, now time.Time) ([]*plan.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DueForBilling", ctx, now)
+	ret0, _ := ret[0].([]*plan.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DueForBilling indicates an expected call of DueForBilling.
+func (mr *MockSubscriptionRepositoryMockRecorder) DueForBilling(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DueForBilling", reflect.TypeOf((*MockSubscriptionRepository)(nil).DueForBilling), ctx, now)
+}
+
+// Save mocks base method.
+func (m *MockSubscriptionRepository) Save(ctx context.Context, subscription *plan.Subscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockSubscriptionRepositoryMockRecorder) Save(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockSubscriptionRepository)(nil).Save), ctx, subscription)
+}
+
+// SaveCharge mocks base method.
+func (m *MockSubscriptionRepository) SaveCharge(ctx context.Context, charge *plan.Charge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCharge", ctx, charge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCharge indicates an expected call of SaveCharge.
+func (mr *MockSubscriptionRepositoryMockRecorder) SaveCharge(ctx, charge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCharge", reflect.TypeOf((*MockSubscriptionRepository)(nil).SaveCharge), ctx, charge)
+}
+
+// MockUsageReader is a mock of UsageReader interface.
+type MockUsageReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsageReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockUsageReaderMockRecorder is the mock recorder for MockUsageReader.
+type MockUsageReaderMockRecorder struct {
+	mock *MockUsageReader
+}
+
+// NewMockUsageReader creates a new mock instance.
+func NewMockUsageReader(ctrl *gomock.Controller) *MockUsageReader {
+	mock := &MockUsageReader{ctrl: ctrl}
+	mock.recorder = &MockUsageReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsageReader) EXPECT() *MockUsageReaderMockRecorder {
+	return m.recorder
+}
+
+// Usage mocks base method.
+func (m *MockUsageReader) Usage(ctx context.Context, feature plan.FeatureDesc) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Usage", ctx, feature)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Usage indicates an expected call of Usage.
+func (mr *MockUsageReaderMockRecorder) Usage(ctx, feature any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Usage", reflect.TypeOf((*MockUsageReader)(nil).Usage), ctx, feature)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
 	"github.com/oklog/ulid/v2"
 )
 
@@ -56,7 +57,7 @@ var PlanTypeValue = map[string]planType{
 type Plan struct {
 	Id       ulid.ULID
 	Type     planType
-	Price    float64
+	Price    money.Money
 	Features []Feature
 }
 
@@ -68,6 +69,18 @@ func (p *Plan) AddFeature(featDesc FeatureDesc, limit uint) {
 	p.Features = append(p.Features, feature)
 }
 
+// Limit returns how many resources of the feature the plan allows. A plan
+// without the feature does not limit it.
+func (p *Plan) Limit(featDesc FeatureDesc) (uint, bool) {
+	for _, feature := range p.Features {
+		if feature.Name == featDesc {
+			return feature.ResourceLimit, true
+		}
+	}
+
+	return 0, false
+}
+
 type Feature struct {
 	Name          FeatureDesc
 	ResourceLimit uint
@@ -0,0 +1,19 @@
+package plan
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrPaymentDeclined = errors.New("payment declined")
+
+// ErrNoPaymentProvider refuses the paid plans while no payment provider is
+// configured, so that they are never given away.
+var ErrNoPaymentProvider = errors.New("paid plans are not available")
+
+// PaymentProvider collects the charges of paid plans from the account
+// payment method kept by the provider.
+type PaymentProvider interface {
+	// Charge returns the reference of the payment at the provider.
+	Charge(ctx context.Context, charge *Charge) (string, error)
+}
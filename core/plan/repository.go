@@ -0,0 +1,34 @@
+package plan
+
+import (
+	"context"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+type Reader interface {
+	FindAll(ctx context.Context) ([]*Plan, error)
+	FindById(ctx context.Context, id ulid.ULID) (*Plan, error)
+	FindByType(ctx context.Context, planType string) (*Plan, error)
+}
+
+type Repository interface {
+	Reader
+}
+
+// SubscriptionRepository reads and writes the subscription of the account
+// the context is scoped to.
+type SubscriptionRepository interface {
+	Current(ctx context.Context) (*Subscription, error)
+	Save(ctx context.Context, subscription *Subscription) error
+	SaveCharge(ctx context.Context, charge *Charge) error
+	// DueForBilling returns, across every tenant, the subscriptions whose
+	// trial or paid period is over, along with the past due ones whose
+	// charge must be tried again.
+	DueForBilling(ctx context.Context, now time.Time) ([]*Subscription, error)
+}
+
+type UsageReader interface {
+	Usage(ctx context.Context, feature FeatureDesc) (uint, error)
+}
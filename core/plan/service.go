@@ -1,5 +1,300 @@
 package plan
 
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/oklog/ulid/v2"
+)
+
 type Service interface {
-	Create()
+	Plans(ctx context.Context) shared.ServiceResponse
+	Subscription(ctx context.Context) shared.ServiceResponse
+	Subscribe(ctx context.Context, dto *SubscribeDto) shared.ServiceResponse
+}
+
+type PlanService struct {
+	plans         Repository
+	subscriptions SubscriptionRepository
+	usage         UsageReader
+	provider      PaymentProvider
+	locker        Locker
+}
+
+// NewPlanService builds the plan service. Without a payment provider, the
+// paid plans can not be subscribed and every renewal charge is declined.
+func NewPlanService(plans Repository, subscriptions SubscriptionRepository, usage UsageReader, provider PaymentProvider) *PlanService {
+	return &PlanService{
+		plans:         plans,
+		subscriptions: subscriptions,
+		usage:         usage,
+		provider:      provider,
+	}
+}
+
+// WithLocker makes ReserveLimit hold the usage of the account across the API
+// instances. Without it, the usage is only checked.
+func (s *PlanService) WithLocker(locker Locker) *PlanService {
+	s.locker = locker
+	return s
+}
+
+func (s *PlanService) Plans(ctx context.Context) shared.ServiceResponse {
+	plans, err := s.plans.FindAll(ctx)
+	if err != nil {
+		log.Println("Error finding plans:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in find plans",
+		}
+	}
+
+	var plansDto []PlanDto
+	for _, p := range plans {
+		plansDto = append(plansDto, s.convertToPlanDto(p, nil))
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "plans found successfully",
+		Data:    plansDto,
+	}
+}
+
+func (s *PlanService) Subscription(ctx context.Context) shared.ServiceResponse {
+	subscription, plan, err := s.current(ctx)
+	if err != nil {
+		log.Println("Error finding subscription:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in find subscription",
+		}
+	}
+
+	usage, err := s.planUsage(ctx, plan)
+	if err != nil {
+		log.Println("Error finding plan usage:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in find subscription",
+		}
+	}
+
+	subscriptionDto := s.convertToSubscriptionDto(subscription, plan, usage)
+	subscriptionDto.ReadOnly = overLimit(plan, usage)
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "subscription found successfully",
+		Data:    subscriptionDto,
+	}
+}
+
+func (s *PlanService) Subscribe(ctx context.Context, dto *SubscribeDto) shared.ServiceResponse {
+	planId, _ := ulid.Parse(dto.PlanId)
+
+	plan, err := s.plans.FindById(ctx, planId)
+	if err != nil {
+		log.Println("Error finding plan:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in subscribe plan",
+		}
+	}
+
+	if plan == nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "plan not found",
+		}
+	}
+
+	subscription, err := s.subscriptions.Current(ctx)
+	if err != nil {
+		log.Println("Error finding subscription:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in subscribe plan",
+		}
+	}
+
+	if !plan.Price.IsZero() && s.provider == nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: ErrNoPaymentProvider.Error(),
+		}
+	}
+
+	if subscription == nil {
+		subscription = &Subscription{Id: ulid.Make()}
+	} else if subscription.PlanId == plan.Id && subscription.Status != Expired {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "account already subscribed to this plan",
+		}
+	}
+
+	now := time.Now()
+
+	switch {
+	case plan.Price.IsZero():
+		subscription.Downgrade(plan, Active, now)
+	case !subscription.TrialUsed():
+		subscription.StartTrial(plan, now)
+	default:
+		charge := subscription.NewCharge(plan, now)
+		if err := s.collect(ctx, charge); err != nil {
+			log.Println("Error saving charge:", err)
+			return shared.ServiceResponse{
+				Status:  "error",
+				Message: "error in subscribe plan",
+			}
+		}
+
+		if !charge.Paid {
+			return shared.ServiceResponse{
+				Status:  "error",
+				Message: ErrPaymentDeclined.Error(),
+			}
+		}
+
+		subscription.Activate(charge)
+	}
+
+	if err := s.subscriptions.Save(ctx, subscription); err != nil {
+		log.Println("Error saving subscription:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in subscribe plan",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "plan subscribed successfully",
+		Data:    s.convertToSubscriptionDto(subscription, plan, nil),
+	}
+}
+
+func (s *PlanService) ReserveLimit(ctx context.Context, feature FeatureDesc) (func(), error) {
+	release := func() {}
+
+	if s.locker != nil {
+		tenantId, _ := shared.TenantFromContext(ctx)
+
+		unlock, err := s.locker.Lock(ctx, "plan-limit:"+tenantId.String()+":"+string(feature))
+		if err != nil {
+			return nil, err
+		}
+		release = unlock
+	}
+
+	if err := s.CheckLimit(ctx, feature); err != nil {
+		release()
+		return nil, err
+	}
+
+	return release, nil
+}
+
+func (s *PlanService) CheckLimit(ctx context.Context, feature FeatureDesc) error {
+	_, plan, err := s.current(ctx)
+	if err != nil {
+		return err
+	}
+
+	limit, limited := plan.Limit(feature)
+	if !limited {
+		return nil
+	}
+
+	used, err := s.usage.Usage(ctx, feature)
+	if err != nil {
+		return err
+	}
+
+	if used >= limit {
+		return ErrLimitReached
+	}
+
+	return nil
+}
+
+// current returns the subscription of the account along with its plan,
+// falling back to the Free plan when the account never subscribed.
+func (s *PlanService) current(ctx context.Context) (*Subscription, *Plan, error) {
+	subscription, err := s.subscriptions.Current(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var plan *Plan
+	if subscription == nil {
+		plan, err = s.plans.FindByType(ctx, Free.String())
+	} else {
+		plan, err = s.plans.FindById(ctx, subscription.PlanId)
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if plan == nil {
+		return nil, nil, errors.New("plan of the account not found")
+	}
+
+	return subscription, plan, nil
+}
+
+func (s *PlanService) convertToSubscriptionDto(subscription *Subscription, plan *Plan, usage map[FeatureDesc]uint) SubscriptionDto {
+	subscriptionDto := SubscriptionDto{
+		Plan: s.convertToPlanDto(plan, usage),
+	}
+
+	if subscription == nil {
+		return subscriptionDto
+	}
+
+	subscriptionDto.Status = string(subscription.Status)
+	subscriptionDto.StartedAt = formatTime(subscription.StartedAt)
+	subscriptionDto.TrialEndsAt = formatTime(subscription.TrialEndsAt)
+	subscriptionDto.CurrentPeriodEnd = formatTime(subscription.CurrentPeriodEnd)
+	subscriptionDto.GraceEndsAt = formatTime(subscription.GraceEndsAt)
+
+	return subscriptionDto
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.DateTime)
+}
+
+func (s *PlanService) convertToPlanDto(plan *Plan, usage map[FeatureDesc]uint) PlanDto {
+	var features []FeatureDto
+	for _, feature := range plan.Features {
+		featureDto := FeatureDto{
+			Name:          string(feature.Name),
+			ResourceLimit: feature.ResourceLimit,
+		}
+
+		if usage != nil {
+			used := usage[feature.Name]
+			featureDto.Usage = &used
+		}
+
+		features = append(features, featureDto)
+	}
+
+	return PlanDto{
+		Id:       plan.Id.String(),
+		Type:     plan.Type.String(),
+		Price:    plan.Price,
+		Features: features,
+	}
 }
@@ -0,0 +1,412 @@
+package plan_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/plan"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/plan/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func freePlan() *plan.Plan {
+	p := &plan.Plan{Id: ulid.Make(), Type: plan.Free}
+	p.AddFeature(plan.FeatureClient, 10)
+	p.AddFeature(plan.FeatureDebt, 20)
+	return p
+}
+
+func TestPlanService(t *testing.T) {
+	t.Run("should allow while the usage is below the free plan limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		plans := mocks.NewMockRepository(ctrl)
+		plans.EXPECT().FindByType(gomock.Any(), "Free").Return(freePlan(), nil)
+		subscriptions := mocks.NewMockSubscriptionRepository(ctrl)
+		subscriptions.EXPECT().Current(gomock.Any()).Return(nil, nil)
+		usage := mocks.NewMockUsageReader(ctrl)
+		usage.EXPECT().Usage(gomock.Any(), plan.FeatureClient).Return(uint(9), nil)
+
+		service := plan.NewPlanService(plans, subscriptions, usage, plan.NewFakePaymentProvider())
+		err := service.CheckLimit(context.Background(), plan.FeatureClient)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should refuse when the usage reached the plan limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		plans := mocks.NewMockRepository(ctrl)
+		plans.EXPECT().FindByType(gomock.Any(), "Free").Return(freePlan(), nil)
+		subscriptions := mocks.NewMockSubscriptionRepository(ctrl)
+		subscriptions.EXPECT().Current(gomock.Any()).Return(nil, nil)
+		usage := mocks.NewMockUsageReader(ctrl)
+		usage.EXPECT().Usage(gomock.Any(), plan.FeatureDebt).Return(uint(20), nil)
+
+		service := plan.NewPlanService(plans, subscriptions, usage, plan.NewFakePaymentProvider())
+		err := service.CheckLimit(context.Background(), plan.FeatureDebt)
+
+		assert.ErrorIs(t, err, plan.ErrLimitReached)
+	})
+
+	t.Run("should hold the usage lock of the tenant until released", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tenantId := ulid.Make()
+		unlocked := false
+
+		plans := mocks.NewMockRepository(ctrl)
+		plans.EXPECT().FindByType(gomock.Any(), "Free").Return(freePlan(), nil)
+		subscriptions := mocks.NewMockSubscriptionRepository(ctrl)
+		subscriptions.EXPECT().Current(gomock.Any()).Return(nil, nil)
+		usage := mocks.NewMockUsageReader(ctrl)
+		usage.EXPECT().Usage(gomock.Any(), plan.FeatureClient).Return(uint(9), nil)
+		locker := mocks.NewMockLocker(ctrl)
+		locker.EXPECT().Lock(gomock.Any(), "plan-limit:"+tenantId.String()+":client").Return(func() { unlocked = true }, nil)
+
+		service := plan.NewPlanService(plans, subscriptions, usage, plan.NewFakePaymentProvider()).WithLocker(locker)
+		release, err := service.ReserveLimit(shared.WithTenant(context.Background(), tenantId), plan.FeatureClient)
+
+		assert.NoError(t, err)
+		assert.False(t, unlocked)
+		release()
+		assert.True(t, unlocked)
+	})
+
+	t.Run("should release the usage lock when the limit was reached", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		unlocked := false
+
+		plans := mocks.NewMockRepository(ctrl)
+		plans.EXPECT().FindByType(gomock.Any(), "Free").Return(freePlan(), nil)
+		subscriptions := mocks.NewMockSubscriptionRepository(ctrl)
+		subscriptions.EXPECT().Current(gomock.Any()).Return(nil, nil)
+		usage := mocks.NewMockUsageReader(ctrl)
+		usage.EXPECT().Usage(gomock.Any(), plan.FeatureDebt).Return(uint(20), nil)
+		locker := mocks.NewMockLocker(ctrl)
+		locker.EXPECT().Lock(gomock.Any(), gomock.Any()).Return(func() { unlocked = true }, nil)
+
+		service := plan.NewPlanService(plans, subscriptions, usage, plan.NewFakePaymentProvider()).WithLocker(locker)
+		release, err := service.ReserveLimit(shared.WithTenant(context.Background(), ulid.Make()), plan.FeatureDebt)
+
+		assert.ErrorIs(t, err, plan.ErrLimitReached)
+		assert.Nil(t, release)
+		assert.True(t, unlocked)
+	})
+
+	t.Run("should not limit a feature absent from the subscribed plan", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pro := &plan.Plan{Id: ulid.Make(), Type: plan.Pro, Price: money.FromCents(2990)}
+
+		plans := mocks.NewMockRepository(ctrl)
+		plans.EXPECT().FindById(gomock.Any(), pro.Id).Return(pro, nil)
+		subscriptions := mocks.NewMockSubscriptionRepository(ctrl)
+		subscriptions.EXPECT().Current(gomock.Any()).Return(&plan.Subscription{Id: ulid.Make(), PlanId: pro.Id}, nil)
+		usage := mocks.NewMockUsageReader(ctrl)
+		usage.EXPECT().Usage(gomock.Any(), gomock.Any()).Times(0)
+
+		service := plan.NewPlanService(plans, subscriptions, usage, plan.NewFakePaymentProvider())
+		err := service.CheckLimit(context.Background(), plan.FeatureDebt)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should show the usage of the current plan", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		plans := mocks.NewMockRepository(ctrl)
+		plans.EXPECT().FindByType(gomock.Any(), "Free").Return(freePlan(), nil)
+		subscriptions := mocks.NewMockSubscriptionRepository(ctrl)
+		subscriptions.EXPECT().Current(gomock.Any()).Return(nil, nil)
+		usage := mocks.NewMockUsageReader(ctrl)
+		usage.EXPECT().Usage(gomock.Any(), plan.FeatureClient).Return(uint(3), nil)
+		usage.EXPECT().Usage(gomock.Any(), plan.FeatureDebt).Return(uint(7), nil)
+
+		service := plan.NewPlanService(plans, subscriptions, usage, plan.NewFakePaymentProvider())
+		result := service.Subscription(context.Background())
+
+		assert.Equal(t, "success", result.Status)
+		subscription := result.Data.(plan.SubscriptionDto)
+		assert.Equal(t, "Free", subscription.Plan.Type)
+		assert.Equal(t, uint(3), *subscription.Plan.Features[0].Usage)
+		assert.Equal(t, uint(7), *subscription.Plan.Features[1].Usage)
+	})
+
+	t.Run("should start a trial on the first subscription to a paid plan", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pro := &plan.Plan{Id: ulid.Make(), Type: plan.Pro, Price: money.FromCents(2990)}
+
+		plans := mocks.NewMockRepository(ctrl)
+		plans.EXPECT().FindById(gomock.Any(), pro.Id).Return(pro, nil)
+		subscriptions := mocks.NewMockSubscriptionRepository(ctrl)
+		subscriptions.EXPECT().Current(gomock.Any()).Return(nil, nil)
+		subscriptions.EXPECT().SaveCharge(gomock.Any(), gomock.Any()).Times(0)
+		subscriptions.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s *plan.Subscription) error {
+			assert.Equal(t, pro.Id, s.PlanId)
+			assert.Equal(t, plan.Trialing, s.Status)
+			assert.NotNil(t, s.StartedAt)
+			assert.NotNil(t, s.TrialEndsAt)
+			return nil
+		})
+
+		service := plan.NewPlanService(plans, subscriptions, mocks.NewMockUsageReader(ctrl), plan.NewFakePaymentProvider())
+		result := service.Subscribe(context.Background(), &plan.SubscribeDto{PlanId: pro.Id.String()})
+
+		assert.Equal(t, "success", result.Status)
+		assert.Equal(t, "plan subscribed successfully", result.Message)
+	})
+
+	t.Run("should charge the subscription when the trial was already used", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		free := freePlan()
+		pro := &plan.Plan{Id: ulid.Make(), Type: plan.Pro, Price: money.FromCents(2990)}
+		current := &plan.Subscription{Id: ulid.Make()}
+		current.StartTrial(pro, time.Now().AddDate(0, -2, 0))
+		current.Downgrade(free, plan.Expired, time.Now().AddDate(0, -1, 0))
+
+		plans := mocks.NewMockRepository(ctrl)
+		plans.EXPECT().FindById(gomock.Any(), pro.Id).Return(pro, nil)
+		subscriptions := mocks.NewMockSubscriptionRepository(ctrl)
+		subscriptions.EXPECT().Current(gomock.Any()).Return(current, nil)
+		subscriptions.EXPECT().SaveCharge(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *plan.Charge) error {
+			assert.True(t, c.Paid)
+			assert.Equal(t, pro.Price, c.Amount)
+			assert.NotEmpty(t, c.Reference)
+			return nil
+		})
+		subscriptions.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s *plan.Subscription) error {
+			assert.Equal(t, pro.Id, s.PlanId)
+			assert.Equal(t, plan.Active, s.Status)
+			assert.NotNil(t, s.CurrentPeriodEnd)
+			return nil
+		})
+
+		provider := plan.NewFakePaymentProvider()
+		service := plan.NewPlanService(plans, subscriptions, mocks.NewMockUsageReader(ctrl), provider)
+		result := service.Subscribe(context.Background(), &plan.SubscribeDto{PlanId: pro.Id.String()})
+
+		assert.Equal(t, "success", result.Status)
+		assert.Len(t, provider.Charges(), 1)
+	})
+
+	t.Run("should keep the plan when the payment is declined", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		free := freePlan()
+		pro := &plan.Plan{Id: ulid.Make(), Type: plan.Pro, Price: money.FromCents(2990)}
+		current := &plan.Subscription{Id: ulid.Make()}
+		current.StartTrial(pro, time.Now().AddDate(0, -2, 0))
+		current.Downgrade(free, plan.Expired, time.Now().AddDate(0, -1, 0))
+
+		plans := mocks.NewMockRepository(ctrl)
+		plans.EXPECT().FindById(gomock.Any(), pro.Id).Return(pro, nil)
+		subscriptions := mocks.NewMockSubscriptionRepository(ctrl)
+		subscriptions.EXPECT().Current(gomock.Any()).Return(current, nil)
+		subscriptions.EXPECT().SaveCharge(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *plan.Charge) error {
+			assert.False(t, c.Paid)
+			return nil
+		})
+		subscriptions.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+		provider := plan.NewFakePaymentProvider()
+		provider.Decline(true)
+		service := plan.NewPlanService(plans, subscriptions, mocks.NewMockUsageReader(ctrl), provider)
+		result := service.Subscribe(context.Background(), &plan.SubscribeDto{PlanId: pro.Id.String()})
+
+		assert.Equal(t, "error", result.Status)
+		assert.Equal(t, "payment declined", result.Message)
+	})
+
+	t.Run("should refuse a paid plan without a payment provider", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pro := &plan.Plan{Id: ulid.Make(), Type: plan.Pro, Price: money.FromCents(2990)}
+
+		plans := mocks.NewMockRepository(ctrl)
+		plans.EXPECT().FindById(gomock.Any(), pro.Id).Return(pro, nil)
+		subscriptions := mocks.NewMockSubscriptionRepository(ctrl)
+		subscriptions.EXPECT().Current(gomock.Any()).Return(nil, nil)
+		subscriptions.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+		service := plan.NewPlanService(plans, subscriptions, mocks.NewMockUsageReader(ctrl), nil)
+		result := service.Subscribe(context.Background(), &plan.SubscribeDto{PlanId: pro.Id.String()})
+
+		assert.Equal(t, "error", result.Status)
+		assert.Equal(t, plan.ErrNoPaymentProvider.Error(), result.Message)
+	})
+
+	t.Run("should decline the renewal without a payment provider", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		now := time.Now()
+		pro := &plan.Plan{Id: ulid.Make(), Type: plan.Pro, Price: money.FromCents(2990)}
+		due := &plan.Subscription{Id: ulid.Make(), TenantId: ulid.Make()}
+		due.Activate(due.NewCharge(pro, now.AddDate(0, -1, 0)))
+
+		plans := mocks.NewMockRepository(ctrl)
+		plans.EXPECT().FindByType(gomock.Any(), "Free").Return(freePlan(), nil)
+		plans.EXPECT().FindById(gomock.Any(), pro.Id).Return(pro, nil)
+		subscriptions := mocks.NewMockSubscriptionRepository(ctrl)
+		subscriptions.EXPECT().DueForBilling(gomock.Any(), now).Return([]*plan.Subscription{due}, nil)
+		subscriptions.EXPECT().SaveCharge(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *plan.Charge) error {
+			assert.False(t, c.Paid)
+			return nil
+		})
+		subscriptions.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s *plan.Subscription) error {
+			assert.Equal(t, plan.PastDue, s.Status)
+			return nil
+		})
+
+		service := plan.NewPlanService(plans, subscriptions, mocks.NewMockUsageReader(ctrl), nil)
+		err := service.RenewSubscriptions(context.Background(), now)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should renew a subscription at the end of the period", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		now := time.Now()
+		pro := &plan.Plan{Id: ulid.Make(), Type: plan.Pro, Price: money.FromCents(2990)}
+		due := &plan.Subscription{Id: ulid.Make(), TenantId: ulid.Make()}
+		due.StartTrial(pro, now.AddDate(0, 0, -plan.TrialDays))
+
+		plans := mocks.NewMockRepository(ctrl)
+		plans.EXPECT().FindByType(gomock.Any(), "Free").Return(freePlan(), nil)
+		plans.EXPECT().FindById(gomock.Any(), pro.Id).Return(pro, nil)
+		subscriptions := mocks.NewMockSubscriptionRepository(ctrl)
+		subscriptions.EXPECT().DueForBilling(gomock.Any(), now).Return([]*plan.Subscription{due}, nil)
+		subscriptions.EXPECT().SaveCharge(gomock.Any(), gomock.Any()).Return(nil)
+		subscriptions.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, s *plan.Subscription) error {
+			tenantId, _ := shared.TenantFromContext(ctx)
+			assert.Equal(t, due.TenantId, tenantId)
+			assert.Equal(t, plan.Active, s.Status)
+			assert.Equal(t, now.AddDate(0, 1, 0), *s.CurrentPeriodEnd)
+			return nil
+		})
+
+		service := plan.NewPlanService(plans, subscriptions, mocks.NewMockUsageReader(ctrl), plan.NewFakePaymentProvider())
+		err := service.RenewSubscriptions(context.Background(), now)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should put the subscription past due when the renewal fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		now := time.Now()
+		pro := &plan.Plan{Id: ulid.Make(), Type: plan.Pro, Price: money.FromCents(2990)}
+		due := &plan.Subscription{Id: ulid.Make(), TenantId: ulid.Make()}
+		due.Activate(due.NewCharge(pro, now.AddDate(0, -1, 0)))
+
+		plans := mocks.NewMockRepository(ctrl)
+		plans.EXPECT().FindByType(gomock.Any(), "Free").Return(freePlan(), nil)
+		plans.EXPECT().FindById(gomock.Any(), pro.Id).Return(pro, nil)
+		subscriptions := mocks.NewMockSubscriptionRepository(ctrl)
+		subscriptions.EXPECT().DueForBilling(gomock.Any(), now).Return([]*plan.Subscription{due}, nil)
+		subscriptions.EXPECT().SaveCharge(gomock.Any(), gomock.Any()).Return(nil)
+		subscriptions.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s *plan.Subscription) error {
+			assert.Equal(t, pro.Id, s.PlanId)
+			assert.Equal(t, plan.PastDue, s.Status)
+			assert.Equal(t, now.AddDate(0, 0, plan.GracePeriodDays), *s.GraceEndsAt)
+			return nil
+		})
+
+		provider := plan.NewFakePaymentProvider()
+		provider.Decline(true)
+		service := plan.NewPlanService(plans, subscriptions, mocks.NewMockUsageReader(ctrl), provider)
+		err := service.RenewSubscriptions(context.Background(), now)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should downgrade to free when the grace period ends unpaid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		now := time.Now()
+		free := freePlan()
+		pro := &plan.Plan{Id: ulid.Make(), Type: plan.Pro, Price: money.FromCents(2990)}
+		due := &plan.Subscription{Id: ulid.Make(), TenantId: ulid.Make()}
+		due.Activate(due.NewCharge(pro, now.AddDate(0, -1, -plan.GracePeriodDays)))
+		due.MarkPastDue(now.AddDate(0, 0, -plan.GracePeriodDays))
+
+		plans := mocks.NewMockRepository(ctrl)
+		plans.EXPECT().FindByType(gomock.Any(), "Free").Return(free, nil)
+		plans.EXPECT().FindById(gomock.Any(), pro.Id).Return(pro, nil)
+		subscriptions := mocks.NewMockSubscriptionRepository(ctrl)
+		subscriptions.EXPECT().DueForBilling(gomock.Any(), now).Return([]*plan.Subscription{due}, nil)
+		subscriptions.EXPECT().SaveCharge(gomock.Any(), gomock.Any()).Return(nil)
+		subscriptions.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s *plan.Subscription) error {
+			assert.Equal(t, free.Id, s.PlanId)
+			assert.Equal(t, plan.Expired, s.Status)
+			assert.Nil(t, s.CurrentPeriodEnd)
+			return nil
+		})
+
+		provider := plan.NewFakePaymentProvider()
+		provider.Decline(true)
+		service := plan.NewPlanService(plans, subscriptions, mocks.NewMockUsageReader(ctrl), provider)
+		err := service.RenewSubscriptions(context.Background(), now)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should be read only when the usage goes over the plan limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		plans := mocks.NewMockRepository(ctrl)
+		plans.EXPECT().FindByType(gomock.Any(), "Free").Return(freePlan(), nil)
+		subscriptions := mocks.NewMockSubscriptionRepository(ctrl)
+		subscriptions.EXPECT().Current(gomock.Any()).Return(nil, nil)
+		usage := mocks.NewMockUsageReader(ctrl)
+		usage.EXPECT().Usage(gomock.Any(), plan.FeatureClient).Return(uint(10), nil)
+		usage.EXPECT().Usage(gomock.Any(), plan.FeatureDebt).Return(uint(35), nil)
+
+		service := plan.NewPlanService(plans, subscriptions, usage, plan.NewFakePaymentProvider())
+		readOnly, err := service.ReadOnly(context.Background())
+
+		assert.NoError(t, err)
+		assert.True(t, readOnly)
+	})
+
+	t.Run("should not subscribe to an unknown plan", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		planId := ulid.Make()
+		plans := mocks.NewMockRepository(ctrl)
+		plans.EXPECT().FindById(gomock.Any(), planId).Return(nil, nil)
+		subscriptions := mocks.NewMockSubscriptionRepository(ctrl)
+		subscriptions.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+		service := plan.NewPlanService(plans, subscriptions, mocks.NewMockUsageReader(ctrl), plan.NewFakePaymentProvider())
+		result := service.Subscribe(context.Background(), &plan.SubscribeDto{PlanId: planId.String()})
+
+		assert.Equal(t, "error", result.Status)
+		assert.Equal(t, "plan not found", result.Message)
+	})
+}
@@ -0,0 +1,146 @@
+package plan
+
+import (
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	TrialDays       = 14
+	GracePeriodDays = 7
+
+	// ChargeRetryInterval is how long a past due subscription waits between
+	// charge attempts.
+	ChargeRetryInterval = 24 * time.Hour
+)
+
+type SubscriptionStatus string
+
+const (
+	Trialing SubscriptionStatus = "trialing"
+	Active   SubscriptionStatus = "active"
+	PastDue  SubscriptionStatus = "past_due"
+	Expired  SubscriptionStatus = "expired"
+)
+
+// Subscription is the plan an account is on. An account without one is on
+// the Free plan.
+//
+// A paid plan starts with a trial, once per account, and is then charged at
+// the end of every monthly period. When a charge fails the subscription is
+// past due: it keeps the plan until the grace period ends, and then expires
+// back to Free. Meanwhile the charge is tried again once a day.
+type Subscription struct {
+	Id                 ulid.ULID
+	TenantId           ulid.ULID
+	PlanId             ulid.ULID
+	Status             SubscriptionStatus
+	StartedAt          *time.Time
+	TrialEndsAt        *time.Time
+	CurrentPeriodStart *time.Time
+	CurrentPeriodEnd   *time.Time
+	GraceEndsAt        *time.Time
+	LastChargeAt       *time.Time
+}
+
+// Charge is an attempt to collect one period of a paid plan.
+type Charge struct {
+	Id          ulid.ULID
+	PlanId      ulid.ULID
+	Amount      money.Money
+	PeriodStart *time.Time
+	PeriodEnd   *time.Time
+	Paid        bool
+	Reference   string
+	CreatedAt   *time.Time
+}
+
+func (s *Subscription) TrialUsed() bool {
+	return s.TrialEndsAt != nil
+}
+
+func (s *Subscription) StartTrial(plan *Plan, now time.Time) {
+	trialEnd := now.AddDate(0, 0, TrialDays)
+
+	s.PlanId = plan.Id
+	s.Status = Trialing
+	s.StartedAt = &now
+	s.TrialEndsAt = &trialEnd
+	s.CurrentPeriodStart = &now
+	s.CurrentPeriodEnd = &trialEnd
+	s.GraceEndsAt = nil
+}
+
+// NewCharge returns the charge of the next period of the plan, starting now.
+func (s *Subscription) NewCharge(plan *Plan, now time.Time) *Charge {
+	periodEnd := now.AddDate(0, 1, 0)
+
+	return &Charge{
+		Id:          ulid.Make(),
+		PlanId:      plan.Id,
+		Amount:      plan.Price,
+		PeriodStart: &now,
+		PeriodEnd:   &periodEnd,
+		CreatedAt:   &now,
+	}
+}
+
+// Activate starts the period paid by the charge.
+func (s *Subscription) Activate(charge *Charge) {
+	if s.PlanId != charge.PlanId || s.StartedAt == nil {
+		s.StartedAt = charge.PeriodStart
+	}
+
+	s.PlanId = charge.PlanId
+	s.Status = Active
+	s.CurrentPeriodStart = charge.PeriodStart
+	s.CurrentPeriodEnd = charge.PeriodEnd
+	s.GraceEndsAt = nil
+	s.LastChargeAt = nil
+}
+
+// MarkPastDue opens the grace period after the first failed charge. Later
+// failures do not extend it, they only hold off the next attempt.
+func (s *Subscription) MarkPastDue(now time.Time) {
+	s.LastChargeAt = &now
+
+	if s.Status == PastDue {
+		return
+	}
+
+	graceEnd := now.AddDate(0, 0, GracePeriodDays)
+	s.Status = PastDue
+	s.GraceEndsAt = &graceEnd
+}
+
+func (s *Subscription) GraceExpired(now time.Time) bool {
+	return s.Status == PastDue && s.GraceEndsAt != nil && !now.Before(*s.GraceEndsAt)
+}
+
+// Downgrade puts the account back on the Free plan. Nothing the account
+// created is removed: what goes over the Free limits is only read-only.
+func (s *Subscription) Downgrade(free *Plan, status SubscriptionStatus, now time.Time) {
+	s.PlanId = free.Id
+	s.Status = status
+	s.StartedAt = &now
+	s.CurrentPeriodStart = nil
+	s.CurrentPeriodEnd = nil
+	s.GraceEndsAt = nil
+	s.LastChargeAt = nil
+}
+
+// DueForBilling reports whether the trial or the paid period is over, or a
+// past due charge must be tried again: once the retry interval has passed
+// since the last attempt, or when the grace period ends.
+func (s *Subscription) DueForBilling(now time.Time) bool {
+	switch s.Status {
+	case Trialing, Active:
+		return s.CurrentPeriodEnd != nil && !now.Before(*s.CurrentPeriodEnd)
+	case PastDue:
+		return s.LastChargeAt == nil || !now.Before(s.LastChargeAt.Add(ChargeRetryInterval)) || s.GraceExpired(now)
+	default:
+		return false
+	}
+}
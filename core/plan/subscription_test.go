@@ -0,0 +1,84 @@
+package plan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func proPlan() *Plan {
+	return &Plan{Id: ulid.Make(), Type: Pro, Price: money.FromCents(2990)}
+}
+
+func TestShouldBeDueForBillingWhenTrialEnds(t *testing.T) {
+	now := time.Now()
+	subscription := Subscription{Id: ulid.Make()}
+	subscription.StartTrial(proPlan(), now)
+
+	assert.True(t, subscription.TrialUsed())
+	assert.False(t, subscription.DueForBilling(now))
+	assert.True(t, subscription.DueForBilling(now.AddDate(0, 0, TrialDays)))
+}
+
+func TestShouldActivatePeriodPaidByCharge(t *testing.T) {
+	now := time.Now()
+	pro := proPlan()
+	subscription := Subscription{Id: ulid.Make()}
+	subscription.StartTrial(pro, now.AddDate(0, 0, -TrialDays))
+	startedAt := subscription.StartedAt
+
+	charge := subscription.NewCharge(pro, now)
+	subscription.Activate(charge)
+
+	assert.Equal(t, Active, subscription.Status)
+	assert.Equal(t, startedAt, subscription.StartedAt)
+	assert.Equal(t, now.AddDate(0, 1, 0), *subscription.CurrentPeriodEnd)
+	assert.Equal(t, pro.Price, charge.Amount)
+}
+
+func TestShouldNotExtendGracePeriodOnLaterFailures(t *testing.T) {
+	now := time.Now()
+	subscription := Subscription{Id: ulid.Make()}
+	subscription.Activate(subscription.NewCharge(proPlan(), now.AddDate(0, -1, 0)))
+
+	subscription.MarkPastDue(now)
+	subscription.MarkPastDue(now.AddDate(0, 0, 3))
+
+	assert.Equal(t, PastDue, subscription.Status)
+	assert.Equal(t, now.AddDate(0, 0, GracePeriodDays), *subscription.GraceEndsAt)
+	assert.False(t, subscription.GraceExpired(now.AddDate(0, 0, GracePeriodDays-1)))
+	assert.True(t, subscription.GraceExpired(now.AddDate(0, 0, GracePeriodDays)))
+}
+
+func TestShouldRetryPastDueChargeOnceADay(t *testing.T) {
+	now := time.Now()
+	subscription := Subscription{Id: ulid.Make()}
+	subscription.Activate(subscription.NewCharge(proPlan(), now.AddDate(0, -1, 0)))
+
+	subscription.MarkPastDue(now)
+
+	assert.False(t, subscription.DueForBilling(now.Add(time.Hour)))
+	assert.True(t, subscription.DueForBilling(now.Add(ChargeRetryInterval)))
+
+	subscription.MarkPastDue(now.Add(ChargeRetryInterval))
+
+	assert.False(t, subscription.DueForBilling(now.Add(ChargeRetryInterval+time.Hour)))
+	assert.True(t, subscription.DueForBilling(now.AddDate(0, 0, GracePeriodDays)))
+}
+
+func TestShouldDowngradeKeepingTrialUsed(t *testing.T) {
+	now := time.Now()
+	free := &Plan{Id: ulid.Make(), Type: Free}
+	subscription := Subscription{Id: ulid.Make()}
+	subscription.StartTrial(proPlan(), now.AddDate(0, 0, -TrialDays))
+
+	subscription.Downgrade(free, Expired, now)
+
+	assert.Equal(t, free.Id, subscription.PlanId)
+	assert.Equal(t, Expired, subscription.Status)
+	assert.True(t, subscription.TrialUsed())
+	assert.False(t, subscription.DueForBilling(now.AddDate(1, 0, 0)))
+}
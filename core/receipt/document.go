@@ -0,0 +1,107 @@
+package receipt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/document"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/pdf"
+)
+
+// Layout of the printed receipt, in points.
+const (
+	pageMargin = 50.0
+	textWidth  = pdf.PageWidth - 2*pageMargin
+	bodySize   = 11.0
+	bodyLeader = 17.0
+	dateLayout = "02/01/2006"
+)
+
+// PDF writes the receipt on an A4 page.
+func (r *Receipt) PDF() []byte {
+	doc := pdf.New()
+	doc.SetTitle(fmt.Sprintf("Recibo %s", r.FormattedNumber()))
+	page := doc.AddPage()
+
+	right := pageMargin + textWidth
+	page.Text(pageMargin, 80, pdf.HelveticaBold, 22, "RECIBO")
+	page.TextRight(right, 70, pdf.HelveticaBold, 12, "Nº "+r.FormattedNumber())
+	page.Rect(right-150, 78, 150, 24, 1)
+	page.TextRight(right-8, 95, pdf.HelveticaBold, 13, r.Amount.Format())
+	page.Line(pageMargin, 115, right, 115, 1)
+
+	y := 137.0
+	page.Text(pageMargin, y, pdf.HelveticaBold, 11, r.Issuer.Name)
+	for _, line := range []string{partyDocument(r.Issuer), r.Issuer.Email} {
+		if line == "" {
+			continue
+		}
+
+		y += 13
+		page.Text(pageMargin, y, pdf.Helvetica, 9, line)
+	}
+
+	y = page.Paragraph(pageMargin, y+35, textWidth, pdf.Helvetica, bodySize, bodyLeader, r.statement())
+	y = page.Paragraph(pageMargin, y+bodyLeader, textWidth, pdf.Helvetica, bodySize, bodyLeader,
+		"Para maior clareza, firmo o presente recibo, que comprova o pagamento acima.")
+
+	page.TextRight(right, y+25, pdf.Helvetica, bodySize, "Emitido em "+r.IssuedAt.Format(dateLayout))
+
+	signatureY := y + 90
+	center := pageMargin + textWidth/2
+	page.Line(center-130, signatureY, center+130, signatureY, 0.5)
+	for i, line := range []string{r.Issuer.Name, partyDocument(r.Issuer)} {
+		if line == "" {
+			continue
+		}
+
+		size := 10.0
+		page.Text(center-pdf.TextWidth(pdf.Helvetica, size, line)/2, signatureY+14+float64(i)*13, pdf.Helvetica, size, line)
+	}
+
+	page.Text(pageMargin, signatureY+60, pdf.Helvetica, 7, "Recibo "+r.Id.String()+" - Pagamento "+r.PaymentId.String())
+
+	return doc.Bytes()
+}
+
+// FormattedNumber writes the number with at least six digits.
+func (r *Receipt) FormattedNumber() string {
+	return fmt.Sprintf("%06d", r.Number)
+}
+
+// statement is the text of the receipt, as in "Recebi de Maria Silva, CPF
+// 529.982.247-25, a importância de R$ 100,00 (cem reais), referente a...".
+func (r *Receipt) statement() string {
+	var text strings.Builder
+	text.WriteString("Recebi de " + r.Payer.Name)
+	if payerDocument := partyDocument(r.Payer); payerDocument != "" {
+		text.WriteString(", " + payerDocument)
+	}
+
+	fmt.Fprintf(&text, ", a importância de %s (%s), referente ao pagamento da parcela %d",
+		r.Amount.Format(), r.AmountInWords(), r.InstallmentNumber)
+	if r.Installments > 0 {
+		fmt.Fprintf(&text, " de %d", r.Installments)
+	}
+
+	if r.Description != "" {
+		fmt.Fprintf(&text, " de \"%s\"", r.Description)
+	}
+
+	fmt.Fprintf(&text, ", paga em %s", r.PaidAt.Format(dateLayout))
+	if method := r.MethodName(); method != "" {
+		text.WriteString(" por meio de " + method)
+	}
+	text.WriteString(".")
+
+	return text.String()
+}
+
+// partyDocument writes the document of the party preceded by its kind.
+func partyDocument(party Party) string {
+	if party.Document == "" {
+		return ""
+	}
+
+	return document.Document(party.Document).Labeled()
+}
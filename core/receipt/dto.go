@@ -0,0 +1,27 @@
+package receipt
+
+import "github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+
+type ReceiptDto struct {
+	Id                string      `json:"id"`
+	Number            int64       `json:"number"`
+	DebtId            string      `json:"debt_id"`
+	InstallmentId     string      `json:"installment_id"`
+	ClientId          string      `json:"client_id"`
+	ClientName        string      `json:"client_name"`
+	ClientDocument    string      `json:"client_document"`
+	Description       string      `json:"description"`
+	InstallmentNumber int         `json:"installment_number"`
+	Amount            money.Money `json:"amount"`
+	AmountInWords     string      `json:"amount_in_words"`
+	PaymentMethod     string      `json:"payment_method"`
+	PaidAt            string      `json:"paid_at"`
+	IssuedAt          string      `json:"issued_at"`
+	// PDF is the printable receipt, served on its own.
+	PDF []byte `json:"-"`
+}
+
+type PaginationResult struct {
+	TotalRecords int        `json:"total_records"`
+	Data         []*Receipt `json:"data"`
+}
@@ -0,0 +1,43 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type Receipt struct {
+	ID                  string      `gorm:"column:id;primaryKey;type:char(26)"`
+	Number              int64       `gorm:"column:number;type:bigint;not null"`
+	PaymentID           string      `gorm:"column:payment_id;type:char(26);not null"`
+	DebtID              string      `gorm:"column:debt_id;type:char(26);not null"`
+	InstallmentID       string      `gorm:"column:installment_id;type:char(26);not null"`
+	ClientID            string      `gorm:"column:client_id;type:char(26);not null"`
+	IssuerName          string      `gorm:"column:issuer_name;type:text;not null"`
+	IssuerDocument      string      `gorm:"column:issuer_document;type:varchar(14)"`
+	IssuerEmail         string      `gorm:"column:issuer_email;type:varchar(255)"`
+	PayerName           string      `gorm:"column:payer_name;type:text;not null"`
+	PayerDocument       string      `gorm:"column:payer_document;type:varchar(20)"`
+	PayerEmail          string      `gorm:"column:payer_email;type:varchar(255)"`
+	Description         string      `gorm:"column:description;type:text"`
+	InstallmentNumber   int         `gorm:"column:installment_number;type:int;not null"`
+	InstallmentQuantity int         `gorm:"column:installments_quantity;type:int;not null"`
+	Amount              money.Money `gorm:"column:amount;type:decimal(12,2);not null"`
+	PaymentMethod       string      `gorm:"column:payment_method;type:text;not null"`
+	PaidAt              time.Time   `gorm:"column:paid_at;type:timestamp;not null"`
+	IssuedAt            time.Time   `gorm:"column:issued_at;type:timestamp;not null"`
+	TenantID            string      `gorm:"column:tenant_id;type:char(26);not null"`
+}
+
+func (d *Receipt) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == "" {
+		d.ID = ulid.Make().String()
+	}
+	return nil
+}
+
+func (d *Receipt) TableName() string {
+	return "receipts"
+}
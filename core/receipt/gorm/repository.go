@@ -0,0 +1,173 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/receipt"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	ormdb "github.com/henriquerocha2004/quem-me-deve-api/core/shared/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+// searchableColumns are the columns the receipts can be searched by. The
+// column name comes from the request, so no other is written in the query.
+var searchableColumns = map[string]bool{
+	"client_id":      true,
+	"debt_id":        true,
+	"installment_id": true,
+	"payment_method": true,
+}
+
+type GormReceiptRepository struct {
+	db *gorm.DB
+}
+
+func NewGormReceiptRepository(db *gorm.DB) *GormReceiptRepository {
+	return &GormReceiptRepository{db: db}
+}
+
+func (g *GormReceiptRepository) Create(ctx context.Context, r *receipt.Receipt) error {
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return CreateReceipt(tx, r)
+	})
+}
+
+// CreateReceipt takes the next number of the account under a transaction
+// lock of the tenant, so that the numbers have no gaps nor repeats, and
+// stores the receipt. It runs in the transaction given, so that the receipt
+// is stored along with the payment it stands for.
+func CreateReceipt(tx *gorm.DB, r *receipt.Receipt) error {
+	tenantId, ok := shared.TenantFromContext(tx.Statement.Context)
+	if !ok {
+		return ormdb.ErrTenantRequired
+	}
+
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "receipts:"+tenantId.String()).Error; err != nil {
+		return err
+	}
+
+	var last int64
+	if err := tx.Model(&Receipt{}).Select("COALESCE(MAX(number), 0)").Scan(&last).Error; err != nil {
+		return err
+	}
+
+	model := convertReceiptToModel(r)
+	model.Number = last + 1
+	if err := tx.Create(&model).Error; err != nil {
+		return err
+	}
+
+	r.Number = model.Number
+	return nil
+}
+
+func (g *GormReceiptRepository) FindById(ctx context.Context, id ulid.ULID) (*receipt.Receipt, error) {
+	var model Receipt
+
+	result := g.db.WithContext(ctx).Where("id = ?", id.String()).Limit(1).Find(&model)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return g.convertModelToReceipt(model), nil
+}
+
+func (g *GormReceiptRepository) Receipts(ctx context.Context, criteria paginate.SearchDto) (*receipt.PaginationResult, error) {
+	var models []Receipt
+	var total int64
+
+	query := g.db.WithContext(ctx).Model(&Receipt{})
+
+	if criteria.TermSearch != "" {
+		query = query.Where("payer_name ILIKE ? OR payer_document LIKE ? OR description ILIKE ?",
+			"%"+criteria.TermSearch+"%", "%"+criteria.TermSearch+"%", "%"+criteria.TermSearch+"%")
+	}
+
+	for _, value := range criteria.ColumnSearch {
+		if !searchableColumns[value.ColumnName] {
+			return nil, errors.New("receipts can not be searched by " + value.ColumnName)
+		}
+
+		query = query.Where(value.ColumnName+" = ?", value.ColumnValue)
+	}
+
+	err := query.
+		Count(&total).
+		Offset(criteria.Offset()).
+		Limit(criteria.Limit).
+		Order("number DESC").
+		Find(&models).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	var receipts []*receipt.Receipt
+	for _, model := range models {
+		receipts = append(receipts, g.convertModelToReceipt(model))
+	}
+
+	return &receipt.PaginationResult{
+		TotalRecords: int(total),
+		Data:         receipts,
+	}, nil
+}
+
+func convertReceiptToModel(r *receipt.Receipt) Receipt {
+	return Receipt{
+		ID:                  r.Id.String(),
+		Number:              r.Number,
+		PaymentID:           r.PaymentId.String(),
+		DebtID:              r.DebtId.String(),
+		InstallmentID:       r.InstallmentId.String(),
+		ClientID:            r.ClientId.String(),
+		IssuerName:          r.Issuer.Name,
+		IssuerDocument:      r.Issuer.Document,
+		IssuerEmail:         r.Issuer.Email,
+		PayerName:           r.Payer.Name,
+		PayerDocument:       r.Payer.Document,
+		PayerEmail:          r.Payer.Email,
+		Description:         r.Description,
+		InstallmentNumber:   r.InstallmentNumber,
+		InstallmentQuantity: r.Installments,
+		Amount:              r.Amount,
+		PaymentMethod:       r.Method,
+		PaidAt:              r.PaidAt,
+		IssuedAt:            r.IssuedAt,
+	}
+}
+
+func (g *GormReceiptRepository) convertModelToReceipt(model Receipt) *receipt.Receipt {
+	return &receipt.Receipt{
+		Id:            ulid.MustParse(model.ID),
+		Number:        model.Number,
+		PaymentId:     ulid.MustParse(model.PaymentID),
+		DebtId:        ulid.MustParse(model.DebtID),
+		InstallmentId: ulid.MustParse(model.InstallmentID),
+		ClientId:      ulid.MustParse(model.ClientID),
+		Issuer: receipt.Party{
+			Name:     model.IssuerName,
+			Document: model.IssuerDocument,
+			Email:    model.IssuerEmail,
+		},
+		Payer: receipt.Party{
+			Name:     model.PayerName,
+			Document: model.PayerDocument,
+			Email:    model.PayerEmail,
+		},
+		Description:       model.Description,
+		InstallmentNumber: model.InstallmentNumber,
+		Installments:      model.InstallmentQuantity,
+		Amount:            model.Amount,
+		Method:            model.PaymentMethod,
+		PaidAt:            model.PaidAt,
+		IssuedAt:          model.IssuedAt,
+	}
+}
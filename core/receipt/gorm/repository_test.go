@@ -0,0 +1,175 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	setupdbtests "github.com/henriquerocha2004/quem-me-deve-api/config/setupDbTests"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/receipt"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	ormdb "github.com/henriquerocha2004/quem-me-deve-api/core/shared/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/helpers"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/joho/godotenv"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/suite"
+	orm "gorm.io/gorm"
+)
+
+var gormDB *orm.DB = nil
+
+// userId is also the tenant of the tests, as every user is an account of
+// its own.
+var userId = ulid.Make()
+
+var tenantCtx = shared.WithTenant(context.Background(), userId)
+
+func TestMain(m *testing.M) {
+	envPath := helpers.ProjetctRoot() + ".env.testing"
+	err := godotenv.Overload(envPath)
+	if err != nil {
+		log.Println(err)
+		panic("Error loading .env file")
+	}
+
+	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
+		os.Getenv("DB_USER"),
+		os.Getenv("DB_PASSWORD"),
+		os.Getenv("DB_HOST"),
+		os.Getenv("DB_PORT"),
+		os.Getenv("DB_NAME"),
+	)
+
+	gormDB, err = ormdb.NewGorm(dsn)
+	if err != nil {
+		log.Println(err)
+		panic(err)
+	}
+	sql, err := gormDB.DB()
+	if err != nil {
+		log.Println(err)
+		panic(err)
+	}
+
+	sql.SetMaxIdleConns(10)
+	sql.SetMaxOpenConns(100)
+	sql.SetConnMaxLifetime(30 * time.Minute)
+
+	defer sql.Close()
+	m.Run()
+}
+
+type ReceiptRepositorySuiteTest struct {
+	suite.Suite
+}
+
+func (s *ReceiptRepositorySuiteTest) TearDownTest() {
+	err := setupdbtests.TruncateTables(gormDB)
+	if err != nil {
+		s.Fail("Failed to truncate tables: %v", err)
+	}
+}
+
+func TestReceiptRepositorySuite(t *testing.T) {
+	suite.Run(t, new(ReceiptRepositorySuiteTest))
+}
+
+func (s *ReceiptRepositorySuiteTest) newReceipt(payerName string) *receipt.Receipt {
+	return receipt.New(receipt.Payment{
+		Id:                ulid.Make(),
+		DebtId:            ulid.Make(),
+		InstallmentId:     ulid.Make(),
+		ClientId:          ulid.Make(),
+		Description:       "Corte e barba",
+		InstallmentNumber: 1,
+		Installments:      2,
+		Amount:            money.FromCents(12550),
+		Method:            "pix",
+		PaidAt:            time.Now(),
+	}, receipt.Party{Name: "Barbearia do Zé", Document: "11222333000181"},
+		receipt.Party{Name: payerName, Document: "52998224725"}, time.Now())
+}
+
+func (s *ReceiptRepositorySuiteTest) TestShouldNumberReceiptsSequentiallyPerAccount() {
+	repo := NewGormReceiptRepository(gormDB)
+	otherCtx := shared.WithTenant(context.Background(), ulid.Make())
+
+	first := s.newReceipt("João Silva")
+	second := s.newReceipt("Maria Souza")
+	other := s.newReceipt("José Santos")
+
+	s.NoError(repo.Create(tenantCtx, first))
+	s.NoError(repo.Create(tenantCtx, second))
+	s.NoError(repo.Create(otherCtx, other))
+
+	s.Equal(int64(1), first.Number)
+	s.Equal(int64(2), second.Number)
+	s.Equal(int64(1), other.Number)
+}
+
+func (s *ReceiptRepositorySuiteTest) TestShouldRefuseReceiptWithoutTenant() {
+	repo := NewGormReceiptRepository(gormDB)
+
+	err := repo.Create(context.Background(), s.newReceipt("João Silva"))
+	s.ErrorIs(err, ormdb.ErrTenantRequired)
+}
+
+func (s *ReceiptRepositorySuiteTest) TestShouldFindReceiptOfTheAccount() {
+	repo := NewGormReceiptRepository(gormDB)
+	r := s.newReceipt("João Silva")
+	s.NoError(repo.Create(tenantCtx, r))
+
+	found, err := repo.FindById(tenantCtx, r.Id)
+	s.NoError(err)
+	s.Require().NotNil(found)
+	s.Equal(r.Number, found.Number)
+	s.Equal("João Silva", found.Payer.Name)
+	s.Equal("11222333000181", found.Issuer.Document)
+	s.Equal(money.FromCents(12550), found.Amount)
+
+	otherCtx := shared.WithTenant(context.Background(), ulid.Make())
+	found, err = repo.FindById(otherCtx, r.Id)
+	s.NoError(err)
+	s.Nil(found)
+}
+
+func (s *ReceiptRepositorySuiteTest) TestShouldListReceiptsFromTheLatest() {
+	repo := NewGormReceiptRepository(gormDB)
+	s.NoError(repo.Create(tenantCtx, s.newReceipt("João Silva")))
+	s.NoError(repo.Create(tenantCtx, s.newReceipt("Maria Souza")))
+	s.NoError(repo.Create(tenantCtx, s.newReceipt("Mariana Lima")))
+
+	criteria := paginate.SearchDto{Limit: 10, TermSearch: "mari"}
+	criteria.SetPage(1)
+	result, err := repo.Receipts(tenantCtx, criteria)
+	s.NoError(err)
+	s.Equal(2, result.TotalRecords)
+	s.Equal(int64(3), result.Data[0].Number)
+	s.Equal(int64(2), result.Data[1].Number)
+}
+
+func (s *ReceiptRepositorySuiteTest) TestShouldSearchReceiptsOnlyByKnownColumns() {
+	repo := NewGormReceiptRepository(gormDB)
+	r := s.newReceipt("João Silva")
+	s.NoError(repo.Create(tenantCtx, r))
+	s.NoError(repo.Create(tenantCtx, s.newReceipt("Maria Souza")))
+
+	criteria := paginate.SearchDto{Limit: 10}
+	criteria.SetPage(1)
+	criteria.AddColumnSearch([]map[string]string{{"name": "client_id", "value": r.ClientId.String()}})
+	result, err := repo.Receipts(tenantCtx, criteria)
+	s.NoError(err)
+	s.Equal(1, result.TotalRecords)
+	s.Equal(r.Id, result.Data[0].Id)
+
+	criteria = paginate.SearchDto{Limit: 10}
+	criteria.SetPage(1)
+	criteria.AddColumnSearch([]map[string]string{{"name": "1 = 1 OR tenant_id", "value": "x"}})
+	_, err = repo.Receipts(tenantCtx, criteria)
+	s.EqualError(err, "receipts can not be searched by 1 = 1 OR tenant_id")
+}
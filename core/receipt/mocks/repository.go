@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: receipt/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=receipt/repository.go -destination=receipt/mocks/repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	client "github.com/henriquerocha2004/quem-me-deve-api/core/client"
+	receipt "github.com/henriquerocha2004/quem-me-deve-api/core/receipt"
+	user "github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	paginate "github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	ulid "github.com/oklog/ulid/v2"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(ctx context.Context, arg1 *receipt.Receipt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, arg1)
+}
+
+// FindById mocks base method.
+func (m *MockRepository) FindById(ctx context.Context, id ulid.ULID) (*receipt.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*receipt.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockRepository)(nil).FindById), ctx, id)
+}
+
+// Receipts mocks base method.
+func (m *MockRepository) Receipts(ctx context.Context, criteria paginate.SearchDto) (*receipt.PaginationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receipts", ctx, criteria)
+	ret0, _ := ret[0].(*receipt.PaginationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Receipts indicates an expected call of Receipts.
+func (mr *MockRepositoryMockRecorder) Receipts(ctx, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receipts", reflect.TypeOf((*MockRepository)(nil).Receipts), ctx, criteria)
+}
+
+// MockAccountReader is a mock of AccountReader interface.
+type MockAccountReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockAccountReaderMockRecorder is the mock recorder for MockAccountReader.
+type MockAccountReaderMockRecorder struct {
+	mock *MockAccountReader
+}
+
+// NewMockAccountReader creates a new mock instance.
+func NewMockAccountReader(ctrl *gomock.Controller) *MockAccountReader {
+	mock := &MockAccountReader{ctrl: ctrl}
+	mock.recorder = &MockAccountReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountReader) EXPECT() *MockAccountReaderMockRecorder {
+	return m.recorder
+}
+
+// FindById mocks base method.
+func (m *MockAccountReader) FindById(ctx context.Context, id ulid.ULID) (*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockAccountReaderMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockAccountReader)(nil).FindById), ctx, id)
+}
+
+// MockClientFinder is a mock of ClientFinder interface.
+type MockClientFinder struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientFinderMockRecorder
+	isgomock struct{}
+}
+
+// MockClientFinderMockRecorder is the mock recorder for MockClientFinder.
+type MockClientFinderMockRecorder struct {
+	mock *MockClientFinder
+}
+
+// NewMockClientFinder creates a new mock instance.
+func NewMockClientFinder(ctrl *gomock.Controller) *MockClientFinder {
+	mock := &MockClientFinder{ctrl: ctrl}
+	mock.recorder = &MockClientFinderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientFinder) EXPECT() *MockClientFinderMockRecorder {
+	return m.recorder
+}
+
+// FindById mocks base method.
+func (m *MockClientFinder) FindById(ctx context.Context, id ulid.ULID) (*client.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*client.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockClientFinderMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockClientFinder)(nil).FindById), ctx, id)
+}
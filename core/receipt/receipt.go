@@ -0,0 +1,99 @@
+package receipt
+
+import (
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/extenso"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+)
+
+// Receipt is the numbered proof of a payment received for an installment.
+// It keeps the data printed on it, so that it is downloaded again the same
+// way whatever happens later to the debt, the client or the account.
+type Receipt struct {
+	Id                ulid.ULID
+	Number            int64
+	PaymentId         ulid.ULID
+	DebtId            ulid.ULID
+	InstallmentId     ulid.ULID
+	ClientId          ulid.ULID
+	Issuer            Party
+	Payer             Party
+	Description       string
+	InstallmentNumber int
+	Installments      int
+	Amount            money.Money
+	Method            string
+	PaidAt            time.Time
+	IssuedAt          time.Time
+}
+
+// Party is the professional that issues the receipt or the client that
+// paid, as printed on it.
+type Party struct {
+	Name     string
+	Document string
+	Email    string
+}
+
+// Payment is a payment received for an installment of a debt.
+type Payment struct {
+	Id                ulid.ULID
+	DebtId            ulid.ULID
+	InstallmentId     ulid.ULID
+	ClientId          ulid.ULID
+	Description       string
+	InstallmentNumber int
+	Installments      int
+	Amount            money.Money
+	Method            string
+	PaidAt            time.Time
+}
+
+// New makes the receipt of the payment, numbered when it is stored.
+func New(payment Payment, issuer, payer Party, now time.Time) *Receipt {
+	return &Receipt{
+		Id:                ulid.Make(),
+		PaymentId:         payment.Id,
+		DebtId:            payment.DebtId,
+		InstallmentId:     payment.InstallmentId,
+		ClientId:          payment.ClientId,
+		Issuer:            issuer,
+		Payer:             payer,
+		Description:       payment.Description,
+		InstallmentNumber: payment.InstallmentNumber,
+		Installments:      payment.Installments,
+		Amount:            payment.Amount,
+		Method:            payment.Method,
+		PaidAt:            payment.PaidAt,
+		IssuedAt:          now,
+	}
+}
+
+// AmountInWords writes the amount in Portuguese words.
+func (r *Receipt) AmountInWords() string {
+	return extenso.Reais(r.Amount)
+}
+
+var methodNames = map[string]string{
+	"pix":         "Pix",
+	"credit":      "crédito do cliente",
+	"cash":        "dinheiro",
+	"money":       "dinheiro",
+	"dinheiro":    "dinheiro",
+	"boleto":      "boleto bancário",
+	"credit_card": "cartão de crédito",
+	"debit_card":  "cartão de débito",
+	"transfer":    "transferência bancária",
+}
+
+// MethodName writes the payment method the way it is printed. Methods typed
+// freely are printed as they were typed.
+func (r *Receipt) MethodName() string {
+	if name, ok := methodNames[r.Method]; ok {
+		return name
+	}
+
+	return r.Method
+}
@@ -0,0 +1,28 @@
+package receipt
+
+import (
+	"context"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/client"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+)
+
+type Repository interface {
+	// Create gives the receipt the next number of the account and stores it.
+	Create(ctx context.Context, receipt *Receipt) error
+	FindById(ctx context.Context, id ulid.ULID) (*Receipt, error)
+	Receipts(ctx context.Context, criteria paginate.SearchDto) (*PaginationResult, error)
+}
+
+// AccountReader finds the professional that owns the account, who issues
+// the receipts.
+type AccountReader interface {
+	FindById(ctx context.Context, id ulid.ULID) (*user.User, error)
+}
+
+// ClientFinder finds the client that paid.
+type ClientFinder interface {
+	FindById(ctx context.Context, id ulid.ULID) (*client.Client, error)
+}
@@ -0,0 +1,176 @@
+package receipt
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+)
+
+type Service interface {
+	Receipts(ctx context.Context, criteria *paginate.PaginateRequest) shared.ServiceResponse
+	Receipt(ctx context.Context, id ulid.ULID) shared.ServiceResponse
+}
+
+type ReceiptService struct {
+	receipts Repository
+	accounts AccountReader
+	clients  ClientFinder
+}
+
+func NewReceiptService(receipts Repository, accounts AccountReader, clients ClientFinder) *ReceiptService {
+	return &ReceiptService{
+		receipts: receipts,
+		accounts: accounts,
+		clients:  clients,
+	}
+}
+
+var (
+	errNoTenant        = errors.New("receipt issued without a tenant")
+	errAccountNotFound = errors.New("account of the receipt not found")
+	errClientNotFound  = errors.New("client of the receipt not found")
+)
+
+// Issue numbers and stores the receipt of a payment received in the account
+// in context.
+func (s *ReceiptService) Issue(ctx context.Context, payment Payment) (*Receipt, error) {
+	receipt, err := s.Prepare(ctx, payment)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.receipts.Create(ctx, receipt); err != nil {
+		return nil, err
+	}
+
+	return receipt, nil
+}
+
+// Prepare makes the receipt of a payment received in the account in context,
+// not yet numbered nor stored, for it to be stored along with the payment.
+// Every user is an account of its own, so the professional that issues it is
+// the tenant.
+func (s *ReceiptService) Prepare(ctx context.Context, payment Payment) (*Receipt, error) {
+	tenantId, ok := shared.TenantFromContext(ctx)
+	if !ok {
+		return nil, errNoTenant
+	}
+
+	account, err := s.accounts.FindById(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return nil, errAccountNotFound
+	}
+
+	payer, err := s.clients.FindById(ctx, payment.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	if payer == nil {
+		return nil, errClientNotFound
+	}
+
+	issuer := Party{Name: account.Name, Email: account.Email}
+	if account.Boleto != nil {
+		issuer.Name = account.Boleto.BeneficiaryName
+		issuer.Document = account.Boleto.BeneficiaryDocument
+	}
+
+	return New(payment, issuer, Party{
+		Name:     strings.TrimSpace(payer.Name + " " + payer.LastName),
+		Document: string(payer.Document),
+		Email:    payer.Email,
+	}, time.Now()), nil
+}
+
+func (s *ReceiptService) Receipts(ctx context.Context, criteria *paginate.PaginateRequest) shared.ServiceResponse {
+	pagDto := paginate.SearchDto{
+		Limit:         criteria.Limit,
+		SortField:     criteria.SortField,
+		TermSearch:    criteria.SearchTerm,
+		SortDirection: criteria.SortDirection,
+	}
+
+	pagDto.SetPage(criteria.Page)
+	pagDto.AddColumnSearch(criteria.ColumnSearch)
+
+	result, err := s.receipts.Receipts(ctx, pagDto)
+	if err != nil {
+		log.Println("Error getting receipts:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in get receipts",
+		}
+	}
+
+	receiptsDto := []ReceiptDto{}
+	for _, receipt := range result.Data {
+		receiptsDto = append(receiptsDto, s.convertToReceiptDto(receipt))
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "receipts retrieved successfully",
+		Data: paginate.Result{
+			TotalRecords: result.TotalRecords,
+			Data:         receiptsDto,
+		},
+	}
+}
+
+// Receipt finds a receipt issued before, along with its printable PDF.
+func (s *ReceiptService) Receipt(ctx context.Context, id ulid.ULID) shared.ServiceResponse {
+	receipt, err := s.receipts.FindById(ctx, id)
+	if err != nil {
+		log.Println("Error finding receipt:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in find receipt",
+		}
+	}
+
+	if receipt == nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "receipt not found",
+		}
+	}
+
+	receiptDto := s.convertToReceiptDto(receipt)
+	receiptDto.PDF = receipt.PDF()
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "receipt found",
+		Data:    receiptDto,
+	}
+}
+
+func (s *ReceiptService) convertToReceiptDto(receipt *Receipt) ReceiptDto {
+	return ReceiptDto{
+		Id:                receipt.Id.String(),
+		Number:            receipt.Number,
+		DebtId:            receipt.DebtId.String(),
+		InstallmentId:     receipt.InstallmentId.String(),
+		ClientId:          receipt.ClientId.String(),
+		ClientName:        receipt.Payer.Name,
+		ClientDocument:    receipt.Payer.Document,
+		Description:       receipt.Description,
+		InstallmentNumber: receipt.InstallmentNumber,
+		Amount:            receipt.Amount,
+		AmountInWords:     receipt.AmountInWords(),
+		PaymentMethod:     receipt.Method,
+		PaidAt:            receipt.PaidAt.Format(time.DateTime),
+		IssuedAt:          receipt.IssuedAt.Format(time.DateTime),
+	}
+}
@@ -0,0 +1,236 @@
+package receipt_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/client"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/receipt"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/receipt/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/boleto"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func newPayment(clientId ulid.ULID) receipt.Payment {
+	return receipt.Payment{
+		Id:                ulid.Make(),
+		DebtId:            ulid.Make(),
+		InstallmentId:     ulid.Make(),
+		ClientId:          clientId,
+		Description:       "Corte e barba",
+		InstallmentNumber: 2,
+		Installments:      3,
+		Amount:            money.FromCents(12550),
+		Method:            "pix",
+		PaidAt:            time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestReceiptService(t *testing.T) {
+	userId := ulid.Make()
+	tenantCtx := shared.WithTenant(context.Background(), userId)
+
+	t.Run("Deve emitir o recibo do pagamento com os dados do profissional e do cliente", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		receipts := mocks.NewMockRepository(ctrl)
+		accounts := mocks.NewMockAccountReader(ctrl)
+		clients := mocks.NewMockClientFinder(ctrl)
+		service := receipt.NewReceiptService(receipts, accounts, clients)
+
+		clientId := ulid.Make()
+		accounts.EXPECT().FindById(gomock.Any(), userId).Return(&user.User{
+			Id:    userId,
+			Name:  "José",
+			Email: "jose@barbearia.com",
+			Boleto: &user.BoletoAccount{
+				Account:             boleto.Account{Bank: boleto.BancoDoBrasilCode},
+				BeneficiaryName:     "Barbearia do Zé",
+				BeneficiaryDocument: "11222333000181",
+			},
+		}, nil)
+		clients.EXPECT().FindById(gomock.Any(), clientId).Return(&client.Client{
+			Id:       clientId,
+			Name:     "João",
+			LastName: "Silva",
+			Document: "52998224725",
+			Email:    "joao@email.com",
+		}, nil)
+		receipts.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r *receipt.Receipt) error {
+			r.Number = 7
+			return nil
+		})
+
+		issued, err := service.Issue(tenantCtx, newPayment(clientId))
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), issued.Number)
+		assert.Equal(t, "Barbearia do Zé", issued.Issuer.Name)
+		assert.Equal(t, "11222333000181", issued.Issuer.Document)
+		assert.Equal(t, "João Silva", issued.Payer.Name)
+		assert.Equal(t, "52998224725", issued.Payer.Document)
+		assert.Equal(t, money.FromCents(12550), issued.Amount)
+	})
+
+	t.Run("Deve emitir o recibo em nome do usuário quando não houver beneficiário", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		receipts := mocks.NewMockRepository(ctrl)
+		accounts := mocks.NewMockAccountReader(ctrl)
+		clients := mocks.NewMockClientFinder(ctrl)
+		service := receipt.NewReceiptService(receipts, accounts, clients)
+
+		clientId := ulid.Make()
+		accounts.EXPECT().FindById(gomock.Any(), userId).Return(&user.User{Id: userId, Name: "José", Email: "jose@barbearia.com"}, nil)
+		clients.EXPECT().FindById(gomock.Any(), clientId).Return(&client.Client{Id: clientId, Name: "João"}, nil)
+		receipts.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		issued, err := service.Issue(tenantCtx, newPayment(clientId))
+
+		assert.NoError(t, err)
+		assert.Equal(t, receipt.Party{Name: "José", Email: "jose@barbearia.com"}, issued.Issuer)
+		assert.Equal(t, "João", issued.Payer.Name)
+	})
+
+	t.Run("Deve preparar o recibo sem numerar nem gravar", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		receipts := mocks.NewMockRepository(ctrl)
+		accounts := mocks.NewMockAccountReader(ctrl)
+		clients := mocks.NewMockClientFinder(ctrl)
+		service := receipt.NewReceiptService(receipts, accounts, clients)
+
+		clientId := ulid.Make()
+		payment := newPayment(clientId)
+		accounts.EXPECT().FindById(gomock.Any(), userId).Return(&user.User{Id: userId, Name: "José"}, nil)
+		clients.EXPECT().FindById(gomock.Any(), clientId).Return(&client.Client{Id: clientId, Name: "João"}, nil)
+		receipts.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		prepared, err := service.Prepare(tenantCtx, payment)
+
+		assert.NoError(t, err)
+		assert.Zero(t, prepared.Number)
+		assert.Equal(t, payment.Id, prepared.PaymentId)
+		assert.Equal(t, "João", prepared.Payer.Name)
+	})
+
+	t.Run("Deve recusar a emissão de recibo sem tenant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		receipts := mocks.NewMockRepository(ctrl)
+		receipts.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+		service := receipt.NewReceiptService(receipts, mocks.NewMockAccountReader(ctrl), mocks.NewMockClientFinder(ctrl))
+
+		issued, err := service.Issue(context.Background(), newPayment(ulid.Make()))
+
+		assert.Error(t, err)
+		assert.Nil(t, issued)
+	})
+
+	t.Run("Deve retornar erro quando o cliente não for encontrado", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		receipts := mocks.NewMockRepository(ctrl)
+		accounts := mocks.NewMockAccountReader(ctrl)
+		clients := mocks.NewMockClientFinder(ctrl)
+		service := receipt.NewReceiptService(receipts, accounts, clients)
+
+		accounts.EXPECT().FindById(gomock.Any(), userId).Return(&user.User{Id: userId, Name: "José"}, nil)
+		clients.EXPECT().FindById(gomock.Any(), gomock.Any()).Return(nil, nil)
+		receipts.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		_, err := service.Issue(tenantCtx, newPayment(ulid.Make()))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Deve retornar o recibo com o PDF para download", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		receipts := mocks.NewMockRepository(ctrl)
+		service := receipt.NewReceiptService(receipts, mocks.NewMockAccountReader(ctrl), mocks.NewMockClientFinder(ctrl))
+
+		r := receipt.New(newPayment(ulid.Make()),
+			receipt.Party{Name: "Barbearia do Ze", Document: "11222333000181"},
+			receipt.Party{Name: "Joao Silva", Document: "52998224725"},
+			time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC))
+		r.Number = 7
+		receipts.EXPECT().FindById(gomock.Any(), r.Id).Return(r, nil)
+
+		response := service.Receipt(tenantCtx, r.Id)
+
+		assert.Equal(t, "success", response.Status)
+		dto := response.Data.(receipt.ReceiptDto)
+		assert.Equal(t, int64(7), dto.Number)
+		assert.Equal(t, "cento e vinte e cinco reais e cinquenta centavos", dto.AmountInWords)
+		assert.True(t, bytes.HasPrefix(dto.PDF, []byte("%PDF-")))
+		assert.True(t, bytes.Contains(dto.PDF, []byte("000007")))
+		assert.True(t, bytes.Contains(dto.PDF, []byte("CPF 529.982.247-25")))
+		assert.True(t, bytes.Contains(dto.PDF, []byte("CNPJ 11.222.333/0001-81")))
+		assert.True(t, bytes.Contains(dto.PDF, []byte("cento e vinte e cinco reais")))
+	})
+
+	t.Run("Deve retornar erro quando o recibo não for encontrado", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		receipts := mocks.NewMockRepository(ctrl)
+		receipts.EXPECT().FindById(gomock.Any(), gomock.Any()).Return(nil, nil)
+		service := receipt.NewReceiptService(receipts, mocks.NewMockAccountReader(ctrl), mocks.NewMockClientFinder(ctrl))
+
+		response := service.Receipt(tenantCtx, ulid.Make())
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "receipt not found", response.Message)
+	})
+
+	t.Run("Deve retornar erro quando falhar ao buscar o recibo", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		receipts := mocks.NewMockRepository(ctrl)
+		receipts.EXPECT().FindById(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+		service := receipt.NewReceiptService(receipts, mocks.NewMockAccountReader(ctrl), mocks.NewMockClientFinder(ctrl))
+
+		response := service.Receipt(tenantCtx, ulid.Make())
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "error in find receipt", response.Message)
+	})
+
+	t.Run("Deve listar os recibos paginados", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		receipts := mocks.NewMockRepository(ctrl)
+		service := receipt.NewReceiptService(receipts, mocks.NewMockAccountReader(ctrl), mocks.NewMockClientFinder(ctrl))
+
+		r := receipt.New(newPayment(ulid.Make()), receipt.Party{Name: "José"}, receipt.Party{Name: "João"}, time.Now())
+		receipts.EXPECT().Receipts(gomock.Any(), gomock.Any()).Return(&receipt.PaginationResult{
+			TotalRecords: 1,
+			Data:         []*receipt.Receipt{r},
+		}, nil)
+
+		response := service.Receipts(tenantCtx, &paginate.PaginateRequest{Page: 1, Limit: 10})
+
+		assert.Equal(t, "success", response.Status)
+		result := response.Data.(paginate.Result)
+		assert.Equal(t, 1, result.TotalRecords)
+		assert.Len(t, result.Data, 1)
+	})
+}
@@ -0,0 +1,37 @@
+package reconciliation
+
+import "github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+
+type PixPaymentDto struct {
+	Id            string      `json:"id"`
+	EndToEndId    string      `json:"end_to_end_id"`
+	TxId          string      `json:"txid"`
+	Amount        money.Money `json:"amount"`
+	PaidAt        string      `json:"paid_at"`
+	ReceivedAt    string      `json:"received_at"`
+	Status        string      `json:"status"`
+	Reason        string      `json:"reason,omitempty"`
+	DebtId        string      `json:"debt_id,omitempty"`
+	InstallmentId string      `json:"installment_id,omitempty"`
+	ResolvedAt    string      `json:"resolved_at,omitempty"`
+	Note          string      `json:"note,omitempty"`
+}
+
+type ReceiptDto struct {
+	Applied    int `json:"applied"`
+	Review     int `json:"review"`
+	Duplicated int `json:"duplicated"`
+}
+
+type ResolveDto struct {
+	// InstallmentId, when informed, is the installment the payment is
+	// registered on.
+	InstallmentId string `json:"installment_id" validate:"omitempty,ulid"`
+	CreditSurplus bool   `json:"credit_surplus"`
+	Note          string `json:"note" validate:"required"`
+}
+
+type PaginationResult struct {
+	TotalRecords int           `json:"total_records"`
+	Data         []*PixPayment `json:"data"`
+}
@@ -0,0 +1,37 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type PixPayment struct {
+	ID            string      `gorm:"column:id;primaryKey;type:char(26)"`
+	EndToEndID    string      `gorm:"column:end_to_end_id;type:varchar(35);not null"`
+	TxID          string      `gorm:"column:txid;type:varchar(35);not null"`
+	Amount        money.Money `gorm:"column:amount;type:decimal(12,2);not null"`
+	PaidAt        time.Time   `gorm:"column:paid_at;type:timestamp;not null"`
+	ReceivedAt    time.Time   `gorm:"column:received_at;type:timestamp;not null"`
+	Status        string      `gorm:"column:status;type:varchar(20);not null"`
+	Reason        string      `gorm:"column:reason;type:text"`
+	DebtID        *string     `gorm:"column:debt_id;type:char(26)"`
+	InstallmentID *string     `gorm:"column:installment_id;type:char(26)"`
+	ResolvedAt    *time.Time  `gorm:"column:resolved_at;type:timestamp"`
+	ResolvedBy    *string     `gorm:"column:resolved_by;type:char(26)"`
+	Note          string      `gorm:"column:note;type:text"`
+	TenantID      string      `gorm:"column:tenant_id;type:char(26);not null"`
+}
+
+func (d *PixPayment) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == "" {
+		d.ID = ulid.Make().String()
+	}
+	return nil
+}
+
+func (d *PixPayment) TableName() string {
+	return "pix_payments"
+}
@@ -0,0 +1,146 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/reconciliation"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GormPixPaymentRepository struct {
+	db *gorm.DB
+}
+
+func NewGormPixPaymentRepository(db *gorm.DB) *GormPixPaymentRepository {
+	return &GormPixPaymentRepository{db: db}
+}
+
+// Register relies on the unique index of the end to end id, so that only
+// one of the deliveries of a payment is kept even when they arrive together.
+func (g *GormPixPaymentRepository) Register(ctx context.Context, payment *reconciliation.PixPayment) (bool, error) {
+	model := g.convertPixPaymentToModel(payment)
+
+	result := g.db.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "end_to_end_id"}}, DoNothing: true}).
+		Create(&model)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (g *GormPixPaymentRepository) Update(ctx context.Context, payment *reconciliation.PixPayment) error {
+	model := g.convertPixPaymentToModel(payment)
+
+	return g.db.WithContext(ctx).Model(&PixPayment{}).
+		Where("id = ?", model.ID).
+		Updates(map[string]any{
+			"status":         model.Status,
+			"reason":         model.Reason,
+			"debt_id":        model.DebtID,
+			"installment_id": model.InstallmentID,
+			"resolved_at":    model.ResolvedAt,
+			"resolved_by":    model.ResolvedBy,
+			"note":           model.Note,
+		}).Error
+}
+
+func (g *GormPixPaymentRepository) FindById(ctx context.Context, id ulid.ULID) (*reconciliation.PixPayment, error) {
+	var model PixPayment
+
+	result := g.db.WithContext(ctx).Where("id = ?", id.String()).Limit(1).Find(&model)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return g.convertModelToPixPayment(model), nil
+}
+
+func (g *GormPixPaymentRepository) Queue(ctx context.Context, criteria paginate.SearchDto) (*reconciliation.PaginationResult, error) {
+	var models []PixPayment
+	var total int64
+
+	err := g.db.WithContext(ctx).Model(&PixPayment{}).
+		Where("status IN ?", []string{string(reconciliation.Received), string(reconciliation.Review)}).
+		Count(&total).
+		Offset(criteria.Offset()).
+		Limit(criteria.Limit).
+		Order("received_at, id").
+		Find(&models).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	var payments []*reconciliation.PixPayment
+	for _, model := range models {
+		payments = append(payments, g.convertModelToPixPayment(model))
+	}
+
+	return &reconciliation.PaginationResult{
+		TotalRecords: int(total),
+		Data:         payments,
+	}, nil
+}
+
+func (g *GormPixPaymentRepository) convertPixPaymentToModel(payment *reconciliation.PixPayment) PixPayment {
+	return PixPayment{
+		ID:            payment.Id.String(),
+		EndToEndID:    payment.EndToEndId,
+		TxID:          payment.TxId,
+		Amount:        payment.Amount,
+		PaidAt:        payment.PaidAt,
+		ReceivedAt:    payment.ReceivedAt,
+		Status:        string(payment.Status),
+		Reason:        payment.Reason,
+		DebtID:        idString(payment.DebtId),
+		InstallmentID: idString(payment.InstallmentId),
+		ResolvedAt:    payment.ResolvedAt,
+		ResolvedBy:    idString(payment.ResolvedBy),
+		Note:          payment.Note,
+	}
+}
+
+func (g *GormPixPaymentRepository) convertModelToPixPayment(model PixPayment) *reconciliation.PixPayment {
+	return &reconciliation.PixPayment{
+		Id:            ulid.MustParse(model.ID),
+		EndToEndId:    model.EndToEndID,
+		TxId:          model.TxID,
+		Amount:        model.Amount,
+		PaidAt:        model.PaidAt,
+		ReceivedAt:    model.ReceivedAt,
+		Status:        reconciliation.Status(model.Status),
+		Reason:        model.Reason,
+		DebtId:        parseId(model.DebtID),
+		InstallmentId: parseId(model.InstallmentID),
+		ResolvedAt:    model.ResolvedAt,
+		ResolvedBy:    parseId(model.ResolvedBy),
+		Note:          model.Note,
+	}
+}
+
+func idString(id *ulid.ULID) *string {
+	if id == nil {
+		return nil
+	}
+
+	value := id.String()
+	return &value
+}
+
+func parseId(value *string) *ulid.ULID {
+	if value == nil {
+		return nil
+	}
+
+	id := ulid.MustParse(*value)
+	return &id
+}
@@ -0,0 +1,145 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	setupdbtests "github.com/henriquerocha2004/quem-me-deve-api/config/setupDbTests"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/reconciliation"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	ormdb "github.com/henriquerocha2004/quem-me-deve-api/core/shared/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/helpers"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/pix"
+	"github.com/joho/godotenv"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/suite"
+	orm "gorm.io/gorm"
+)
+
+var gormDB *orm.DB = nil
+
+var tenantCtx = shared.WithTenant(context.Background(), ulid.Make())
+
+func TestMain(m *testing.M) {
+	envPath := helpers.ProjetctRoot() + ".env.testing"
+	err := godotenv.Overload(envPath)
+	if err != nil {
+		log.Println(err)
+		panic("Error loading .env file")
+	}
+
+	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
+		os.Getenv("DB_USER"),
+		os.Getenv("DB_PASSWORD"),
+		os.Getenv("DB_HOST"),
+		os.Getenv("DB_PORT"),
+		os.Getenv("DB_NAME"),
+	)
+
+	gormDB, err = ormdb.NewGorm(dsn)
+	if err != nil {
+		log.Println(err)
+		panic(err)
+	}
+	sql, err := gormDB.DB()
+	if err != nil {
+		log.Println(err)
+		panic(err)
+	}
+
+	sql.SetMaxIdleConns(10)
+	sql.SetMaxOpenConns(100)
+	sql.SetConnMaxLifetime(30 * time.Minute)
+
+	defer sql.Close()
+	m.Run()
+}
+
+type PixPaymentRepositorySuiteTest struct {
+	suite.Suite
+}
+
+func (s *PixPaymentRepositorySuiteTest) TearDownTest() {
+	err := setupdbtests.TruncateTables(gormDB)
+	if err != nil {
+		s.Fail("Failed to truncate tables: %v", err)
+	}
+}
+
+func TestPixPaymentRepositorySuite(t *testing.T) {
+	suite.Run(t, new(PixPaymentRepositorySuiteTest))
+}
+
+func (s *PixPaymentRepositorySuiteTest) newPayment(endToEndId string, receivedAt time.Time) *reconciliation.PixPayment {
+	return reconciliation.NewPixPayment(pix.Received{
+		EndToEndId: endToEndId,
+		TxId:       "TX" + endToEndId,
+		Amount:     money.MustParse("150.00"),
+		PaidAt:     receivedAt.Add(-time.Minute).UTC().Truncate(time.Second),
+	}, receivedAt.UTC().Truncate(time.Second))
+}
+
+func (s *PixPaymentRepositorySuiteTest) TestShouldRegisterPaymentOnlyOnce() {
+	repo := NewGormPixPaymentRepository(gormDB)
+	payment := s.newPayment("E1", time.Now())
+
+	registered, err := repo.Register(tenantCtx, payment)
+	s.NoError(err)
+	s.True(registered)
+
+	registered, err = repo.Register(tenantCtx, s.newPayment("E1", time.Now()))
+	s.NoError(err)
+	s.False(registered)
+
+	found, err := repo.FindById(tenantCtx, payment.Id)
+	s.NoError(err)
+	s.Equal(payment.EndToEndId, found.EndToEndId)
+	s.Equal(payment.Amount, found.Amount)
+	s.Equal(reconciliation.Received, found.Status)
+}
+
+func (s *PixPaymentRepositorySuiteTest) TestShouldListQueuedPaymentsOfTenant() {
+	repo := NewGormPixPaymentRepository(gormDB)
+	now := time.Now()
+
+	applied := s.newPayment("E1", now)
+	review := s.newPayment("E2", now.Add(time.Minute))
+	received := s.newPayment("E3", now.Add(2*time.Minute))
+	for _, payment := range []*reconciliation.PixPayment{applied, review, received} {
+		_, err := repo.Register(tenantCtx, payment)
+		s.NoError(err)
+	}
+
+	debtId, installmentId := ulid.Make(), ulid.Make()
+	applied.Status = reconciliation.Applied
+	applied.DebtId = &debtId
+	applied.InstallmentId = &installmentId
+	s.NoError(repo.Update(tenantCtx, applied))
+
+	review.Status = reconciliation.Review
+	review.Reason = reconciliation.ReasonAmountMismatch
+	s.NoError(repo.Update(tenantCtx, review))
+
+	otherTenant := shared.WithTenant(context.Background(), ulid.Make())
+	_, err := repo.Register(otherTenant, s.newPayment("E4", now))
+	s.NoError(err)
+
+	criteria := paginate.SearchDto{Limit: 10}
+	criteria.SetPage(1)
+	result, err := repo.Queue(tenantCtx, criteria)
+	s.NoError(err)
+	s.Equal(2, result.TotalRecords)
+	s.Equal(review.Id, result.Data[0].Id)
+	s.Equal(reconciliation.ReasonAmountMismatch, result.Data[0].Reason)
+	s.Equal(received.Id, result.Data[1].Id)
+
+	found, err := repo.FindById(tenantCtx, applied.Id)
+	s.NoError(err)
+	s.Equal(installmentId, *found.InstallmentId)
+}
@@ -0,0 +1,222 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reconciliation/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=reconciliation/repository.go -destination=reconciliation/mocks/repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	debt "github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	reconciliation "github.com/henriquerocha2004/quem-me-deve-api/core/reconciliation"
+	shared "github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	user "github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	paginate "github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	ulid "github.com/oklog/ulid/v2"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindById mocks base method.
+func (m *MockRepository) FindById(ctx context.Context, id ulid.ULID) (*reconciliation.PixPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*reconciliation.PixPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockRepository)(nil).FindById), ctx, id)
+}
+
+// Queue mocks base method.
+func (m *MockRepository) Queue(ctx context.Context, criteria paginate.SearchDto) (*reconciliation.PaginationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Queue", ctx, criteria)
+	ret0, _ := ret[0].(*reconciliation.PaginationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Queue indicates an expected call of Queue.
+func (mr *MockRepositoryMockRecorder) Queue(ctx, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Queue", reflect.TypeOf((*MockRepository)(nil).Queue), ctx, criteria)
+}
+
+// Register mocks base method.
+func (m *MockRepository) Register(ctx context.Context, payment *reconciliation.PixPayment) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, payment)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockRepositoryMockRecorder) Register(ctx, payment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockRepository)(nil).Register), ctx, payment)
+}
+
+// Update mocks base method.
+func (m *MockRepository) Update(ctx context.Context, payment *reconciliation.PixPayment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, payment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRepositoryMockRecorder) Update(ctx, payment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, payment)
+}
+
+// MockAccountReader is a mock of AccountReader interface.
+type MockAccountReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockAccountReaderMockRecorder is the mock recorder for MockAccountReader.
+type MockAccountReaderMockRecorder struct {
+	mock *MockAccountReader
+}
+
+// NewMockAccountReader creates a new mock instance.
+func NewMockAccountReader(ctrl *gomock.Controller) *MockAccountReader {
+	mock := &MockAccountReader{ctrl: ctrl}
+	mock.recorder = &MockAccountReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountReader) EXPECT() *MockAccountReaderMockRecorder {
+	return m.recorder
+}
+
+// FindById mocks base method.
+func (m *MockAccountReader) FindById(ctx context.Context, id ulid.ULID) (*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockAccountReaderMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockAccountReader)(nil).FindById), ctx, id)
+}
+
+// MockDebtReader is a mock of DebtReader interface.
+type MockDebtReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockDebtReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockDebtReaderMockRecorder is the mock recorder for MockDebtReader.
+type MockDebtReaderMockRecorder struct {
+	mock *MockDebtReader
+}
+
+// NewMockDebtReader creates a new mock instance.
+func NewMockDebtReader(ctrl *gomock.Controller) *MockDebtReader {
+	mock := &MockDebtReader{ctrl: ctrl}
+	mock.recorder = &MockDebtReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDebtReader) EXPECT() *MockDebtReaderMockRecorder {
+	return m.recorder
+}
+
+// InstallmentDebt mocks base method.
+func (m *MockDebtReader) InstallmentDebt(ctx context.Context, installmentId ulid.ULID) (*debt.Debt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallmentDebt", ctx, installmentId)
+	ret0, _ := ret[0].(*debt.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstallmentDebt indicates an expected call of InstallmentDebt.
+func (mr *MockDebtReaderMockRecorder) InstallmentDebt(ctx, installmentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallmentDebt", reflect.TypeOf((*MockDebtReader)(nil).InstallmentDebt), ctx, installmentId)
+}
+
+// MockInstallmentPayer is a mock of InstallmentPayer interface.
+type MockInstallmentPayer struct {
+	ctrl     *gomock.Controller
+	recorder *MockInstallmentPayerMockRecorder
+	isgomock struct{}
+}
+
+// MockInstallmentPayerMockRecorder is the mock recorder for MockInstallmentPayer.
+type MockInstallmentPayerMockRecorder struct {
+	mock *MockInstallmentPayer
+}
+
+// NewMockInstallmentPayer creates a new mock instance.
+func NewMockInstallmentPayer(ctrl *gomock.Controller) *MockInstallmentPayer {
+	mock := &MockInstallmentPayer{ctrl: ctrl}
+	mock.recorder = &MockInstallmentPayerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInstallmentPayer) EXPECT() *MockInstallmentPayerMockRecorder {
+	return m.recorder
+}
+
+// PayInstallment mocks base method.
+func (m *MockInstallmentPayer) PayInstallment(ctx context.Context, pgInfo *debt.PaymentInfoDto) shared.ServiceResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayInstallment", ctx, pgInfo)
+	ret0, _ := ret[0].(shared.ServiceResponse)
+	return ret0
+}
+
+// PayInstallment indicates an expected call of PayInstallment.
+func (mr *MockInstallmentPayerMockRecorder) PayInstallment(ctx, pgInfo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayInstallment", reflect.TypeOf((*MockInstallmentPayer)(nil).PayInstallment), ctx, pgInfo)
+}
@@ -0,0 +1,87 @@
+package reconciliation
+
+import (
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/pix"
+	"github.com/oklog/ulid/v2"
+)
+
+type Status string
+
+const (
+	// Received is a payment kept but not reconciled yet.
+	Received Status = "received"
+	// Applied is a payment registered on the installment it was made for.
+	Applied Status = "applied"
+	// Review is a payment waiting for someone to decide where it goes.
+	Review Status = "review"
+	// Resolved is a payment reviewed by hand.
+	Resolved Status = "resolved"
+)
+
+// Reasons a payment is sent to review.
+const (
+	ReasonUnknownTxId     = "the txid was not made for an installment"
+	ReasonNotFound        = "installment not found"
+	ReasonNotOpen         = "installment is not open for payment"
+	ReasonAmountMismatch  = "amount does not match the installment balance"
+	ReasonLookupFailed    = "error finding the installment"
+	ReasonPaymentRejected = "payment rejected: "
+)
+
+// PixPayment is a Pix payment notified by the PSP to the account. The ones
+// that can not be registered on an installment by themselves wait in the
+// reconciliation queue.
+type PixPayment struct {
+	Id            ulid.ULID
+	EndToEndId    string
+	TxId          string
+	Amount        money.Money
+	PaidAt        time.Time
+	ReceivedAt    time.Time
+	Status        Status
+	Reason        string
+	DebtId        *ulid.ULID
+	InstallmentId *ulid.ULID
+	ResolvedAt    *time.Time
+	ResolvedBy    *ulid.ULID
+	Note          string
+}
+
+func NewPixPayment(received pix.Received, now time.Time) *PixPayment {
+	return &PixPayment{
+		Id:         ulid.Make(),
+		EndToEndId: received.EndToEndId,
+		TxId:       received.TxId,
+		Amount:     received.Amount,
+		PaidAt:     received.PaidAt,
+		ReceivedAt: now,
+		Status:     Received,
+	}
+}
+
+// IsQueued reports whether the payment waits for review.
+func (p *PixPayment) IsQueued() bool {
+	return p.Status == Received || p.Status == Review
+}
+
+func (p *PixPayment) apply(debtId, installmentId ulid.ULID) {
+	p.DebtId = &debtId
+	p.InstallmentId = &installmentId
+	p.Status = Applied
+	p.Reason = ""
+}
+
+func (p *PixPayment) review(reason string) {
+	p.Status = Review
+	p.Reason = reason
+}
+
+func (p *PixPayment) resolve(by ulid.ULID, note string, at time.Time) {
+	p.Status = Resolved
+	p.ResolvedBy = &by
+	p.ResolvedAt = &at
+	p.Note = note
+}
@@ -0,0 +1,33 @@
+package reconciliation
+
+import (
+	"context"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+)
+
+type Repository interface {
+	// Register keeps the payment, reporting false when a payment with the
+	// same end to end id was already received.
+	Register(ctx context.Context, payment *PixPayment) (bool, error)
+	Update(ctx context.Context, payment *PixPayment) error
+	FindById(ctx context.Context, id ulid.ULID) (*PixPayment, error)
+	// Queue returns the payments waiting for review, the oldest first.
+	Queue(ctx context.Context, criteria paginate.SearchDto) (*PaginationResult, error)
+}
+
+type AccountReader interface {
+	FindById(ctx context.Context, id ulid.ULID) (*user.User, error)
+}
+
+type DebtReader interface {
+	InstallmentDebt(ctx context.Context, installmentId ulid.ULID) (*debt.Debt, error)
+}
+
+type InstallmentPayer interface {
+	PayInstallment(ctx context.Context, pgInfo *debt.PaymentInfoDto) shared.ServiceResponse
+}
@@ -0,0 +1,310 @@
+package reconciliation
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/pix"
+	"github.com/oklog/ulid/v2"
+)
+
+type Service interface {
+	ReceivePix(ctx context.Context, accountId ulid.ULID, payments []pix.Received) shared.ServiceResponse
+	Queue(ctx context.Context, criteria *paginate.PaginateRequest) shared.ServiceResponse
+	Resolve(ctx context.Context, paymentId ulid.ULID, dto *ResolveDto) shared.ServiceResponse
+}
+
+type ReconciliationService struct {
+	payments Repository
+	accounts AccountReader
+	debts    DebtReader
+	payer    InstallmentPayer
+}
+
+func NewReconciliationService(payments Repository, accounts AccountReader, debts DebtReader, payer InstallmentPayer) *ReconciliationService {
+	return &ReconciliationService{
+		payments: payments,
+		accounts: accounts,
+		debts:    debts,
+		payer:    payer,
+	}
+}
+
+// ReceivePix registers the Pix payments the PSP notified to the account. A
+// payment delivered again is left as it is, so that it is never registered
+// twice on the installment.
+func (s *ReconciliationService) ReceivePix(ctx context.Context, accountId ulid.ULID, payments []pix.Received) shared.ServiceResponse {
+	account, err := s.accounts.FindById(ctx, accountId)
+	if err != nil {
+		log.Println("Error retrieving account:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error retrieving account",
+		}
+	}
+
+	if account == nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "account not found",
+		}
+	}
+
+	ctx = shared.WithTenant(ctx, account.Id)
+	receipt := ReceiptDto{}
+
+	for _, received := range payments {
+		payment := NewPixPayment(received, time.Now())
+
+		registered, err := s.payments.Register(ctx, payment)
+		if err != nil {
+			log.Println("Error registering pix payment:", err)
+			return shared.ServiceResponse{
+				Status:  "error",
+				Message: "error registering pix payment",
+			}
+		}
+
+		if !registered {
+			receipt.Duplicated++
+			continue
+		}
+
+		s.reconcile(ctx, payment)
+
+		if err := s.payments.Update(ctx, payment); err != nil {
+			log.Println("Error updating pix payment:", err)
+			return shared.ServiceResponse{
+				Status:  "error",
+				Message: "error updating pix payment",
+			}
+		}
+
+		if payment.Status == Applied {
+			receipt.Applied++
+		} else {
+			receipt.Review++
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "pix payments received",
+		Data:    receipt,
+	}
+}
+
+func (s *ReconciliationService) Queue(ctx context.Context, criteria *paginate.PaginateRequest) shared.ServiceResponse {
+	pagDto := paginate.SearchDto{
+		Limit:         criteria.Limit,
+		SortField:     criteria.SortField,
+		TermSearch:    criteria.SearchTerm,
+		SortDirection: criteria.SortDirection,
+	}
+
+	pagDto.SetPage(criteria.Page)
+
+	result, err := s.payments.Queue(ctx, pagDto)
+	if err != nil {
+		log.Println("Error getting reconciliation queue:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in get reconciliation queue",
+		}
+	}
+
+	paymentsDto := []PixPaymentDto{}
+	for _, payment := range result.Data {
+		paymentsDto = append(paymentsDto, s.convertToPixPaymentDto(payment))
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "reconciliation queue retrieved successfully",
+		Data: paginate.Result{
+			TotalRecords: result.TotalRecords,
+			Data:         paymentsDto,
+		},
+	}
+}
+
+// Resolve takes a payment out of the reconciliation queue, registering it on
+// the installment informed, if any.
+func (s *ReconciliationService) Resolve(ctx context.Context, paymentId ulid.ULID, dto *ResolveDto) shared.ServiceResponse {
+	userId, ok := user.IdFromContext(ctx)
+	if !ok {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "user not found in context",
+		}
+	}
+
+	payment, err := s.payments.FindById(ctx, paymentId)
+	if err != nil {
+		log.Println("Error retrieving pix payment:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error retrieving pix payment",
+		}
+	}
+
+	if payment == nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "pix payment not found",
+		}
+	}
+
+	if !payment.IsQueued() {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "pix payment is not waiting for review",
+		}
+	}
+
+	if dto.InstallmentId != "" {
+		installmentId, _ := ulid.Parse(dto.InstallmentId)
+
+		d, err := s.debts.InstallmentDebt(ctx, installmentId)
+		if err != nil {
+			log.Println("Error retrieving installment debt:", err)
+			return shared.ServiceResponse{
+				Status:  "error",
+				Message: "error retrieving installment",
+			}
+		}
+
+		if d == nil {
+			return shared.ServiceResponse{
+				Status:  "error",
+				Message: ReasonNotFound,
+			}
+		}
+
+		response := s.pay(ctx, payment, d.Id, installmentId, dto.CreditSurplus)
+		if response.Status == "error" {
+			return response
+		}
+
+		payment.DebtId = &d.Id
+		payment.InstallmentId = &installmentId
+	}
+
+	payment.resolve(userId, dto.Note, time.Now())
+
+	if err := s.payments.Update(ctx, payment); err != nil {
+		log.Println("Error updating pix payment:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error updating pix payment",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "pix payment resolved",
+		Data:    s.convertToPixPaymentDto(payment),
+	}
+}
+
+// reconcile registers the payment on the installment its txid was made for
+// when it pays exactly the balance of the installment, and sends it to review
+// otherwise.
+func (s *ReconciliationService) reconcile(ctx context.Context, payment *PixPayment) {
+	installmentId, err := debt.InstallmentIdFromTxId(payment.TxId)
+	if err != nil {
+		payment.review(ReasonUnknownTxId)
+		return
+	}
+
+	d, err := s.debts.InstallmentDebt(ctx, installmentId)
+	if err != nil {
+		log.Println("Error retrieving installment debt:", err)
+		payment.review(ReasonLookupFailed)
+		return
+	}
+
+	if d == nil {
+		payment.review(ReasonNotFound)
+		return
+	}
+
+	payment.DebtId = &d.Id
+	payment.InstallmentId = &installmentId
+
+	if !isOpen(d, installmentId) {
+		payment.review(ReasonNotOpen)
+		return
+	}
+
+	balance, err := d.InstallmentBalance(installmentId.String(), payment.PaidAt)
+	if err != nil || !payment.Amount.Equal(balance) {
+		payment.review(ReasonAmountMismatch)
+		return
+	}
+
+	response := s.pay(ctx, payment, d.Id, installmentId, false)
+	if response.Status == "error" {
+		payment.review(ReasonPaymentRejected + response.Message)
+		return
+	}
+
+	payment.apply(d.Id, installmentId)
+}
+
+// pay registers the Pix on the installment under its end to end id, so that
+// a payment resolved again, after its update failed, is not paid twice.
+func (s *ReconciliationService) pay(ctx context.Context, payment *PixPayment, debtId, installmentId ulid.ULID, creditSurplus bool) shared.ServiceResponse {
+	return s.payer.PayInstallment(ctx, &debt.PaymentInfoDto{
+		DebtId:        debtId.String(),
+		InstallmentId: installmentId.String(),
+		Amount:        payment.Amount,
+		PaymentMethod: debt.PixPaymentMethod,
+		CreditSurplus: creditSurplus,
+		PaidAt:        &payment.PaidAt,
+		Reference:     payment.EndToEndId,
+	})
+}
+
+func isOpen(d *debt.Debt, installmentId ulid.ULID) bool {
+	for _, installment := range d.Intallments {
+		if installment.Id == installmentId {
+			return installment.IsOpen()
+		}
+	}
+
+	return false
+}
+
+func (s *ReconciliationService) convertToPixPaymentDto(payment *PixPayment) PixPaymentDto {
+	paymentDto := PixPaymentDto{
+		Id:         payment.Id.String(),
+		EndToEndId: payment.EndToEndId,
+		TxId:       payment.TxId,
+		Amount:     payment.Amount,
+		PaidAt:     payment.PaidAt.Format(time.DateTime),
+		ReceivedAt: payment.ReceivedAt.Format(time.DateTime),
+		Status:     string(payment.Status),
+		Reason:     payment.Reason,
+		Note:       payment.Note,
+	}
+
+	if payment.DebtId != nil {
+		paymentDto.DebtId = payment.DebtId.String()
+	}
+
+	if payment.InstallmentId != nil {
+		paymentDto.InstallmentId = payment.InstallmentId.String()
+	}
+
+	if payment.ResolvedAt != nil {
+		paymentDto.ResolvedAt = payment.ResolvedAt.Format(time.DateTime)
+	}
+
+	return paymentDto
+}
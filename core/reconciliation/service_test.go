@@ -0,0 +1,259 @@
+package reconciliation_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/reconciliation"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/reconciliation/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/pix"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func newDebt() *debt.Debt {
+	dueDate := time.Now().AddDate(0, 1, 0)
+	d := &debt.Debt{
+		Id:                   ulid.Make(),
+		UserClientId:         ulid.Make(),
+		Status:               debt.Pending,
+		DueDate:              &dueDate,
+		TotalValue:           money.FromCents(50000),
+		InstallmentsQuantity: 2,
+	}
+	d.GenerateInstallments()
+
+	return d
+}
+
+func received(txId string, amount money.Money) pix.Received {
+	return pix.Received{
+		EndToEndId: "E" + ulid.Make().String(),
+		TxId:       txId,
+		Amount:     amount,
+		PaidAt:     time.Now(),
+	}
+}
+
+func TestReconciliationService(t *testing.T) {
+	account, _ := user.NewUser("Maria", "maria@email.com", "12345678")
+
+	t.Run("Deve registrar o pix recebido na parcela do txid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		d := newDebt()
+		installment := d.Intallments[0]
+		pixReceived := received(debt.PixTxId(installment.Id), installment.Value)
+
+		accounts := mocks.NewMockAccountReader(ctrl)
+		accounts.EXPECT().FindById(gomock.Any(), account.Id).Return(account, nil)
+		debts := mocks.NewMockDebtReader(ctrl)
+		debts.EXPECT().InstallmentDebt(gomock.Any(), installment.Id).Return(d, nil)
+		payer := mocks.NewMockInstallmentPayer(ctrl)
+		payer.EXPECT().PayInstallment(gomock.Any(), &debt.PaymentInfoDto{
+			DebtId:        d.Id.String(),
+			InstallmentId: installment.Id.String(),
+			Amount:        installment.Value,
+			PaymentMethod: debt.PixPaymentMethod,
+			PaidAt:        &pixReceived.PaidAt,
+			Reference:     pixReceived.EndToEndId,
+		}).DoAndReturn(func(ctx context.Context, _ *debt.PaymentInfoDto) shared.ServiceResponse {
+			tenantId, _ := shared.TenantFromContext(ctx)
+			assert.Equal(t, account.Id, tenantId)
+			return shared.ServiceResponse{Status: "success"}
+		})
+
+		payments := mocks.NewMockRepository(ctrl)
+		payments.EXPECT().Register(gomock.Any(), gomock.Any()).Return(true, nil)
+		payments.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, payment *reconciliation.PixPayment) error {
+			assert.Equal(t, reconciliation.Applied, payment.Status)
+			assert.Equal(t, installment.Id, *payment.InstallmentId)
+			return nil
+		})
+
+		service := reconciliation.NewReconciliationService(payments, accounts, debts, payer)
+		response := service.ReceivePix(context.Background(), account.Id, []pix.Received{pixReceived})
+
+		assert.Equal(t, "success", response.Status)
+		assert.Equal(t, reconciliation.ReceiptDto{Applied: 1}, response.Data)
+	})
+
+	t.Run("Deve ignorar o pix entregue novamente", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		accounts := mocks.NewMockAccountReader(ctrl)
+		accounts.EXPECT().FindById(gomock.Any(), account.Id).Return(account, nil)
+		payer := mocks.NewMockInstallmentPayer(ctrl)
+		payer.EXPECT().PayInstallment(gomock.Any(), gomock.Any()).Times(0)
+
+		payments := mocks.NewMockRepository(ctrl)
+		payments.EXPECT().Register(gomock.Any(), gomock.Any()).Return(false, nil)
+		payments.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+
+		service := reconciliation.NewReconciliationService(payments, accounts, mocks.NewMockDebtReader(ctrl), payer)
+		response := service.ReceivePix(context.Background(), account.Id, []pix.Received{
+			received(debt.PixTxId(ulid.Make()), money.FromCents(100)),
+		})
+
+		assert.Equal(t, "success", response.Status)
+		assert.Equal(t, reconciliation.ReceiptDto{Duplicated: 1}, response.Data)
+	})
+
+	t.Run("Deve enviar para revisao o pix sem parcela ou com valor divergente", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		d := newDebt()
+		installment := d.Intallments[0]
+		paid := d.Intallments[1]
+		d.Intallments[1].Status = debt.Paid
+		unknown := ulid.Make()
+
+		accounts := mocks.NewMockAccountReader(ctrl)
+		accounts.EXPECT().FindById(gomock.Any(), account.Id).Return(account, nil)
+		debts := mocks.NewMockDebtReader(ctrl)
+		debts.EXPECT().InstallmentDebt(gomock.Any(), installment.Id).Return(d, nil)
+		debts.EXPECT().InstallmentDebt(gomock.Any(), paid.Id).Return(d, nil)
+		debts.EXPECT().InstallmentDebt(gomock.Any(), unknown).Return(nil, nil)
+		payer := mocks.NewMockInstallmentPayer(ctrl)
+		payer.EXPECT().PayInstallment(gomock.Any(), gomock.Any()).Times(0)
+
+		var reasons []string
+		payments := mocks.NewMockRepository(ctrl)
+		payments.EXPECT().Register(gomock.Any(), gomock.Any()).Return(true, nil).Times(4)
+		payments.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, payment *reconciliation.PixPayment) error {
+			assert.Equal(t, reconciliation.Review, payment.Status)
+			reasons = append(reasons, payment.Reason)
+			return nil
+		}).Times(4)
+
+		service := reconciliation.NewReconciliationService(payments, accounts, debts, payer)
+		response := service.ReceivePix(context.Background(), account.Id, []pix.Received{
+			received("***", installment.Value),
+			received(debt.PixTxId(unknown), installment.Value),
+			received(debt.PixTxId(installment.Id), installment.Value.Sub(money.FromCents(1))),
+			received(debt.PixTxId(paid.Id), paid.Value),
+		})
+
+		assert.Equal(t, "success", response.Status)
+		assert.Equal(t, reconciliation.ReceiptDto{Review: 4}, response.Data)
+		assert.Equal(t, []string{
+			reconciliation.ReasonUnknownTxId,
+			reconciliation.ReasonNotFound,
+			reconciliation.ReasonAmountMismatch,
+			reconciliation.ReasonNotOpen,
+		}, reasons)
+	})
+
+	t.Run("Deve enviar para revisao o pix recusado pela divida", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		d := newDebt()
+		installment := d.Intallments[0]
+
+		accounts := mocks.NewMockAccountReader(ctrl)
+		accounts.EXPECT().FindById(gomock.Any(), account.Id).Return(account, nil)
+		debts := mocks.NewMockDebtReader(ctrl)
+		debts.EXPECT().InstallmentDebt(gomock.Any(), installment.Id).Return(d, nil)
+		payer := mocks.NewMockInstallmentPayer(ctrl)
+		payer.EXPECT().PayInstallment(gomock.Any(), gomock.Any()).Return(shared.ServiceResponse{Status: "error", Message: "error updating debt"})
+
+		payments := mocks.NewMockRepository(ctrl)
+		payments.EXPECT().Register(gomock.Any(), gomock.Any()).Return(true, nil)
+		payments.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, payment *reconciliation.PixPayment) error {
+			assert.Equal(t, reconciliation.Review, payment.Status)
+			assert.Equal(t, reconciliation.ReasonPaymentRejected+"error updating debt", payment.Reason)
+			return nil
+		})
+
+		service := reconciliation.NewReconciliationService(payments, accounts, debts, payer)
+		response := service.ReceivePix(context.Background(), account.Id, []pix.Received{
+			received(debt.PixTxId(installment.Id), installment.Value),
+		})
+
+		assert.Equal(t, reconciliation.ReceiptDto{Review: 1}, response.Data)
+	})
+
+	t.Run("Deve recusar o pix de uma conta inexistente", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		accounts := mocks.NewMockAccountReader(ctrl)
+		accounts.EXPECT().FindById(gomock.Any(), gomock.Any()).Return(nil, nil)
+		payments := mocks.NewMockRepository(ctrl)
+		payments.EXPECT().Register(gomock.Any(), gomock.Any()).Times(0)
+
+		service := reconciliation.NewReconciliationService(payments, accounts, mocks.NewMockDebtReader(ctrl), mocks.NewMockInstallmentPayer(ctrl))
+		response := service.ReceivePix(context.Background(), ulid.Make(), []pix.Received{received("***", money.FromCents(100))})
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "account not found", response.Message)
+	})
+
+	t.Run("Deve resolver um pix da fila registrando na parcela informada", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		d := newDebt()
+		installment := d.Intallments[1]
+		payment := reconciliation.NewPixPayment(received("***", money.FromCents(30000)), time.Now())
+		payment.Status = reconciliation.Review
+
+		payments := mocks.NewMockRepository(ctrl)
+		payments.EXPECT().FindById(gomock.Any(), payment.Id).Return(payment, nil)
+		payments.EXPECT().Update(gomock.Any(), payment).Return(nil)
+		debts := mocks.NewMockDebtReader(ctrl)
+		debts.EXPECT().InstallmentDebt(gomock.Any(), installment.Id).Return(d, nil)
+		payer := mocks.NewMockInstallmentPayer(ctrl)
+		payer.EXPECT().PayInstallment(gomock.Any(), &debt.PaymentInfoDto{
+			DebtId:        d.Id.String(),
+			InstallmentId: installment.Id.String(),
+			Amount:        money.FromCents(30000),
+			PaymentMethod: debt.PixPaymentMethod,
+			CreditSurplus: true,
+			PaidAt:        &payment.PaidAt,
+			Reference:     payment.EndToEndId,
+		}).Return(shared.ServiceResponse{Status: "success"})
+
+		service := reconciliation.NewReconciliationService(payments, mocks.NewMockAccountReader(ctrl), debts, payer)
+		ctx := user.WithUserId(context.Background(), account.Id)
+		response := service.Resolve(ctx, payment.Id, &reconciliation.ResolveDto{
+			InstallmentId: installment.Id.String(),
+			CreditSurplus: true,
+			Note:          "cliente pagou sem usar o QR code",
+		})
+
+		assert.Equal(t, "success", response.Status)
+		assert.Equal(t, reconciliation.Resolved, payment.Status)
+		assert.Equal(t, account.Id, *payment.ResolvedBy)
+		assert.Equal(t, installment.Id, *payment.InstallmentId)
+	})
+
+	t.Run("Deve recusar resolver um pix ja registrado", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		payment := reconciliation.NewPixPayment(received("***", money.FromCents(30000)), time.Now())
+		payment.Status = reconciliation.Applied
+
+		payments := mocks.NewMockRepository(ctrl)
+		payments.EXPECT().FindById(gomock.Any(), payment.Id).Return(payment, nil)
+		payments.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+
+		service := reconciliation.NewReconciliationService(payments, mocks.NewMockAccountReader(ctrl), mocks.NewMockDebtReader(ctrl), mocks.NewMockInstallmentPayer(ctrl))
+		ctx := user.WithUserId(context.Background(), account.Id)
+		response := service.Resolve(ctx, payment.Id, &reconciliation.ResolveDto{Note: "duplicado"})
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "pix payment is not waiting for review", response.Message)
+	})
+}
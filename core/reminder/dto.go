@@ -0,0 +1,20 @@
+package reminder
+
+type RuleDto struct {
+	Id       string `json:"id,omitempty"`
+	Trigger  string `json:"trigger" validate:"required,oneof=before_due on_due after_due"`
+	Days     int    `json:"days" validate:"gte=0"`
+	Channel  string `json:"channel" validate:"required,oneof=email sms whatsapp"`
+	Template string `json:"template,omitempty"`
+}
+
+type SendDto struct {
+	Id            string `json:"id"`
+	RuleId        string `json:"rule_id"`
+	Channel       string `json:"channel"`
+	Recipient     string `json:"recipient"`
+	Status        string `json:"status"`
+	Error         string `json:"error,omitempty"`
+	ReferenceDate string `json:"reference_date"`
+	AttemptedAt   string `json:"attempted_at"`
+}
@@ -0,0 +1,78 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type Rule struct {
+	ID        string     `gorm:"column:id;primaryKey;type:char(26)"`
+	Trigger   string     `gorm:"column:trigger;type:varchar(20);not null"`
+	Days      int        `gorm:"column:days;type:int;not null"`
+	Channel   string     `gorm:"column:channel;type:varchar(20);not null"`
+	Template  string     `gorm:"column:template;type:text;not null"`
+	CreatedAt *time.Time `gorm:"column:created_at"`
+	TenantID  string     `gorm:"column:tenant_id;type:char(26);not null"`
+}
+
+func (d *Rule) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == "" {
+		d.ID = ulid.Make().String()
+	}
+	return nil
+}
+
+func (d *Rule) TableName() string {
+	return "reminder_rules"
+}
+
+type Send struct {
+	ID            string     `gorm:"column:id;primaryKey;type:char(26)"`
+	RuleID        string     `gorm:"column:rule_id;type:char(26);not null"`
+	InstallmentID string     `gorm:"column:installment_id;type:char(26);not null"`
+	Channel       string     `gorm:"column:channel;type:varchar(20);not null"`
+	Recipient     string     `gorm:"column:recipient;type:text"`
+	Status        string     `gorm:"column:status;type:varchar(20);not null"`
+	Error         string     `gorm:"column:error;type:text"`
+	ReferenceDate *time.Time `gorm:"column:reference_date;type:date;not null"`
+	AttemptedAt   *time.Time `gorm:"column:attempted_at;type:timestamp;not null"`
+	TenantID      string     `gorm:"column:tenant_id;type:char(26);not null"`
+}
+
+func (d *Send) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == "" {
+		d.ID = ulid.Make().String()
+	}
+	return nil
+}
+
+func (d *Send) TableName() string {
+	return "reminder_sends"
+}
+
+// dueInstallment maps the installments with the tenant column, so that the
+// reminders only read the installments of the account, joined with what
+// reaches their client.
+type dueInstallment struct {
+	ID       string `gorm:"column:id"`
+	TenantID string `gorm:"column:tenant_id"`
+}
+
+func (d *dueInstallment) TableName() string {
+	return "installments"
+}
+
+type dueInstallmentRow struct {
+	ID         string
+	DebtID     string
+	Number     int
+	Value      money.Money
+	DueDate    *time.Time
+	ClientName string
+	LastName   string
+	Email      string
+	Phone      string
+}
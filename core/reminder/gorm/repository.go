@@ -0,0 +1,204 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/reminder"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type GormRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewGormRuleRepository(db *gorm.DB) *GormRuleRepository {
+	return &GormRuleRepository{db: db}
+}
+
+func (r *GormRuleRepository) Rules(ctx context.Context) ([]*reminder.Rule, error) {
+	var models []Rule
+
+	err := r.db.WithContext(ctx).Order("id").Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []*reminder.Rule
+	for _, model := range models {
+		rules = append(rules, &reminder.Rule{
+			Id:       ulid.MustParse(model.ID),
+			Trigger:  reminder.Trigger(model.Trigger),
+			Days:     model.Days,
+			Channel:  reminder.Channel(model.Channel),
+			Template: model.Template,
+		})
+	}
+
+	return rules, nil
+}
+
+func (r *GormRuleRepository) Create(ctx context.Context, rule *reminder.Rule) error {
+	model := Rule{
+		ID:       rule.Id.String(),
+		Trigger:  string(rule.Trigger),
+		Days:     rule.Days,
+		Channel:  string(rule.Channel),
+		Template: rule.Template,
+	}
+
+	return r.db.WithContext(ctx).Create(&model).Error
+}
+
+func (r *GormRuleRepository) Delete(ctx context.Context, id ulid.ULID) (bool, error) {
+	result := r.db.WithContext(ctx).Where("id = ?", id.String()).Delete(&Rule{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// Tenants runs from the reminder job and not from a request, so it looks
+// through every account.
+func (r *GormRuleRepository) Tenants(ctx context.Context) ([]ulid.ULID, error) {
+	var ids []string
+
+	err := r.db.WithContext(shared.AcrossTenants(ctx)).Model(&Rule{}).
+		Distinct("tenant_id").
+		Order("tenant_id").
+		Pluck("tenant_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var tenants []ulid.ULID
+	for _, id := range ids {
+		tenants = append(tenants, ulid.MustParse(id))
+	}
+
+	return tenants, nil
+}
+
+type GormInstallmentReader struct {
+	db *gorm.DB
+}
+
+func NewGormInstallmentReader(db *gorm.DB) *GormInstallmentReader {
+	return &GormInstallmentReader{db: db}
+}
+
+// DueInstallments reaches the client of each installment through its debt,
+// taking the first phone of the client for the SMS and WhatsApp reminders.
+func (i *GormInstallmentReader) DueInstallments(ctx context.Context, until time.Time) ([]*reminder.DueInstallment, error) {
+	var rows []dueInstallmentRow
+
+	err := i.db.WithContext(ctx).Model(&dueInstallment{}).
+		Select(`installments.id, installments.debt_id, installments.number, installments.value, installments.due_date,
+			clients.name AS client_name, clients.last_name, clients.email,
+			(SELECT phones.number FROM phones WHERE phones.owner_id = clients.id ORDER BY phones.id LIMIT 1) AS phone`).
+		Joins("JOIN debts ON debts.id = installments.debt_id").
+		Joins("JOIN clients ON clients.id = debts.user_client_id AND clients.deleted_at IS NULL").
+		Where("installments.status IN ? AND debts.status IN ? AND installments.due_date <= ?",
+			[]string{"pending", "partially_paid", "overdue"}, []string{"pending", "overdue"}, until).
+		Order("installments.due_date, installments.id").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var installments []*reminder.DueInstallment
+	for _, row := range rows {
+		if row.DueDate == nil {
+			continue
+		}
+
+		installments = append(installments, &reminder.DueInstallment{
+			Id:         ulid.MustParse(row.ID),
+			DebtId:     ulid.MustParse(row.DebtID),
+			Number:     row.Number,
+			Value:      row.Value,
+			DueDate:    *row.DueDate,
+			ClientName: strings.TrimSpace(row.ClientName + " " + row.LastName),
+			Email:      row.Email,
+			Phone:      row.Phone,
+		})
+	}
+
+	return installments, nil
+}
+
+type GormSendLog struct {
+	db *gorm.DB
+}
+
+func NewGormSendLog(db *gorm.DB) *GormSendLog {
+	return &GormSendLog{db: db}
+}
+
+func (l *GormSendLog) Done(ctx context.Context, ruleId, installmentId ulid.ULID, referenceDate time.Time) (bool, error) {
+	var count int64
+
+	err := l.db.WithContext(ctx).Model(&Send{}).
+		Where("rule_id = ? AND installment_id = ? AND reference_date = ? AND status IN ?",
+			ruleId.String(), installmentId.String(), referenceDate,
+			[]string{string(reminder.Sent), string(reminder.Skipped)}).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (l *GormSendLog) Record(ctx context.Context, send *reminder.Send) error {
+	model := Send{
+		ID:            send.Id.String(),
+		RuleID:        send.RuleId.String(),
+		InstallmentID: send.InstallmentId.String(),
+		Channel:       string(send.Channel),
+		Recipient:     send.Recipient,
+		Status:        string(send.Status),
+		Error:         send.Error,
+		ReferenceDate: &send.ReferenceDate,
+		AttemptedAt:   &send.AttemptedAt,
+	}
+
+	return l.db.WithContext(ctx).Create(&model).Error
+}
+
+func (l *GormSendLog) Sends(ctx context.Context, installmentId ulid.ULID) ([]*reminder.Send, error) {
+	var models []Send
+
+	err := l.db.WithContext(ctx).
+		Where("installment_id = ?", installmentId.String()).
+		Order("attempted_at, id").
+		Find(&models).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var sends []*reminder.Send
+	for _, model := range models {
+		sends = append(sends, &reminder.Send{
+			Id:            ulid.MustParse(model.ID),
+			RuleId:        ulid.MustParse(model.RuleID),
+			InstallmentId: ulid.MustParse(model.InstallmentID),
+			Channel:       reminder.Channel(model.Channel),
+			Recipient:     model.Recipient,
+			Status:        reminder.SendStatus(model.Status),
+			Error:         model.Error,
+			ReferenceDate: *model.ReferenceDate,
+			AttemptedAt:   *model.AttemptedAt,
+		})
+	}
+
+	return sends, nil
+}
@@ -0,0 +1,206 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	setupdbtests "github.com/henriquerocha2004/quem-me-deve-api/config/setupDbTests"
+	clientGorm "github.com/henriquerocha2004/quem-me-deve-api/core/client/gorm"
+	debtGorm "github.com/henriquerocha2004/quem-me-deve-api/core/debt/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/reminder"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	ormdb "github.com/henriquerocha2004/quem-me-deve-api/core/shared/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/helpers"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/joho/godotenv"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/suite"
+	orm "gorm.io/gorm"
+)
+
+var gormDB *orm.DB = nil
+
+// tenantCtx scopes the tests to a single tenant, as the requests are.
+var tenantCtx = shared.WithTenant(context.Background(), ulid.Make())
+
+func TestMain(m *testing.M) {
+	envPath := helpers.ProjetctRoot() + ".env.testing"
+	err := godotenv.Overload(envPath)
+	if err != nil {
+		log.Println(err)
+		panic("Error loading .env file")
+	}
+
+	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
+		os.Getenv("DB_USER"),
+		os.Getenv("DB_PASSWORD"),
+		os.Getenv("DB_HOST"),
+		os.Getenv("DB_PORT"),
+		os.Getenv("DB_NAME"),
+	)
+
+	gormDB, err = ormdb.NewGorm(dsn)
+	if err != nil {
+		log.Println(err)
+		panic(err)
+	}
+	sql, err := gormDB.DB()
+	if err != nil {
+		log.Println(err)
+		panic(err)
+	}
+
+	sql.SetMaxIdleConns(10)
+	sql.SetMaxOpenConns(100)
+	sql.SetConnMaxLifetime(30 * time.Minute)
+
+	defer sql.Close()
+	m.Run()
+}
+
+type ReminderRepositorySuiteTest struct {
+	suite.Suite
+}
+
+func (s *ReminderRepositorySuiteTest) TearDownTest() {
+	err := setupdbtests.TruncateTables(gormDB)
+	if err != nil {
+		s.Fail("Failed to truncate tables: %v", err)
+	}
+}
+
+func TestReminderRepositorySuite(t *testing.T) {
+	suite.Run(t, new(ReminderRepositorySuiteTest))
+}
+
+// createInstallment stores a client with a phone and a debt with a single
+// installment due on dueDate.
+func (s *ReminderRepositorySuiteTest) createInstallment(ctx context.Context, dueDate time.Time, status string) string {
+	client := &clientGorm.Client{
+		Name:       "Maria",
+		LastName:   "Souza",
+		EntityType: "person",
+		Document:   "12345678909",
+		Email:      "maria@example.com",
+		Phones:     []clientGorm.Phone{{Description: "celular", Number: "71999990000"}},
+	}
+	s.NoError(gormDB.WithContext(ctx).Create(client).Error)
+
+	now := time.Now()
+	installment := debtGorm.Installment{
+		Value:   money.FromCents(10000),
+		DueDate: &dueDate,
+		Status:  status,
+		Number:  1,
+	}
+	debt := &debtGorm.Debt{
+		Description:          "Venda",
+		TotalValue:           money.FromCents(10000),
+		DueDate:              &dueDate,
+		InstallmentsQuantity: 1,
+		UserClientId:         client.ID,
+		Status:               "pending",
+		DebtDate:             &now,
+		InterestPeriod:       "monthly",
+		Schedule:             "monthly",
+		Installments:         []debtGorm.Installment{installment},
+	}
+	s.NoError(gormDB.WithContext(ctx).Create(debt).Error)
+
+	return debt.Installments[0].Id
+}
+
+func (s *ReminderRepositorySuiteTest) TestShouldKeepRulesOfTenant() {
+	repo := NewGormRuleRepository(gormDB)
+	otherTenantCtx := shared.WithTenant(context.Background(), ulid.Make())
+
+	rule, err := reminder.NewRule(reminder.BeforeDue, 3, reminder.Email, "")
+	s.NoError(err)
+	s.NoError(repo.Create(tenantCtx, rule))
+
+	rules, err := repo.Rules(tenantCtx)
+	s.NoError(err)
+	s.Len(rules, 1)
+	s.Equal(rule.Id, rules[0].Id)
+	s.Equal(rule.Template, rules[0].Template)
+
+	rules, err = repo.Rules(otherTenantCtx)
+	s.NoError(err)
+	s.Empty(rules)
+
+	tenants, err := repo.Tenants(context.Background())
+	s.NoError(err)
+	s.Len(tenants, 1)
+
+	deleted, err := repo.Delete(otherTenantCtx, rule.Id)
+	s.NoError(err)
+	s.False(deleted)
+
+	deleted, err = repo.Delete(tenantCtx, rule.Id)
+	s.NoError(err)
+	s.True(deleted)
+}
+
+func (s *ReminderRepositorySuiteTest) TestShouldFindOpenInstallmentsDueUntilDate() {
+	today := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	dueId := s.createInstallment(tenantCtx, today.AddDate(0, 0, 3), "pending")
+	s.createInstallment(tenantCtx, today.AddDate(0, 0, -1), "paid")
+	s.createInstallment(tenantCtx, today.AddDate(0, 0, 10), "pending")
+	s.createInstallment(shared.WithTenant(context.Background(), ulid.Make()), today, "pending")
+
+	reader := NewGormInstallmentReader(gormDB)
+	installments, err := reader.DueInstallments(tenantCtx, today.AddDate(0, 0, 3))
+
+	s.NoError(err)
+	s.Len(installments, 1)
+	s.Equal(dueId, installments[0].Id.String())
+	s.Equal("Maria Souza", installments[0].ClientName)
+	s.Equal("maria@example.com", installments[0].Email)
+	s.Equal("71999990000", installments[0].Phone)
+	s.True(money.FromCents(10000).Equal(installments[0].Value))
+}
+
+func (s *ReminderRepositorySuiteTest) TestShouldTellDoneOnlyForSentOrSkipped() {
+	today := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	installmentId := ulid.Make()
+	ruleId := ulid.Make()
+	sendLog := NewGormSendLog(gormDB)
+
+	failed := &reminder.Send{
+		Id:            ulid.Make(),
+		RuleId:        ruleId,
+		InstallmentId: installmentId,
+		Channel:       reminder.Email,
+		Status:        reminder.Failed,
+		Error:         "smtp unavailable",
+		ReferenceDate: today,
+		AttemptedAt:   time.Now(),
+	}
+	s.NoError(sendLog.Record(tenantCtx, failed))
+
+	done, err := sendLog.Done(tenantCtx, ruleId, installmentId, today)
+	s.NoError(err)
+	s.False(done)
+
+	sent := *failed
+	sent.Id = ulid.Make()
+	sent.Status = reminder.Sent
+	sent.Error = ""
+	s.NoError(sendLog.Record(tenantCtx, &sent))
+
+	done, err = sendLog.Done(tenantCtx, ruleId, installmentId, today)
+	s.NoError(err)
+	s.True(done)
+
+	done, err = sendLog.Done(tenantCtx, ruleId, installmentId, today.AddDate(0, 0, 1))
+	s.NoError(err)
+	s.False(done)
+
+	sends, err := sendLog.Sends(tenantCtx, installmentId)
+	s.NoError(err)
+	s.Len(sends, 2)
+}
@@ -0,0 +1,210 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reminder/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=reminder/repository.go -destination=reminder/mocks/repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	reminder "github.com/henriquerocha2004/quem-me-deve-api/core/reminder"
+	ulid "github.com/oklog/ulid/v2"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRuleRepository is a mock of RuleRepository interface.
+type MockRuleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRuleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRuleRepositoryMockRecorder is the mock recorder for MockRuleRepository.
+type MockRuleRepositoryMockRecorder struct {
+	mock *MockRuleRepository
+}
+
+// NewMockRuleRepository creates a new mock instance.
+func NewMockRuleRepository(ctrl *gomock.Controller) *MockRuleRepository {
+	mock := &MockRuleRepository{ctrl: ctrl}
+	mock.recorder = &MockRuleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRuleRepository) EXPECT() *MockRuleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRuleRepository) Create(ctx context.Context, rule *reminder.Rule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRuleRepositoryMockRecorder) Create(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRuleRepository)(nil).Create), ctx, rule)
+}
+
+// Delete mocks base method.
+func (m *MockRuleRepository) Delete(ctx context.Context, id ulid.ULID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRuleRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRuleRepository)(nil).Delete), ctx, id)
+}
+
+// Rules mocks base method.
+func (m *MockRuleRepository) Rules(ctx context.Context) ([]*reminder.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rules", ctx)
+	ret0, _ := ret[0].([]*reminder.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rules indicates an expected call of Rules.
+func (mr *MockRuleRepositoryMockRecorder) Rules(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rules", reflect.TypeOf((*MockRuleRepository)(nil).Rules), ctx)
+}
+
+// Tenants mocks base method.
+func (m *MockRuleRepository) Tenants(ctx context.Context) ([]ulid.ULID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tenants", ctx)
+	ret0, _ := ret[0].([]ulid.ULID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Tenants indicates an expected call of Tenants.
+func (mr *MockRuleRepositoryMockRecorder) Tenants(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tenants", reflect.TypeOf((*MockRuleRepository)(nil).Tenants), ctx)
+}
+
+// MockInstallmentReader is a mock of InstallmentReader interface.
+type MockInstallmentReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockInstallmentReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockInstallmentReaderMockRecorder is the mock recorder for MockInstallmentReader.
+type MockInstallmentReaderMockRecorder struct {
+	mock *MockInstallmentReader
+}
+
+// NewMockInstallmentReader creates a new mock instance.
+func NewMockInstallmentReader(ctrl *gomock.Controller) *MockInstallmentReader {
+	mock := &MockInstallmentReader{ctrl: ctrl}
+	mock.recorder = &MockInstallmentReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInstallmentReader) EXPECT() *MockInstallmentReaderMockRecorder {
+	return m.recorder
+}
+
+// DueInstallments mocks base method.
+func (m *MockInstallmentReader) DueInstallments(ctx context.Context, until time.Time) ([]*reminder.DueInstallment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DueInstallments", ctx, until)
+	ret0, _ := ret[0].([]*reminder.DueInstallment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DueInstallments indicates an expected call of DueInstallments.
+func (mr *MockInstallmentReaderMockRecorder) DueInstallments(ctx, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DueInstallments", reflect.TypeOf((*MockInstallmentReader)(nil).DueInstallments), ctx, until)
+}
+
+// MockSendLog is a mock of SendLog interface.
+type MockSendLog struct {
+	ctrl     *gomock.Controller
+	recorder *MockSendLogMockRecorder
+	isgomock struct{}
+}
+
+// MockSendLogMockRecorder is the mock recorder for MockSendLog.
+type MockSendLogMockRecorder struct {
+	mock *MockSendLog
+}
+
+// NewMockSendLog creates a new mock instance.
+func NewMockSendLog(ctrl *gomock.Controller) *MockSendLog {
+	mock := &MockSendLog{ctrl: ctrl}
+	mock.recorder = &MockSendLogMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSendLog) EXPECT() *MockSendLogMockRecorder {
+	return m.recorder
+}
+
+// Done mocks base method.
+func (m *MockSendLog) Done(ctx context.Context, ruleId, installmentId ulid.ULID, referenceDate time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Done", ctx, ruleId, installmentId, referenceDate)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Done indicates an expected call of Done.
+func (mr *MockSendLogMockRecorder) Done(ctx, ruleId, installmentId, referenceDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Done", reflect.TypeOf((*MockSendLog)(nil).Done), ctx, ruleId, installmentId, referenceDate)
+}
+
+// Record mocks base method.
+func (m *MockSendLog) Record(ctx context.Context, send *reminder.Send) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, send)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockSendLogMockRecorder) Record(ctx, send any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockSendLog)(nil).Record), ctx, send)
+}
+
+// Sends mocks base method.
+func (m *MockSendLog) Sends(ctx context.Context, installmentId ulid.ULID) ([]*reminder.Send, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sends", ctx, installmentId)
+	ret0, _ := ret[0].([]*reminder.Send)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sends indicates an expected call of Sends.
+func (mr *MockSendLogMockRecorder) Sends(ctx, installmentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sends", reflect.TypeOf((*MockSendLog)(nil).Sends), ctx, installmentId)
+}
@@ -0,0 +1,156 @@
+package reminder
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+)
+
+// Trigger tells when, relative to the due date of an installment, a rule
+// sends its reminder.
+type Trigger string
+
+const (
+	BeforeDue Trigger = "before_due"
+	OnDue     Trigger = "on_due"
+	AfterDue  Trigger = "after_due"
+)
+
+type Channel string
+
+const (
+	Email    Channel = "email"
+	SMS      Channel = "sms"
+	WhatsApp Channel = "whatsapp"
+)
+
+type SendStatus string
+
+const (
+	Sent    SendStatus = "sent"
+	Failed  SendStatus = "failed"
+	Skipped SendStatus = "skipped"
+)
+
+// Rule sends a reminder through a channel, Days before the due date, on the
+// due date, or every Days after it while the installment is still open.
+type Rule struct {
+	Id       ulid.ULID
+	Trigger  Trigger
+	Days     int
+	Channel  Channel
+	Template string
+}
+
+func NewRule(trigger Trigger, days int, channel Channel, template string) (*Rule, error) {
+	rule := &Rule{
+		Id:       ulid.Make(),
+		Trigger:  trigger,
+		Days:     days,
+		Channel:  channel,
+		Template: template,
+	}
+
+	if rule.Template == "" {
+		rule.Template = defaultTemplates[trigger]
+	}
+
+	if err := rule.validate(); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (r *Rule) validate() error {
+	switch r.Trigger {
+	case BeforeDue, AfterDue:
+		if r.Days <= 0 {
+			return errors.New("days must be greater than 0")
+		}
+	case OnDue:
+		if r.Days != 0 {
+			return errors.New("days must be 0 for reminders on the due date")
+		}
+	default:
+		return fmt.Errorf("invalid trigger: %s", r.Trigger)
+	}
+
+	switch r.Channel {
+	case Email, SMS, WhatsApp:
+	default:
+		return fmt.Errorf("invalid channel: %s", r.Channel)
+	}
+
+	return validateTemplate(r.Template)
+}
+
+// Applies reports whether the reminder of an installment due on dueDate is
+// to be sent on the given day.
+func (r *Rule) Applies(dueDate time.Time, today time.Time) bool {
+	daysLate := daysBetween(dueDate, today)
+
+	switch r.Trigger {
+	case BeforeDue:
+		return daysLate == -r.Days
+	case OnDue:
+		return daysLate == 0
+	case AfterDue:
+		return daysLate > 0 && daysLate%r.Days == 0
+	}
+
+	return false
+}
+
+// DueInstallment is an open installment along with what a reminder needs to
+// reach its client.
+type DueInstallment struct {
+	Id         ulid.ULID
+	DebtId     ulid.ULID
+	Number     int
+	Value      money.Money
+	DueDate    time.Time
+	ClientName string
+	Email      string
+	Phone      string
+}
+
+// Recipient returns the address of the client in the channel, empty when the
+// client has none.
+func (i *DueInstallment) Recipient(channel Channel) string {
+	if channel == Email {
+		return i.Email
+	}
+
+	return i.Phone
+}
+
+// Send is an attempt to send the reminder of a rule for an installment. Only
+// one attempt per rule, installment and day is sent or skipped: the failed
+// ones are tried again on the next run.
+type Send struct {
+	Id            ulid.ULID
+	RuleId        ulid.ULID
+	InstallmentId ulid.ULID
+	Channel       Channel
+	Recipient     string
+	Status        SendStatus
+	Error         string
+	ReferenceDate time.Time
+	AttemptedAt   time.Time
+}
+
+func daysBetween(from time.Time, to time.Time) int {
+	fromDay := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDay := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+
+	return int(toDay.Sub(fromDay).Hours() / 24)
+}
+
+// startOfDay keeps only the date of t, as the due dates are kept.
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
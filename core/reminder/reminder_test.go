@@ -0,0 +1,90 @@
+package reminder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldApplyRuleOnItsDays(t *testing.T) {
+	dueDate := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		trigger Trigger
+		days    int
+		today   time.Time
+		applies bool
+	}{
+		{"before due on the day", BeforeDue, 3, dueDate.AddDate(0, 0, -3), true},
+		{"before due on another day", BeforeDue, 3, dueDate.AddDate(0, 0, -2), false},
+		{"on due on the due date", OnDue, 0, dueDate.Add(15 * time.Hour), true},
+		{"on due after the due date", OnDue, 0, dueDate.AddDate(0, 0, 1), false},
+		{"after due on the first period", AfterDue, 5, dueDate.AddDate(0, 0, 5), true},
+		{"after due on a later period", AfterDue, 5, dueDate.AddDate(0, 0, 15), true},
+		{"after due between periods", AfterDue, 5, dueDate.AddDate(0, 0, 7), false},
+		{"after due on the due date", AfterDue, 5, dueDate, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := Rule{Trigger: tt.trigger, Days: tt.days}
+			if got := rule.Applies(dueDate, tt.today); got != tt.applies {
+				t.Errorf("Applies() = %v, want %v", got, tt.applies)
+			}
+		})
+	}
+}
+
+func TestShouldValidateNewRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		trigger  Trigger
+		days     int
+		channel  Channel
+		template string
+		wantErr  bool
+	}{
+		{"before due with days", BeforeDue, 3, Email, "", false},
+		{"before due without days", BeforeDue, 0, Email, "", true},
+		{"on due with days", OnDue, 2, SMS, "", true},
+		{"after due with custom template", AfterDue, 7, WhatsApp, "{{.ClientName}} {{.DaysLate}}", false},
+		{"unknown trigger", Trigger("weekly"), 1, Email, "", true},
+		{"unknown channel", OnDue, 0, Channel("telegram"), "", true},
+		{"unknown template field", OnDue, 0, Email, "{{.Product}}", true},
+		{"malformed template", OnDue, 0, Email, "{{.ClientName", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := NewRule(tt.trigger, tt.days, tt.channel, tt.template)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err == nil && rule.Template == "" {
+				t.Errorf("NewRule() left the template empty")
+			}
+		})
+	}
+}
+
+func TestShouldRenderTemplateWithInstallment(t *testing.T) {
+	installment := &DueInstallment{
+		Id:         ulid.Make(),
+		Number:     2,
+		Value:      money.FromCents(123450),
+		DueDate:    time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+		ClientName: "Maria Souza",
+	}
+
+	rule, err := NewRule(AfterDue, 5, Email, "")
+	assert.NoError(t, err)
+
+	message, err := rule.Render(installment, time.Date(2025, 3, 15, 9, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, "Olá Maria Souza, a parcela 2 no valor de R$ 1.234,50 venceu em 10/03/2025 e está em atraso há 5 dias.", message)
+}
@@ -0,0 +1,30 @@
+package reminder
+
+import (
+	"context"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+type RuleRepository interface {
+	Rules(ctx context.Context) ([]*Rule, error)
+	Create(ctx context.Context, rule *Rule) error
+	Delete(ctx context.Context, id ulid.ULID) (bool, error)
+	// Tenants returns, across every tenant, the ones with reminder rules.
+	Tenants(ctx context.Context) ([]ulid.ULID, error)
+}
+
+type InstallmentReader interface {
+	// DueInstallments returns the open installments of open debts due until
+	// the given date, overdue ones included.
+	DueInstallments(ctx context.Context, until time.Time) ([]*DueInstallment, error)
+}
+
+type SendLog interface {
+	// Done reports whether the reminder of the rule for the installment was
+	// already sent, or skipped, for the reference date.
+	Done(ctx context.Context, ruleId, installmentId ulid.ULID, referenceDate time.Time) (bool, error)
+	Record(ctx context.Context, send *Send) error
+	Sends(ctx context.Context, installmentId ulid.ULID) ([]*Send, error)
+}
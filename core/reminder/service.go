@@ -0,0 +1,266 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/notify"
+	"github.com/oklog/ulid/v2"
+)
+
+type Service interface {
+	Rules(ctx context.Context) shared.ServiceResponse
+	CreateRule(ctx context.Context, dto *RuleDto) shared.ServiceResponse
+	DeleteRule(ctx context.Context, id ulid.ULID) shared.ServiceResponse
+	Sends(ctx context.Context, installmentId ulid.ULID) shared.ServiceResponse
+}
+
+type ReminderService struct {
+	rules        RuleRepository
+	installments InstallmentReader
+	sends        SendLog
+	notifiers    map[Channel]notify.Notifier
+}
+
+func NewReminderService(rules RuleRepository, installments InstallmentReader, sends SendLog) *ReminderService {
+	return &ReminderService{
+		rules:        rules,
+		installments: installments,
+		sends:        sends,
+		notifiers:    make(map[Channel]notify.Notifier),
+	}
+}
+
+// WithNotifier sends the reminders of the channel through the notifier. The
+// reminders of a channel without one are skipped.
+func (s *ReminderService) WithNotifier(channel Channel, notifier notify.Notifier) *ReminderService {
+	s.notifiers[channel] = notifier
+	return s
+}
+
+func (s *ReminderService) Rules(ctx context.Context) shared.ServiceResponse {
+	rules, err := s.rules.Rules(ctx)
+	if err != nil {
+		log.Println("Error finding reminder rules:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in find reminder rules",
+		}
+	}
+
+	rulesDto := []RuleDto{}
+	for _, rule := range rules {
+		rulesDto = append(rulesDto, s.convertToRuleDto(rule))
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "reminder rules found",
+		Data:    rulesDto,
+	}
+}
+
+func (s *ReminderService) CreateRule(ctx context.Context, dto *RuleDto) shared.ServiceResponse {
+	rule, err := NewRule(Trigger(dto.Trigger), dto.Days, Channel(dto.Channel), dto.Template)
+	if err != nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: err.Error(),
+		}
+	}
+
+	if err := s.rules.Create(ctx, rule); err != nil {
+		log.Println("Error creating reminder rule:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in create reminder rule",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "reminder rule created successfully",
+		Data:    s.convertToRuleDto(rule),
+	}
+}
+
+func (s *ReminderService) DeleteRule(ctx context.Context, id ulid.ULID) shared.ServiceResponse {
+	deleted, err := s.rules.Delete(ctx, id)
+	if err != nil {
+		log.Println("Error deleting reminder rule:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in delete reminder rule",
+		}
+	}
+
+	if !deleted {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "reminder rule not found",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "reminder rule deleted successfully",
+	}
+}
+
+func (s *ReminderService) Sends(ctx context.Context, installmentId ulid.ULID) shared.ServiceResponse {
+	sends, err := s.sends.Sends(ctx, installmentId)
+	if err != nil {
+		log.Println("Error finding reminder sends:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in find reminder sends",
+		}
+	}
+
+	sendsDto := []SendDto{}
+	for _, send := range sends {
+		sendsDto = append(sendsDto, SendDto{
+			Id:            send.Id.String(),
+			RuleId:        send.RuleId.String(),
+			Channel:       string(send.Channel),
+			Recipient:     send.Recipient,
+			Status:        string(send.Status),
+			Error:         send.Error,
+			ReferenceDate: send.ReferenceDate.Format(time.DateOnly),
+			AttemptedAt:   send.AttemptedAt.Format(time.DateTime),
+		})
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "reminder sends found",
+		Data:    sendsDto,
+	}
+}
+
+// SendReminders is run by the reminder job. It goes through every account
+// with reminder rules and sends the reminders due on the day of now. A
+// failure on one account does not stop the others.
+func (s *ReminderService) SendReminders(ctx context.Context, now time.Time) error {
+	tenants, err := s.rules.Tenants(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, tenantId := range tenants {
+		if err := s.sendTenantReminders(shared.WithTenant(ctx, tenantId), now); err != nil {
+			log.Println("Error sending reminders of tenant", tenantId, ":", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *ReminderService) sendTenantReminders(ctx context.Context, now time.Time) error {
+	rules, err := s.rules.Rules(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	// The installments due later than the farthest reminder before the due
+	// date have nothing to be sent yet.
+	horizon := 0
+	for _, rule := range rules {
+		if rule.Trigger == BeforeDue {
+			horizon = max(horizon, rule.Days)
+		}
+	}
+
+	installments, err := s.installments.DueInstallments(ctx, startOfDay(now).AddDate(0, 0, horizon))
+	if err != nil {
+		return err
+	}
+
+	for _, installment := range installments {
+		for _, rule := range rules {
+			if !rule.Applies(installment.DueDate, now) {
+				continue
+			}
+
+			if err := s.send(ctx, rule, installment, now); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// send attempts the reminder of the rule for the installment, unless it was
+// already done for the day, and logs the attempt.
+func (s *ReminderService) send(ctx context.Context, rule *Rule, installment *DueInstallment, now time.Time) error {
+	referenceDate := startOfDay(now)
+
+	done, err := s.sends.Done(ctx, rule.Id, installment.Id, referenceDate)
+	if err != nil || done {
+		return err
+	}
+
+	send := &Send{
+		Id:            ulid.Make(),
+		RuleId:        rule.Id,
+		InstallmentId: installment.Id,
+		Channel:       rule.Channel,
+		Recipient:     installment.Recipient(rule.Channel),
+		Status:        Sent,
+		ReferenceDate: referenceDate,
+		AttemptedAt:   now,
+	}
+
+	if err := s.dispatch(ctx, rule, installment, send.Recipient, now); err != nil {
+		send.Status = Failed
+		send.Error = err.Error()
+
+		if errors.Is(err, errNoNotifier) || errors.Is(err, notify.ErrNoRecipient) {
+			send.Status = Skipped
+		}
+	}
+
+	return s.sends.Record(ctx, send)
+}
+
+var errNoNotifier = errors.New("no notifier for the channel")
+
+func (s *ReminderService) dispatch(ctx context.Context, rule *Rule, installment *DueInstallment, recipient string, now time.Time) error {
+	notifier, ok := s.notifiers[rule.Channel]
+	if !ok {
+		return errNoNotifier
+	}
+
+	if recipient == "" {
+		return notify.ErrNoRecipient
+	}
+
+	body, err := rule.Render(installment, now)
+	if err != nil {
+		return err
+	}
+
+	return notifier.Notify(ctx, notify.Message{
+		To:      recipient,
+		Subject: Subject,
+		Body:    body,
+	})
+}
+
+func (s *ReminderService) convertToRuleDto(rule *Rule) RuleDto {
+	return RuleDto{
+		Id:       rule.Id.String(),
+		Trigger:  string(rule.Trigger),
+		Days:     rule.Days,
+		Channel:  string(rule.Channel),
+		Template: rule.Template,
+	}
+}
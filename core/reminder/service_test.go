@@ -0,0 +1,171 @@
+package reminder_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/reminder"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/reminder/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/notify"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func dueInstallment(dueDate time.Time) *reminder.DueInstallment {
+	return &reminder.DueInstallment{
+		Id:         ulid.Make(),
+		DebtId:     ulid.Make(),
+		Number:     1,
+		Value:      money.FromCents(10000),
+		DueDate:    dueDate,
+		ClientName: "Maria Souza",
+		Email:      "maria@example.com",
+	}
+}
+
+func TestReminderService(t *testing.T) {
+	now := time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC)
+	today := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	tenantId := ulid.Make()
+
+	t.Run("should send the reminder of the day through the channel notifier", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rule, _ := reminder.NewRule(reminder.BeforeDue, 3, reminder.Email, "")
+		installment := dueInstallment(today.AddDate(0, 0, 3))
+
+		rules := mocks.NewMockRuleRepository(ctrl)
+		rules.EXPECT().Tenants(gomock.Any()).Return([]ulid.ULID{tenantId}, nil)
+		rules.EXPECT().Rules(gomock.Any()).DoAndReturn(func(ctx context.Context) ([]*reminder.Rule, error) {
+			id, _ := shared.TenantFromContext(ctx)
+			assert.Equal(t, tenantId, id)
+			return []*reminder.Rule{rule}, nil
+		})
+		installments := mocks.NewMockInstallmentReader(ctrl)
+		installments.EXPECT().DueInstallments(gomock.Any(), today.AddDate(0, 0, 3)).Return([]*reminder.DueInstallment{installment}, nil)
+		sends := mocks.NewMockSendLog(ctrl)
+		sends.EXPECT().Done(gomock.Any(), rule.Id, installment.Id, today).Return(false, nil)
+		sends.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, send *reminder.Send) error {
+			assert.Equal(t, reminder.Sent, send.Status)
+			assert.Equal(t, "maria@example.com", send.Recipient)
+			assert.Equal(t, today, send.ReferenceDate)
+			return nil
+		})
+
+		notifier := notify.NewFakeNotifier()
+		service := reminder.NewReminderService(rules, installments, sends).WithNotifier(reminder.Email, notifier)
+
+		err := service.SendReminders(context.Background(), now)
+
+		assert.NoError(t, err)
+		assert.Len(t, notifier.Messages(), 1)
+		assert.Equal(t, "maria@example.com", notifier.Messages()[0].To)
+		assert.Equal(t, reminder.Subject, notifier.Messages()[0].Subject)
+		assert.Contains(t, notifier.Messages()[0].Body, "R$ 100,00")
+	})
+
+	t.Run("should not send again a reminder already sent on the day", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rule, _ := reminder.NewRule(reminder.OnDue, 0, reminder.Email, "")
+		installment := dueInstallment(today)
+
+		rules := mocks.NewMockRuleRepository(ctrl)
+		rules.EXPECT().Tenants(gomock.Any()).Return([]ulid.ULID{tenantId}, nil)
+		rules.EXPECT().Rules(gomock.Any()).Return([]*reminder.Rule{rule}, nil)
+		installments := mocks.NewMockInstallmentReader(ctrl)
+		installments.EXPECT().DueInstallments(gomock.Any(), today).Return([]*reminder.DueInstallment{installment}, nil)
+		sends := mocks.NewMockSendLog(ctrl)
+		sends.EXPECT().Done(gomock.Any(), rule.Id, installment.Id, today).Return(true, nil)
+		sends.EXPECT().Record(gomock.Any(), gomock.Any()).Times(0)
+
+		notifier := notify.NewFakeNotifier()
+		service := reminder.NewReminderService(rules, installments, sends).WithNotifier(reminder.Email, notifier)
+
+		err := service.SendReminders(context.Background(), now)
+
+		assert.NoError(t, err)
+		assert.Empty(t, notifier.Messages())
+	})
+
+	t.Run("should log the failed attempt when the notifier fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rule, _ := reminder.NewRule(reminder.AfterDue, 5, reminder.Email, "")
+		installment := dueInstallment(today.AddDate(0, 0, -10))
+
+		rules := mocks.NewMockRuleRepository(ctrl)
+		rules.EXPECT().Tenants(gomock.Any()).Return([]ulid.ULID{tenantId}, nil)
+		rules.EXPECT().Rules(gomock.Any()).Return([]*reminder.Rule{rule}, nil)
+		installments := mocks.NewMockInstallmentReader(ctrl)
+		installments.EXPECT().DueInstallments(gomock.Any(), today).Return([]*reminder.DueInstallment{installment}, nil)
+		sends := mocks.NewMockSendLog(ctrl)
+		sends.EXPECT().Done(gomock.Any(), rule.Id, installment.Id, today).Return(false, nil)
+		sends.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, send *reminder.Send) error {
+			assert.Equal(t, reminder.Failed, send.Status)
+			assert.Equal(t, "smtp unavailable", send.Error)
+			return nil
+		})
+
+		notifier := notify.NewFakeNotifier()
+		notifier.Fail(errors.New("smtp unavailable"))
+		service := reminder.NewReminderService(rules, installments, sends).WithNotifier(reminder.Email, notifier)
+
+		err := service.SendReminders(context.Background(), now)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should skip the reminder of a client without the channel address", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rule, _ := reminder.NewRule(reminder.OnDue, 0, reminder.SMS, "")
+		installment := dueInstallment(today)
+
+		rules := mocks.NewMockRuleRepository(ctrl)
+		rules.EXPECT().Tenants(gomock.Any()).Return([]ulid.ULID{tenantId}, nil)
+		rules.EXPECT().Rules(gomock.Any()).Return([]*reminder.Rule{rule}, nil)
+		installments := mocks.NewMockInstallmentReader(ctrl)
+		installments.EXPECT().DueInstallments(gomock.Any(), today).Return([]*reminder.DueInstallment{installment}, nil)
+		sends := mocks.NewMockSendLog(ctrl)
+		sends.EXPECT().Done(gomock.Any(), rule.Id, installment.Id, today).Return(false, nil)
+		sends.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, send *reminder.Send) error {
+			assert.Equal(t, reminder.Skipped, send.Status)
+			return nil
+		})
+
+		notifier := notify.NewFakeNotifier()
+		service := reminder.NewReminderService(rules, installments, sends).WithNotifier(reminder.SMS, notifier)
+
+		err := service.SendReminders(context.Background(), now)
+
+		assert.NoError(t, err)
+		assert.Empty(t, notifier.Messages())
+	})
+
+	t.Run("should refuse a rule with an invalid template", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rules := mocks.NewMockRuleRepository(ctrl)
+		rules.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		service := reminder.NewReminderService(rules, mocks.NewMockInstallmentReader(ctrl), mocks.NewMockSendLog(ctrl))
+		response := service.CreateRule(context.Background(), &reminder.RuleDto{
+			Trigger:  "on_due",
+			Channel:  "email",
+			Template: "{{.Unknown}}",
+		})
+
+		assert.Equal(t, "error", response.Status)
+	})
+}
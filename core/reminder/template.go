@@ -0,0 +1,69 @@
+package reminder
+
+import (
+	"errors"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const Subject = "Lembrete de cobrança"
+
+var defaultTemplates = map[Trigger]string{
+	BeforeDue: "Olá {{.ClientName}}, a parcela {{.InstallmentNumber}} no valor de {{.Value}} vence em {{.DueDate}}.",
+	OnDue:     "Olá {{.ClientName}}, a parcela {{.InstallmentNumber}} no valor de {{.Value}} vence hoje, {{.DueDate}}.",
+	AfterDue:  "Olá {{.ClientName}}, a parcela {{.InstallmentNumber}} no valor de {{.Value}} venceu em {{.DueDate}} e está em atraso há {{.DaysLate}} dias.",
+}
+
+// TemplateData is what the message templates of the rules can use.
+type TemplateData struct {
+	ClientName        string
+	InstallmentNumber int
+	Value             string
+	DueDate           string
+	DaysLate          int
+}
+
+func newTemplateData(installment *DueInstallment, today time.Time) TemplateData {
+	return TemplateData{
+		ClientName:        installment.ClientName,
+		InstallmentNumber: installment.Number,
+		Value:             installment.Value.Format(),
+		DueDate:           installment.DueDate.Format("02/01/2006"),
+		DaysLate:          max(daysBetween(installment.DueDate, today), 0),
+	}
+}
+
+// Render writes the message of the rule for the installment.
+func (r *Rule) Render(installment *DueInstallment, today time.Time) (string, error) {
+	tmpl, err := parseTemplate(r.Template)
+	if err != nil {
+		return "", err
+	}
+
+	var message strings.Builder
+	if err := tmpl.Execute(&message, newTemplateData(installment, today)); err != nil {
+		return "", err
+	}
+
+	return message.String(), nil
+}
+
+// validateTemplate parses the template and runs it once, so that a field
+// unknown to TemplateData is refused when the rule is created.
+func validateTemplate(text string) error {
+	tmpl, err := parseTemplate(text)
+	if err != nil {
+		return errors.New("invalid template: " + err.Error())
+	}
+
+	if err := tmpl.Execute(&strings.Builder{}, TemplateData{}); err != nil {
+		return errors.New("invalid template: " + err.Error())
+	}
+
+	return nil
+}
+
+func parseTemplate(text string) (*template.Template, error) {
+	return template.New("reminder").Option("missingkey=error").Parse(text)
+}
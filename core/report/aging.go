@@ -0,0 +1,80 @@
+package report
+
+import (
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+)
+
+// AgingBuckets splits the open amounts of the installments by how many days
+// they are past due, with the fine and interest owed at the reference date.
+// Installments not yet due, or due today, are current.
+type AgingBuckets struct {
+	Current      money.Money
+	Days1To30    money.Money
+	Days31To60   money.Money
+	Days61To90   money.Money
+	Over90       money.Money
+	Installments int
+}
+
+func (b AgingBuckets) Total() money.Money {
+	return b.Current.Add(b.Days1To30).Add(b.Days31To60).Add(b.Days61To90).Add(b.Over90)
+}
+
+// PastDue is the part of the total already past its due date.
+func (b AgingBuckets) PastDue() money.Money {
+	return b.Total().Sub(b.Current)
+}
+
+func (b AgingBuckets) Add(other AgingBuckets) AgingBuckets {
+	return AgingBuckets{
+		Current:      b.Current.Add(other.Current),
+		Days1To30:    b.Days1To30.Add(other.Days1To30),
+		Days31To60:   b.Days31To60.Add(other.Days31To60),
+		Days61To90:   b.Days61To90.Add(other.Days61To90),
+		Over90:       b.Over90.Add(other.Over90),
+		Installments: b.Installments + other.Installments,
+	}
+}
+
+// ClientAging is the aging of the open installments of a client.
+type ClientAging struct {
+	ClientId   ulid.ULID
+	ClientName string
+	AgingBuckets
+}
+
+// AgingFilter narrows the installments of the aging report. The dates are
+// the due dates of the installments, both days included, and the statuses
+// are among the open ones: pending, partially_paid and overdue. Without
+// statuses, every open installment is taken.
+type AgingFilter struct {
+	Reference time.Time
+	From      *time.Time
+	To        *time.Time
+	Statuses  []string
+}
+
+// Aging is the accounts receivable aging report: how much is owed to the
+// account and for how long, overall and per client.
+type Aging struct {
+	Reference time.Time
+	Overall   AgingBuckets
+	Clients   []ClientAging
+}
+
+// NewAging sums up the aging of the clients in the overall one.
+func NewAging(reference time.Time, clients []ClientAging) *Aging {
+	aging := &Aging{
+		Reference: reference,
+		Clients:   clients,
+	}
+
+	for _, client := range clients {
+		aging.Overall = aging.Overall.Add(client.AgingBuckets)
+	}
+
+	return aging
+}
@@ -0,0 +1,114 @@
+package report
+
+import "github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+
+// AgingRequestDto filters the aging report by the due dates of the
+// installments, both days included, and by their status.
+type AgingRequestDto struct {
+	From   string `json:"from" validate:"omitempty,dateFormat:YYYY-MM-DD"`
+	To     string `json:"to" validate:"omitempty,dateFormat:YYYY-MM-DD"`
+	Status string `json:"status" validate:"omitempty,oneof=pending partially_paid overdue"`
+}
+
+type AgingDto struct {
+	ReferenceDate string           `json:"reference_date"`
+	Overall       AgingBucketsDto  `json:"overall"`
+	Clients       []ClientAgingDto `json:"clients"`
+}
+
+type AgingBucketsDto struct {
+	Current      money.Money `json:"current"`
+	Days1To30    money.Money `json:"days_1_30"`
+	Days31To60   money.Money `json:"days_31_60"`
+	Days61To90   money.Money `json:"days_61_90"`
+	Over90       money.Money `json:"days_over_90"`
+	PastDue      money.Money `json:"past_due"`
+	Total        money.Money `json:"total"`
+	Installments int         `json:"installments"`
+}
+
+type ClientAgingDto struct {
+	ClientId   string `json:"client_id"`
+	ClientName string `json:"client_name"`
+	AgingBucketsDto
+}
+
+// ForecastRequestDto asks for the cash flow grouped by day, week or month,
+// reaching the number of months back and ahead of today, optionally
+// discounting the expected inflows by the late payment history of the
+// clients.
+type ForecastRequestDto struct {
+	GroupBy      string `json:"group_by" validate:"omitempty,oneof=day week month"`
+	Months       int    `json:"months" validate:"min=0,max=24"`
+	RiskAdjusted bool   `json:"risk_adjusted"`
+}
+
+type ForecastDto struct {
+	GroupBy       string              `json:"group_by"`
+	ReferenceDate string              `json:"reference_date"`
+	From          string              `json:"from"`
+	To            string              `json:"to"`
+	RiskAdjusted  bool                `json:"risk_adjusted"`
+	Totals        CashFlowPeriodDto   `json:"totals"`
+	Periods       []CashFlowPeriodDto `json:"periods"`
+}
+
+type CashFlowPeriodDto struct {
+	Start        string       `json:"start"`
+	End          string       `json:"end"`
+	Realized     money.Money  `json:"realized"`
+	Expected     money.Money  `json:"expected"`
+	RiskAdjusted *money.Money `json:"risk_adjusted,omitempty"`
+}
+
+// SalesRequestDto filters the sales report by the days of the sales, both
+// included, the client, the status of the debts and the payment method,
+// grouping the sales by day, week or month.
+type SalesRequestDto struct {
+	From          string `json:"from" validate:"omitempty,dateFormat:YYYY-MM-DD"`
+	To            string `json:"to" validate:"omitempty,dateFormat:YYYY-MM-DD"`
+	ClientId      string `json:"client_id" validate:"omitempty,ulid"`
+	Status        string `json:"status" validate:"omitempty,oneof=pending paid canceled reversed partially_paid renegotiated overdue"`
+	PaymentMethod string `json:"payment_method" validate:"omitempty,max=30"`
+	GroupBy       string `json:"group_by" validate:"omitempty,oneof=day week month"`
+}
+
+type SalesDto struct {
+	GroupBy     string            `json:"group_by"`
+	From        string            `json:"from,omitempty"`
+	To          string            `json:"to,omitempty"`
+	Totals      SalesTotalsDto    `json:"totals"`
+	Periods     []SalesPeriodDto  `json:"periods"`
+	TopClients  []ClientSalesDto  `json:"top_clients"`
+	TopProducts []ProductSalesDto `json:"top_products"`
+}
+
+type SalesTotalsDto struct {
+	Sales         int         `json:"sales"`
+	Sold          money.Money `json:"sold"`
+	Received      money.Money `json:"received"`
+	Canceled      money.Money `json:"canceled"`
+	Reversed      money.Money `json:"reversed"`
+	AverageTicket money.Money `json:"average_ticket"`
+}
+
+type SalesPeriodDto struct {
+	Start string `json:"start"`
+	SalesTotalsDto
+}
+
+type ClientSalesDto struct {
+	ClientId      string      `json:"client_id"`
+	ClientName    string      `json:"client_name"`
+	Sales         int         `json:"sales"`
+	Sold          money.Money `json:"sold"`
+	Received      money.Money `json:"received"`
+	AverageTicket money.Money `json:"average_ticket"`
+}
+
+type ProductSalesDto struct {
+	ProductId   string      `json:"product_id"`
+	Description string      `json:"description"`
+	Quantity    int         `json:"quantity"`
+	Total       money.Money `json:"total"`
+}
@@ -0,0 +1,170 @@
+package report
+
+import (
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+)
+
+// Granularity is the length of the periods the cash flow is grouped by.
+type Granularity string
+
+const (
+	Day   Granularity = "day"
+	Week  Granularity = "week"
+	Month Granularity = "month"
+)
+
+// Start is the first day of the period containing the date. Weeks start on
+// Monday, as they do in the database.
+func (g Granularity) Start(date time.Time) time.Time {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+
+	switch g {
+	case Week:
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case Month:
+		return day.AddDate(0, 0, 1-day.Day())
+	}
+
+	return day
+}
+
+// Next is the start of the period following the one starting at start.
+func (g Granularity) Next(start time.Time) time.Time {
+	switch g {
+	case Week:
+		return start.AddDate(0, 0, 7)
+	case Month:
+		return start.AddDate(0, 1, 0)
+	}
+
+	return start.AddDate(0, 0, 1)
+}
+
+// Inflow is an amount received, or expected to be, in the period starting
+// at Period.
+type Inflow struct {
+	Period time.Time
+	Amount money.Money
+}
+
+// ClientInflow is the inflow expected from a client in a period.
+type ClientInflow struct {
+	ClientId ulid.ULID
+	Inflow
+}
+
+// InflowFilter selects the inflows from the day From, included, to the day
+// To, excluded, grouped by GroupBy.
+type InflowFilter struct {
+	GroupBy Granularity
+	From    time.Time
+	To      time.Time
+}
+
+// PaymentHistory counts the installments of a client already due and how
+// many of them were not paid by their due date.
+type PaymentHistory struct {
+	ClientId     ulid.ULID
+	Installments int
+	Late         int
+}
+
+// LateRatio is the share of the installments of the client paid late, or
+// not paid at all.
+func (h PaymentHistory) LateRatio() float64 {
+	if h.Installments == 0 {
+		return 0
+	}
+
+	return float64(h.Late) / float64(h.Installments)
+}
+
+// CashFlowPeriod is a period of the cash flow with what was received in it
+// and what is still expected to be.
+type CashFlowPeriod struct {
+	Start        time.Time
+	End          time.Time
+	Realized     money.Money
+	Expected     money.Money
+	RiskAdjusted money.Money
+}
+
+// Forecast is the cash flow of the account, received in the past periods
+// and expected in the next ones, from the due dates of the open
+// installments.
+type Forecast struct {
+	GroupBy      Granularity
+	Reference    time.Time
+	From         time.Time
+	To           time.Time
+	RiskAdjusted bool
+	Periods      []CashFlowPeriod
+}
+
+// ForecastWindow is the range of whole periods reaching months back and
+// ahead of the reference day.
+func ForecastWindow(groupBy Granularity, reference time.Time, months int) (time.Time, time.Time) {
+	from := groupBy.Start(reference.AddDate(0, -months, 0))
+	to := groupBy.Next(groupBy.Start(reference.AddDate(0, months, 0)))
+
+	return from, to
+}
+
+// NewForecast spreads the inflows over the periods of the window. With the
+// late ratios of the clients, the expected inflows are also discounted by
+// the chance of each client not paying on time.
+func NewForecast(groupBy Granularity, reference time.Time, months int, realized []Inflow, expected []ClientInflow, lateRatios map[ulid.ULID]float64) *Forecast {
+	from, to := ForecastWindow(groupBy, reference, months)
+	forecast := &Forecast{
+		GroupBy:      groupBy,
+		Reference:    reference,
+		From:         from,
+		To:           to,
+		RiskAdjusted: lateRatios != nil,
+	}
+
+	index := map[time.Time]int{}
+	for start := from; start.Before(to); start = groupBy.Next(start) {
+		index[start] = len(forecast.Periods)
+		forecast.Periods = append(forecast.Periods, CashFlowPeriod{
+			Start: start,
+			End:   groupBy.Next(start).AddDate(0, 0, -1),
+		})
+	}
+
+	for _, inflow := range realized {
+		if i, ok := index[groupBy.Start(inflow.Period)]; ok {
+			forecast.Periods[i].Realized = forecast.Periods[i].Realized.Add(inflow.Amount)
+		}
+	}
+
+	for _, inflow := range expected {
+		i, ok := index[groupBy.Start(inflow.Period)]
+		if !ok {
+			continue
+		}
+
+		period := &forecast.Periods[i]
+		period.Expected = period.Expected.Add(inflow.Amount)
+		if forecast.RiskAdjusted {
+			period.RiskAdjusted = period.RiskAdjusted.Add(inflow.Amount.Percent((1 - lateRatios[inflow.ClientId]) * 100))
+		}
+	}
+
+	return forecast
+}
+
+// Totals adds up the periods of the forecast.
+func (f *Forecast) Totals() CashFlowPeriod {
+	totals := CashFlowPeriod{Start: f.From, End: f.To.AddDate(0, 0, -1)}
+	for _, period := range f.Periods {
+		totals.Realized = totals.Realized.Add(period.Realized)
+		totals.Expected = totals.Expected.Add(period.Expected)
+		totals.RiskAdjusted = totals.RiskAdjusted.Add(period.RiskAdjusted)
+	}
+
+	return totals
+}
@@ -0,0 +1,92 @@
+package report_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/report"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
+
+func TestGranularity(t *testing.T) {
+	t.Run("Deve iniciar a semana na segunda-feira e o mês no dia primeiro", func(t *testing.T) {
+		wednesday := time.Date(2026, 6, 17, 15, 30, 0, 0, time.Local)
+
+		assert.Equal(t, date(2026, 6, 17), report.Day.Start(wednesday))
+		assert.Equal(t, date(2026, 6, 15), report.Week.Start(wednesday))
+		assert.Equal(t, date(2026, 6, 15), report.Week.Start(date(2026, 6, 21)))
+		assert.Equal(t, date(2026, 6, 1), report.Month.Start(wednesday))
+
+		assert.Equal(t, date(2026, 6, 18), report.Day.Next(date(2026, 6, 17)))
+		assert.Equal(t, date(2026, 6, 22), report.Week.Next(date(2026, 6, 15)))
+		assert.Equal(t, date(2026, 7, 1), report.Month.Next(date(2026, 6, 1)))
+	})
+}
+
+func TestForecast(t *testing.T) {
+	reference := time.Date(2026, 6, 17, 15, 30, 0, 0, time.Local)
+
+	t.Run("Deve agrupar os recebimentos realizados e previstos por mês", func(t *testing.T) {
+		joao, maria := ulid.Make(), ulid.Make()
+
+		forecast := report.NewForecast(report.Month, reference, 2, []report.Inflow{
+			{Period: date(2026, 4, 1), Amount: money.FromCents(10000)},
+			{Period: date(2026, 6, 1), Amount: money.FromCents(2500)},
+		}, []report.ClientInflow{
+			{ClientId: joao, Inflow: report.Inflow{Period: date(2026, 6, 1), Amount: money.FromCents(4000)}},
+			{ClientId: maria, Inflow: report.Inflow{Period: date(2026, 8, 1), Amount: money.FromCents(6000)}},
+			{ClientId: joao, Inflow: report.Inflow{Period: date(2026, 8, 1), Amount: money.FromCents(1000)}},
+		}, nil)
+
+		assert.Equal(t, date(2026, 4, 1), forecast.From)
+		assert.Equal(t, date(2026, 9, 1), forecast.To)
+		assert.False(t, forecast.RiskAdjusted)
+		assert.Len(t, forecast.Periods, 5)
+
+		assert.Equal(t, date(2026, 4, 30), forecast.Periods[0].End)
+		assert.Equal(t, money.FromCents(10000), forecast.Periods[0].Realized)
+		assert.Equal(t, money.FromCents(2500), forecast.Periods[2].Realized)
+		assert.Equal(t, money.FromCents(4000), forecast.Periods[2].Expected)
+		assert.True(t, forecast.Periods[3].Expected.IsZero())
+		assert.Equal(t, money.FromCents(7000), forecast.Periods[4].Expected)
+		assert.True(t, forecast.Periods[4].RiskAdjusted.IsZero())
+
+		totals := forecast.Totals()
+		assert.Equal(t, money.FromCents(12500), totals.Realized)
+		assert.Equal(t, money.FromCents(11000), totals.Expected)
+		assert.Equal(t, date(2026, 8, 31), totals.End)
+	})
+
+	t.Run("Deve descontar o previsto pelo histórico de atrasos do cliente", func(t *testing.T) {
+		joao, maria := ulid.Make(), ulid.Make()
+		history := report.PaymentHistory{ClientId: joao, Installments: 4, Late: 1}
+
+		forecast := report.NewForecast(report.Week, reference, 1, nil, []report.ClientInflow{
+			{ClientId: joao, Inflow: report.Inflow{Period: date(2026, 6, 22), Amount: money.FromCents(10000)}},
+			{ClientId: maria, Inflow: report.Inflow{Period: date(2026, 6, 22), Amount: money.FromCents(5000)}},
+		}, map[ulid.ULID]float64{joao: history.LateRatio()})
+
+		assert.True(t, forecast.RiskAdjusted)
+		assert.Equal(t, date(2026, 5, 11), forecast.From)
+		assert.Equal(t, date(2026, 7, 20), forecast.To)
+
+		for _, period := range forecast.Periods {
+			if period.Start.Equal(date(2026, 6, 22)) {
+				assert.Equal(t, money.FromCents(15000), period.Expected)
+				assert.Equal(t, money.FromCents(12500), period.RiskAdjusted)
+			}
+		}
+		assert.Equal(t, money.FromCents(12500), forecast.Totals().RiskAdjusted)
+	})
+
+	t.Run("Deve considerar sem atrasos o cliente sem histórico", func(t *testing.T) {
+		assert.Zero(t, report.PaymentHistory{}.LateRatio())
+		assert.Equal(t, 0.5, report.PaymentHistory{Installments: 2, Late: 1}.LateRatio())
+	})
+}
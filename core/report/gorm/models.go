@@ -0,0 +1,70 @@
+package gorm
+
+import (
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+)
+
+// reportInstallment maps the installments with the tenant column, so that
+// the reports only add up the installments of the account.
+type reportInstallment struct {
+	ID       string `gorm:"column:id"`
+	TenantID string `gorm:"column:tenant_id"`
+}
+
+func (d *reportInstallment) TableName() string {
+	return "installments"
+}
+
+type agingRow struct {
+	ClientID     string      `gorm:"column:client_id"`
+	ClientName   string      `gorm:"column:client_name"`
+	LastName     string      `gorm:"column:last_name"`
+	Current      money.Money `gorm:"column:current_amount"`
+	Days1To30    money.Money `gorm:"column:days_1_30"`
+	Days31To60   money.Money `gorm:"column:days_31_60"`
+	Days61To90   money.Money `gorm:"column:days_61_90"`
+	Over90       money.Money `gorm:"column:days_over_90"`
+	Installments int         `gorm:"column:installments"`
+}
+
+type inflowRow struct {
+	Period   string      `gorm:"column:period"`
+	ClientID string      `gorm:"column:client_id"`
+	Amount   money.Money `gorm:"column:amount"`
+}
+
+type historyRow struct {
+	ClientID     string `gorm:"column:client_id"`
+	Installments int    `gorm:"column:installments"`
+	Late         int    `gorm:"column:late"`
+}
+
+// reportDebt maps the debts with the tenant column, so that the sales
+// reports only add up the debts of the account.
+type reportDebt struct {
+	ID       string `gorm:"column:id"`
+	TenantID string `gorm:"column:tenant_id"`
+}
+
+func (d *reportDebt) TableName() string {
+	return "debts"
+}
+
+type salesRow struct {
+	Period     string      `gorm:"column:period"`
+	ClientID   string      `gorm:"column:client_id"`
+	ClientName string      `gorm:"column:client_name"`
+	LastName   string      `gorm:"column:last_name"`
+	Sales      int         `gorm:"column:sales"`
+	Sold       money.Money `gorm:"column:sold"`
+	Received   money.Money `gorm:"column:received"`
+	Canceled   money.Money `gorm:"column:canceled"`
+	Reversed   money.Money `gorm:"column:reversed"`
+}
+
+type productSalesRow struct {
+	ProductID   string      `gorm:"column:product_id"`
+	Description string      `gorm:"column:description"`
+	Quantity    int         `gorm:"column:quantity"`
+	Total       money.Money `gorm:"column:total"`
+}
@@ -0,0 +1,372 @@
+package gorm
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/report"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type GormReportRepository struct {
+	db *gorm.DB
+}
+
+func NewGormReportRepository(db *gorm.DB) *GormReportRepository {
+	return &GormReportRepository{db: db}
+}
+
+// owedAmount joins each installment with what is still owed on it and how
+// many days it is past due at the reference day. Past the due date, the
+// fine and the interest of the debt are added as ChargePolicy.Calculate
+// does: a fine over the value and simple interest per day late, a monthly
+// rate spread over 30 days. It takes the monthly interest period and the
+// reference day, and must follow the join of the debts.
+const owedAmount = `CROSS JOIN LATERAL (
+	SELECT late.days_late, installments.value - COALESCE((
+		SELECT SUM(installment_payments.amount) FROM installment_payments
+		WHERE installment_payments.installment_id = installments.id
+	), 0) + CASE WHEN late.days_late > 0 THEN
+		ROUND(installments.value * debts.fine_percentage / 100, 2) +
+		ROUND(installments.value * debts.interest_rate * late.days_late / 100 /
+			CASE WHEN debts.interest_period = ? THEN 30 ELSE 1 END, 2)
+	ELSE 0 END AS amount
+	FROM (SELECT CAST(? AS date) - CAST(installments.due_date AS date) AS days_late) AS late
+) AS owed`
+
+// AgingByClient adds up the open amounts in a single query, fine and
+// interest included, bucketed by the days past due. Installments without a
+// due date are current.
+func (g *GormReportRepository) AgingByClient(ctx context.Context, filter report.AgingFilter) ([]report.ClientAging, error) {
+	statuses := filter.Statuses
+	if len(statuses) == 0 {
+		statuses = openInstallmentStatus()
+	}
+
+	query := g.db.WithContext(ctx).Model(&reportInstallment{}).
+		Select(`debts.user_client_id AS client_id,
+			MAX(clients.name) AS client_name, MAX(clients.last_name) AS last_name,
+			COALESCE(SUM(owed.amount) FILTER (WHERE owed.days_late IS NULL OR owed.days_late <= 0), 0) AS current_amount,
+			COALESCE(SUM(owed.amount) FILTER (WHERE owed.days_late BETWEEN 1 AND 30), 0) AS days_1_30,
+			COALESCE(SUM(owed.amount) FILTER (WHERE owed.days_late BETWEEN 31 AND 60), 0) AS days_31_60,
+			COALESCE(SUM(owed.amount) FILTER (WHERE owed.days_late BETWEEN 61 AND 90), 0) AS days_61_90,
+			COALESCE(SUM(owed.amount) FILTER (WHERE owed.days_late > 90), 0) AS days_over_90,
+			COUNT(*) AS installments`).
+		Joins("JOIN debts ON debts.id = installments.debt_id").
+		Joins("LEFT JOIN clients ON clients.id = debts.user_client_id").
+		Joins(owedAmount, debt.MonthlyInterest.String(), filter.Reference.Format(time.DateOnly)).
+		Where("installments.status IN ? AND debts.status IN ? AND owed.amount > 0",
+			statuses, openDebtStatus())
+
+	query = dueDateBetween(query, filter.From, filter.To)
+
+	var rows []agingRow
+	err := query.
+		Group("debts.user_client_id").
+		Order("SUM(owed.amount) DESC, debts.user_client_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var clients []report.ClientAging
+	for _, row := range rows {
+		clients = append(clients, report.ClientAging{
+			ClientId:   ulid.MustParse(row.ClientID),
+			ClientName: strings.TrimSpace(row.ClientName + " " + row.LastName),
+			AgingBuckets: report.AgingBuckets{
+				Current:      row.Current,
+				Days1To30:    row.Days1To30,
+				Days31To60:   row.Days31To60,
+				Days61To90:   row.Days61To90,
+				Over90:       row.Over90,
+				Installments: row.Installments,
+			},
+		})
+	}
+
+	return clients, nil
+}
+
+// RealizedInflows adds up the payments received in each period, leaving
+// out the ones settled from the credit of the client, which was received
+// before, and the ones of reversed debts, which were given back.
+func (g *GormReportRepository) RealizedInflows(ctx context.Context, filter report.InflowFilter) ([]report.Inflow, error) {
+	var rows []inflowRow
+	err := g.db.WithContext(ctx).Model(&reportInstallment{}).
+		Select(`to_char(date_trunc(?, installment_payments.payment_date), 'YYYY-MM-DD') AS period,
+			SUM(installment_payments.amount) AS amount`, string(filter.GroupBy)).
+		Joins("JOIN installment_payments ON installment_payments.installment_id = installments.id").
+		Joins("JOIN debts ON debts.id = installments.debt_id").
+		Where("installment_payments.payment_date >= CAST(? AS date) AND installment_payments.payment_date < CAST(? AS date)",
+			filter.From.Format(time.DateOnly), filter.To.Format(time.DateOnly)).
+		Where("installment_payments.payment_method <> ? AND debts.status <> ?",
+			debt.CreditPaymentMethod, debt.Reversed.String()).
+		Group("period").
+		Order("period").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var inflows []report.Inflow
+	for _, row := range rows {
+		inflows = append(inflows, report.Inflow{
+			Period: parsePeriod(row.Period),
+			Amount: row.Amount,
+		})
+	}
+
+	return inflows, nil
+}
+
+// ExpectedInflows adds up what is still owed on the open installments due
+// in each period, per client. None of them is late at the start of the
+// window, so no late charges are added.
+func (g *GormReportRepository) ExpectedInflows(ctx context.Context, filter report.InflowFilter) ([]report.ClientInflow, error) {
+	var rows []inflowRow
+	err := g.db.WithContext(ctx).Model(&reportInstallment{}).
+		Select(`to_char(date_trunc(?, installments.due_date), 'YYYY-MM-DD') AS period,
+			debts.user_client_id AS client_id, SUM(owed.amount) AS amount`, string(filter.GroupBy)).
+		Joins("JOIN debts ON debts.id = installments.debt_id").
+		Joins(owedAmount, debt.MonthlyInterest.String(), filter.From.Format(time.DateOnly)).
+		Where("installments.status IN ? AND debts.status IN ? AND owed.amount > 0",
+			openInstallmentStatus(), openDebtStatus()).
+		Where("installments.due_date >= CAST(? AS date) AND installments.due_date < CAST(? AS date)",
+			filter.From.Format(time.DateOnly), filter.To.Format(time.DateOnly)).
+		Group("period, debts.user_client_id").
+		Order("period, debts.user_client_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var inflows []report.ClientInflow
+	for _, row := range rows {
+		inflows = append(inflows, report.ClientInflow{
+			ClientId: ulid.MustParse(row.ClientID),
+			Inflow: report.Inflow{
+				Period: parsePeriod(row.Period),
+				Amount: row.Amount,
+			},
+		})
+	}
+
+	return inflows, nil
+}
+
+// PaymentHistories counts, per client, the installments due before the
+// reference day and the late ones: paid after the due date, or not paid
+// yet. Installments of canceled and reversed debts are left out.
+func (g *GormReportRepository) PaymentHistories(ctx context.Context, reference time.Time) ([]report.PaymentHistory, error) {
+	var rows []historyRow
+	err := g.db.WithContext(ctx).Model(&reportInstallment{}).
+		Select(`debts.user_client_id AS client_id, COUNT(*) AS installments,
+			COUNT(*) FILTER (WHERE installments.payment_date IS NULL
+				OR CAST(installments.payment_date AS date) > CAST(installments.due_date AS date)) AS late`).
+		Joins("JOIN debts ON debts.id = installments.debt_id").
+		Where("installments.due_date < CAST(? AS date) AND installments.status <> ? AND debts.status NOT IN ?",
+			reference.Format(time.DateOnly), debt.Canceled.String(), closedDebtStatus()).
+		Group("debts.user_client_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var histories []report.PaymentHistory
+	for _, row := range rows {
+		histories = append(histories, report.PaymentHistory{
+			ClientId:     ulid.MustParse(row.ClientID),
+			Installments: row.Installments,
+			Late:         row.Late,
+		})
+	}
+
+	return histories, nil
+}
+
+// receivedAmount joins each debt with what was received on its
+// installments, only by the payment method when one is given.
+const receivedAmount = `CROSS JOIN LATERAL (
+	SELECT COALESCE(SUM(installment_payments.amount), 0) AS amount FROM installment_payments
+	JOIN installments ON installments.id = installment_payments.installment_id
+	WHERE installments.debt_id = debts.id
+	AND (CAST(? AS text) = '' OR installment_payments.payment_method = ?)
+) AS received`
+
+// SalesByPeriod adds up the sales in a single query, grouped by the period
+// of the day they were made.
+func (g *GormReportRepository) SalesByPeriod(ctx context.Context, filter report.SalesFilter) ([]report.SalesPeriod, error) {
+	query := g.db.WithContext(ctx).Model(&reportDebt{}).
+		Select(`to_char(date_trunc(?, debts.debt_date), 'YYYY-MM-DD') AS period,
+			COUNT(*) AS sales, SUM(debts.total_value) AS sold, SUM(received.amount) AS received,
+			COALESCE(SUM(debts.total_value) FILTER (WHERE debts.status = ?), 0) AS canceled,
+			COALESCE(SUM(debts.total_value) FILTER (WHERE debts.status = ?), 0) AS reversed`,
+			string(filter.GroupBy), debt.Canceled.String(), debt.Reversed.String()).
+		Joins(receivedAmount, filter.PaymentMethod, filter.PaymentMethod)
+
+	var rows []salesRow
+	err := salesOf(query, filter).
+		Group("period").
+		Order("period").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var periods []report.SalesPeriod
+	for _, row := range rows {
+		periods = append(periods, report.SalesPeriod{
+			Start: parsePeriod(row.Period),
+			SalesTotals: report.SalesTotals{
+				Sales:    row.Sales,
+				Sold:     row.Sold,
+				Received: row.Received,
+				Canceled: row.Canceled,
+				Reversed: row.Reversed,
+			},
+		})
+	}
+
+	return periods, nil
+}
+
+// TopClients ranks the clients by the value of their sales.
+func (g *GormReportRepository) TopClients(ctx context.Context, filter report.SalesFilter) ([]report.ClientSales, error) {
+	query := g.db.WithContext(ctx).Model(&reportDebt{}).
+		Select(`debts.user_client_id AS client_id,
+			MAX(clients.name) AS client_name, MAX(clients.last_name) AS last_name,
+			COUNT(*) AS sales, SUM(debts.total_value) AS sold, SUM(received.amount) AS received`).
+		Joins("LEFT JOIN clients ON clients.id = debts.user_client_id").
+		Joins(receivedAmount, filter.PaymentMethod, filter.PaymentMethod).
+		Where("debts.status NOT IN ?", closedDebtStatus())
+
+	var rows []salesRow
+	err := salesOf(query, filter).
+		Group("debts.user_client_id").
+		Order("SUM(debts.total_value) DESC, debts.user_client_id").
+		Limit(filter.Limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var clients []report.ClientSales
+	for _, row := range rows {
+		clients = append(clients, report.ClientSales{
+			ClientId:   ulid.MustParse(row.ClientID),
+			ClientName: strings.TrimSpace(row.ClientName + " " + row.LastName),
+			SalesTotals: report.SalesTotals{
+				Sales:    row.Sales,
+				Sold:     row.Sold,
+				Received: row.Received,
+			},
+		})
+	}
+
+	return clients, nil
+}
+
+// TopProducts ranks the products by the value sold of them, from the items
+// of the debts. The description is the one of the latest sale.
+func (g *GormReportRepository) TopProducts(ctx context.Context, filter report.SalesFilter) ([]report.ProductSales, error) {
+	query := g.db.WithContext(ctx).Model(&reportDebt{}).
+		Select(`debt_items.reference_id AS product_id,
+			(ARRAY_AGG(debt_items.description ORDER BY debts.debt_date DESC))[1] AS description,
+			SUM(debt_items.quantity) AS quantity, SUM(debt_items.total) AS total`).
+		Joins("JOIN debt_items ON debt_items.debt_id = debts.id").
+		Where("debt_items.item_type = ? AND debts.status NOT IN ?", debt.ProductItem.String(), closedDebtStatus())
+
+	var rows []productSalesRow
+	err := salesOf(query, filter).
+		Group("debt_items.reference_id").
+		Order("SUM(debt_items.total) DESC, debt_items.reference_id").
+		Limit(filter.Limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var products []report.ProductSales
+	for _, row := range rows {
+		products = append(products, report.ProductSales{
+			ProductId:   ulid.MustParse(row.ProductID),
+			Description: row.Description,
+			Quantity:    row.Quantity,
+			Total:       row.Total,
+		})
+	}
+
+	return products, nil
+}
+
+// salesOf keeps the debts matching the filter. The debts made by
+// renegotiating others are left out, as their value was already sold in
+// the renegotiated ones.
+func salesOf(query *gorm.DB, filter report.SalesFilter) *gorm.DB {
+	query = query.Where(`NOT EXISTS (
+		SELECT 1 FROM renegotiation_info WHERE renegotiation_info.renegotiated_debt_id = debts.id
+	)`)
+
+	if filter.From != nil {
+		query = query.Where("debts.debt_date >= CAST(? AS date)", filter.From.Format(time.DateOnly))
+	}
+
+	if filter.To != nil {
+		query = query.Where("debts.debt_date < CAST(? AS date) + 1", filter.To.Format(time.DateOnly))
+	}
+
+	if filter.ClientId != nil {
+		query = query.Where("debts.user_client_id = ?", filter.ClientId.String())
+	}
+
+	if filter.Status != "" {
+		query = query.Where("debts.status = ?", filter.Status)
+	}
+
+	if filter.PaymentMethod != "" {
+		query = query.Where(`EXISTS (
+			SELECT 1 FROM installment_payments
+			JOIN installments ON installments.id = installment_payments.installment_id
+			WHERE installments.debt_id = debts.id AND installment_payments.payment_method = ?
+		)`, filter.PaymentMethod)
+	}
+
+	return query
+}
+
+// parsePeriod reads the first day of a period, as grouped by the database,
+// in the time zone of the server.
+func parsePeriod(day string) time.Time {
+	period, _ := time.ParseInLocation(time.DateOnly, day, time.Local)
+	return period
+}
+
+// dueDateBetween keeps the installments due between the days, both
+// included.
+func dueDateBetween(query *gorm.DB, from, to *time.Time) *gorm.DB {
+	if from != nil {
+		query = query.Where("installments.due_date >= CAST(? AS date)", from.Format(time.DateOnly))
+	}
+
+	if to != nil {
+		query = query.Where("installments.due_date < CAST(? AS date) + 1", to.Format(time.DateOnly))
+	}
+
+	return query
+}
+
+func openInstallmentStatus() []string {
+	return []string{debt.Pending.String(), debt.PartiallyPaid.String(), debt.Overdue.String()}
+}
+
+func openDebtStatus() []string {
+	return []string{debt.Pending.String(), debt.Overdue.String()}
+}
+
+func closedDebtStatus() []string {
+	return []string{debt.Canceled.String(), debt.Reversed.String()}
+}
@@ -0,0 +1,463 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	setupdbtests "github.com/henriquerocha2004/quem-me-deve-api/config/setupDbTests"
+	clientGorm "github.com/henriquerocha2004/quem-me-deve-api/core/client/gorm"
+	debtGorm "github.com/henriquerocha2004/quem-me-deve-api/core/debt/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/report"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	ormdb "github.com/henriquerocha2004/quem-me-deve-api/core/shared/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/helpers"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/joho/godotenv"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/suite"
+	orm "gorm.io/gorm"
+)
+
+var gormDB *orm.DB = nil
+
+// tenantCtx scopes the tests to a single tenant, as the requests are.
+var tenantCtx = shared.WithTenant(context.Background(), ulid.Make())
+
+// reference is the day the reports are computed at.
+var reference = time.Date(2026, 6, 15, 0, 0, 0, 0, time.Local)
+
+func TestMain(m *testing.M) {
+	envPath := helpers.ProjetctRoot() + ".env.testing"
+	err := godotenv.Overload(envPath)
+	if err != nil {
+		log.Println(err)
+		panic("Error loading .env file")
+	}
+
+	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
+		os.Getenv("DB_USER"),
+		os.Getenv("DB_PASSWORD"),
+		os.Getenv("DB_HOST"),
+		os.Getenv("DB_PORT"),
+		os.Getenv("DB_NAME"),
+	)
+
+	gormDB, err = ormdb.NewGorm(dsn)
+	if err != nil {
+		log.Println(err)
+		panic(err)
+	}
+	sql, err := gormDB.DB()
+	if err != nil {
+		log.Println(err)
+		panic(err)
+	}
+
+	sql.SetMaxIdleConns(10)
+	sql.SetMaxOpenConns(100)
+	sql.SetConnMaxLifetime(30 * time.Minute)
+
+	defer sql.Close()
+	m.Run()
+}
+
+type ReportRepositorySuiteTest struct {
+	suite.Suite
+}
+
+func (s *ReportRepositorySuiteTest) TearDownTest() {
+	err := setupdbtests.TruncateTables(gormDB)
+	if err != nil {
+		s.Fail("Failed to truncate tables: %v", err)
+	}
+}
+
+func TestReportRepositorySuite(t *testing.T) {
+	suite.Run(t, new(ReportRepositorySuiteTest))
+}
+
+// daysFromReference is the day the given number of days after the
+// reference, before it when negative.
+func daysFromReference(days int) *time.Time {
+	date := reference.AddDate(0, 0, days)
+	return &date
+}
+
+// installment is a pending installment of the value due the given number of
+// days after the reference.
+func installment(cents int64, dueIn int) debtGorm.Installment {
+	return debtGorm.Installment{
+		Value:   money.FromCents(cents),
+		DueDate: daysFromReference(dueIn),
+		Status:  "pending",
+	}
+}
+
+// createClient stores a client with the name, returning their id.
+func (s *ReportRepositorySuiteTest) createClient(ctx context.Context, name string) string {
+	client := &clientGorm.Client{
+		Name:       name,
+		LastName:   "Silva",
+		EntityType: "person",
+		Document:   "12345678909",
+		Email:      "cliente@example.com",
+	}
+	s.NoError(gormDB.WithContext(ctx).Create(client).Error)
+
+	return client.ID
+}
+
+// createSale stores a debt of the client sold the given number of days
+// after the reference, worth the sum of the installments, returning its id.
+func (s *ReportRepositorySuiteTest) createSale(ctx context.Context, clientId, status string, soldIn int, items []debtGorm.DebtItem, installments ...debtGorm.Installment) string {
+	total := money.Money{}
+	for i := range installments {
+		installments[i].Number = i + 1
+		total = total.Add(installments[i].Value)
+	}
+
+	debt := &debtGorm.Debt{
+		Description:          "Venda",
+		TotalValue:           total,
+		DueDate:              installments[len(installments)-1].DueDate,
+		InstallmentsQuantity: len(installments),
+		UserClientId:         clientId,
+		Status:               status,
+		DebtDate:             daysFromReference(soldIn),
+		InterestPeriod:       "monthly",
+		Schedule:             "monthly",
+		Items:                items,
+		Installments:         installments,
+	}
+	s.NoError(gormDB.WithContext(ctx).Create(debt).Error)
+
+	return debt.ID
+}
+
+// createDebt stores a client and a debt of theirs with the installments.
+func (s *ReportRepositorySuiteTest) createDebt(ctx context.Context, name, status string, installments ...debtGorm.Installment) string {
+	clientId := s.createClient(ctx, name)
+	s.createSale(ctx, clientId, status, -120, nil, installments...)
+
+	return clientId
+}
+
+// product is an item of a sale of the product.
+func product(productId, description string, quantity int, cents int64) debtGorm.DebtItem {
+	return debtGorm.DebtItem{
+		ItemType:    "product",
+		ReferenceId: productId,
+		Description: description,
+		Quantity:    quantity,
+		UnitPrice:   money.FromCents(cents),
+		Total:       money.FromCents(cents).Mul(int64(quantity)),
+	}
+}
+
+func (s *ReportRepositorySuiteTest) TestShouldBucketOpenAmountsByDaysPastDue() {
+	repo := NewGormReportRepository(gormDB)
+
+	partiallyPaid := installment(10000, -45)
+	partiallyPaid.Status = "partially_paid"
+	partiallyPaid.Payments = []debtGorm.InstallmentPayment{
+		{Amount: money.FromCents(4000), PaymentMethod: "pix", PaymentDate: daysFromReference(-40)},
+	}
+	paid := installment(10000, -100)
+	paid.Status = "paid"
+
+	joao := s.createDebt(tenantCtx, "João", "pending",
+		installment(10000, 0),
+		installment(10000, -1),
+		partiallyPaid,
+		installment(10000, -75),
+		installment(10000, -91),
+		paid,
+	)
+	maria := s.createDebt(tenantCtx, "Maria", "overdue", installment(5000, -30), installment(5000, 10))
+	s.createDebt(tenantCtx, "Pedro", "canceled", installment(7000, -10))
+
+	clients, err := repo.AgingByClient(tenantCtx, report.AgingFilter{Reference: reference})
+	s.NoError(err)
+	s.Len(clients, 2)
+
+	s.Equal(joao, clients[0].ClientId.String())
+	s.Equal("João Silva", clients[0].ClientName)
+	s.Equal(money.FromCents(10000), clients[0].Current)
+	s.Equal(money.FromCents(10000), clients[0].Days1To30)
+	s.Equal(money.FromCents(6000), clients[0].Days31To60)
+	s.Equal(money.FromCents(10000), clients[0].Days61To90)
+	s.Equal(money.FromCents(10000), clients[0].Over90)
+	s.Equal(5, clients[0].Installments)
+
+	s.Equal(maria, clients[1].ClientId.String())
+	s.Equal(money.FromCents(5000), clients[1].Current)
+	s.Equal(money.FromCents(5000), clients[1].Days1To30)
+	s.Equal(money.FromCents(10000), clients[1].Total())
+
+	otherTenantCtx := shared.WithTenant(context.Background(), ulid.Make())
+	clients, err = repo.AgingByClient(otherTenantCtx, report.AgingFilter{Reference: reference})
+	s.NoError(err)
+	s.Empty(clients)
+}
+
+func (s *ReportRepositorySuiteTest) TestShouldFilterAgingByDueDateAndStatus() {
+	repo := NewGormReportRepository(gormDB)
+
+	overdue := installment(3000, -20)
+	overdue.Status = "overdue"
+	s.createDebt(tenantCtx, "João", "overdue", installment(10000, -5), overdue, installment(2000, 20))
+
+	clients, err := repo.AgingByClient(tenantCtx, report.AgingFilter{
+		Reference: reference,
+		From:      daysFromReference(-20),
+		To:        daysFromReference(-5),
+	})
+	s.NoError(err)
+	s.Len(clients, 1)
+	s.Equal(money.FromCents(13000), clients[0].Days1To30)
+	s.True(clients[0].Current.IsZero())
+	s.Equal(2, clients[0].Installments)
+
+	clients, err = repo.AgingByClient(tenantCtx, report.AgingFilter{
+		Reference: reference,
+		Statuses:  []string{"overdue"},
+	})
+	s.NoError(err)
+	s.Len(clients, 1)
+	s.Equal(money.FromCents(3000), clients[0].Total())
+	s.Equal(1, clients[0].Installments)
+}
+
+func (s *ReportRepositorySuiteTest) TestShouldAddFineAndInterestToTheAging() {
+	repo := NewGormReportRepository(gormDB)
+
+	partiallyPaid := installment(10000, -45)
+	partiallyPaid.Status = "partially_paid"
+	partiallyPaid.Payments = []debtGorm.InstallmentPayment{
+		{Amount: money.FromCents(4000), PaymentMethod: "pix", PaymentDate: daysFromReference(-20)},
+	}
+	joao := s.createDebt(tenantCtx, "João", "overdue", installment(10000, 5), installment(10000, -30), partiallyPaid)
+
+	err := gormDB.Exec("UPDATE debts SET fine_percentage = 2, interest_rate = 1 WHERE user_client_id = ?", joao).Error
+	s.Require().NoError(err)
+
+	clients, err := repo.AgingByClient(tenantCtx, report.AgingFilter{Reference: reference})
+	s.NoError(err)
+	s.Len(clients, 1)
+	s.Equal(money.FromCents(10000), clients[0].Current)
+	s.Equal(money.FromCents(10300), clients[0].Days1To30)
+	s.Equal(money.FromCents(6350), clients[0].Days31To60)
+	s.Equal(3, clients[0].Installments)
+
+	err = gormDB.Exec("UPDATE debts SET interest_period = 'daily' WHERE user_client_id = ?", joao).Error
+	s.Require().NoError(err)
+
+	clients, err = repo.AgingByClient(tenantCtx, report.AgingFilter{Reference: reference})
+	s.NoError(err)
+	s.Equal(money.FromCents(13200), clients[0].Days1To30)
+}
+
+func (s *ReportRepositorySuiteTest) TestShouldSumRealizedInflowsByPeriod() {
+	repo := NewGormReportRepository(gormDB)
+
+	paid := installment(10000, -40)
+	paid.Status = "paid"
+	paid.PaymentDate = daysFromReference(-10)
+	paid.Payments = []debtGorm.InstallmentPayment{
+		{Amount: money.FromCents(4000), PaymentMethod: "pix", PaymentDate: daysFromReference(-45)},
+		{Amount: money.FromCents(5000), PaymentMethod: "cash", PaymentDate: daysFromReference(-10)},
+		{Amount: money.FromCents(1000), PaymentMethod: "credit", PaymentDate: daysFromReference(-10)},
+	}
+	s.createDebt(tenantCtx, "João", "paid", paid)
+
+	reversed := installment(7000, -20)
+	reversed.Payments = []debtGorm.InstallmentPayment{
+		{Amount: money.FromCents(7000), PaymentMethod: "pix", PaymentDate: daysFromReference(-20)},
+	}
+	s.createDebt(tenantCtx, "Pedro", "reversed", reversed)
+
+	inflows, err := repo.RealizedInflows(tenantCtx, report.InflowFilter{
+		GroupBy: report.Month,
+		From:    time.Date(2026, 4, 1, 0, 0, 0, 0, time.Local),
+		To:      reference.AddDate(0, 0, 1),
+	})
+	s.NoError(err)
+	s.Len(inflows, 2)
+	s.Equal(time.Date(2026, 5, 1, 0, 0, 0, 0, time.Local), inflows[0].Period)
+	s.Equal(money.FromCents(4000), inflows[0].Amount)
+	s.Equal(time.Date(2026, 6, 1, 0, 0, 0, 0, time.Local), inflows[1].Period)
+	s.Equal(money.FromCents(5000), inflows[1].Amount)
+
+	otherTenantCtx := shared.WithTenant(context.Background(), ulid.Make())
+	inflows, err = repo.RealizedInflows(otherTenantCtx, report.InflowFilter{
+		GroupBy: report.Month,
+		From:    time.Date(2026, 4, 1, 0, 0, 0, 0, time.Local),
+		To:      reference.AddDate(0, 0, 1),
+	})
+	s.NoError(err)
+	s.Empty(inflows)
+}
+
+func (s *ReportRepositorySuiteTest) TestShouldSumExpectedInflowsByPeriodAndClient() {
+	repo := NewGormReportRepository(gormDB)
+
+	partiallyPaid := installment(10000, 2)
+	partiallyPaid.Status = "partially_paid"
+	partiallyPaid.Payments = []debtGorm.InstallmentPayment{
+		{Amount: money.FromCents(3000), PaymentMethod: "pix", PaymentDate: daysFromReference(-1)},
+	}
+	joao := s.createDebt(tenantCtx, "João", "pending",
+		installment(5000, -3),
+		installment(10000, 0),
+		partiallyPaid,
+		installment(10000, 30),
+		installment(10000, 60),
+	)
+	maria := s.createDebt(tenantCtx, "Maria", "pending", installment(2000, 5))
+
+	inflows, err := repo.ExpectedInflows(tenantCtx, report.InflowFilter{
+		GroupBy: report.Week,
+		From:    reference,
+		To:      reference.AddDate(0, 0, 31),
+	})
+	s.NoError(err)
+	s.Len(inflows, 3)
+
+	expected := map[string]money.Money{}
+	for _, inflow := range inflows {
+		expected[inflow.ClientId.String()+" "+inflow.Period.Format(time.DateOnly)] = inflow.Amount
+	}
+
+	s.Equal(map[string]money.Money{
+		joao + " 2026-06-15":  money.FromCents(17000),
+		maria + " 2026-06-15": money.FromCents(2000),
+		joao + " 2026-07-13":  money.FromCents(10000),
+	}, expected)
+	s.Equal(reference.AddDate(0, 0, 28), inflows[2].Period)
+}
+
+func (s *ReportRepositorySuiteTest) TestShouldCountLatePaymentsOfClients() {
+	repo := NewGormReportRepository(gormDB)
+
+	onTime := installment(5000, -30)
+	onTime.Status = "paid"
+	onTime.PaymentDate = daysFromReference(-30)
+	late := installment(5000, -20)
+	late.Status = "paid"
+	late.PaymentDate = daysFromReference(-15)
+	overdue := installment(5000, -10)
+	overdue.Status = "overdue"
+	joao := s.createDebt(tenantCtx, "João", "overdue", onTime, late, overdue, installment(5000, 10))
+	s.createDebt(tenantCtx, "Pedro", "canceled", installment(5000, -10))
+
+	histories, err := repo.PaymentHistories(tenantCtx, reference)
+	s.NoError(err)
+	s.Len(histories, 1)
+	s.Equal(joao, histories[0].ClientId.String())
+	s.Equal(3, histories[0].Installments)
+	s.Equal(2, histories[0].Late)
+}
+
+func (s *ReportRepositorySuiteTest) TestShouldSumSalesByPeriod() {
+	repo := NewGormReportRepository(gormDB)
+	joao := s.createClient(tenantCtx, "João")
+	maria := s.createClient(tenantCtx, "Maria")
+
+	paid := installment(10000, -40)
+	paid.Status = "paid"
+	paid.Payments = []debtGorm.InstallmentPayment{
+		{Amount: money.FromCents(6000), PaymentMethod: "pix", PaymentDate: daysFromReference(-45)},
+		{Amount: money.FromCents(4000), PaymentMethod: "cash", PaymentDate: daysFromReference(-40)},
+	}
+	s.createSale(tenantCtx, joao, "paid", -45, nil, paid)
+	s.createSale(tenantCtx, maria, "canceled", -44, nil, installment(3000, -10))
+	s.createSale(tenantCtx, joao, "reversed", -5, nil, installment(7000, 20))
+	original := s.createSale(tenantCtx, maria, "renegotiated", -3, nil, installment(5000, 10))
+	renegotiated := s.createSale(tenantCtx, maria, "pending", -2, nil, installment(5200, 40))
+	s.NoError(gormDB.WithContext(tenantCtx).Create(&debtGorm.RenegotiationInfo{
+		RenegotiatedDebtId: renegotiated,
+		OpenBalance:        money.FromCents(5000),
+		Fees:               money.FromCents(200),
+		RenegotiatedBy:     ulid.Make().String(),
+		DebtId:             original,
+	}).Error)
+
+	periods, err := repo.SalesByPeriod(tenantCtx, report.SalesFilter{GroupBy: report.Month})
+	s.NoError(err)
+	s.Len(periods, 2)
+
+	s.Equal(time.Date(2026, 5, 1, 0, 0, 0, 0, time.Local), periods[0].Start)
+	s.Equal(2, periods[0].Sales)
+	s.Equal(money.FromCents(13000), periods[0].Sold)
+	s.Equal(money.FromCents(10000), periods[0].Received)
+	s.Equal(money.FromCents(3000), periods[0].Canceled)
+
+	s.Equal(time.Date(2026, 6, 1, 0, 0, 0, 0, time.Local), periods[1].Start)
+	s.Equal(2, periods[1].Sales)
+	s.Equal(money.FromCents(12000), periods[1].Sold)
+	s.Equal(money.FromCents(7000), periods[1].Reversed)
+
+	clientId := ulid.MustParse(joao)
+	periods, err = repo.SalesByPeriod(tenantCtx, report.SalesFilter{
+		GroupBy:       report.Month,
+		ClientId:      &clientId,
+		PaymentMethod: "pix",
+	})
+	s.NoError(err)
+	s.Len(periods, 1)
+	s.Equal(1, periods[0].Sales)
+	s.Equal(money.FromCents(6000), periods[0].Received)
+
+	periods, err = repo.SalesByPeriod(tenantCtx, report.SalesFilter{
+		GroupBy: report.Day,
+		From:    daysFromReference(-44),
+		To:      daysFromReference(-5),
+		Status:  "canceled",
+	})
+	s.NoError(err)
+	s.Len(periods, 1)
+	s.Equal(*daysFromReference(-44), periods[0].Start)
+	s.Equal(money.FromCents(3000), periods[0].Canceled)
+
+	otherTenantCtx := shared.WithTenant(context.Background(), ulid.Make())
+	periods, err = repo.SalesByPeriod(otherTenantCtx, report.SalesFilter{GroupBy: report.Month})
+	s.NoError(err)
+	s.Empty(periods)
+}
+
+func (s *ReportRepositorySuiteTest) TestShouldRankTopClientsAndProducts() {
+	repo := NewGormReportRepository(gormDB)
+	joao := s.createClient(tenantCtx, "João")
+	maria := s.createClient(tenantCtx, "Maria")
+	pomada, shampoo := ulid.Make().String(), ulid.Make().String()
+
+	s.createSale(tenantCtx, joao, "pending", -10,
+		[]debtGorm.DebtItem{product(pomada, "Pomada modeladora", 2, 3000)}, installment(6000, 20))
+	s.createSale(tenantCtx, joao, "pending", -5,
+		[]debtGorm.DebtItem{product(shampoo, "Shampoo", 1, 2500)}, installment(2500, 25))
+	s.createSale(tenantCtx, maria, "pending", -1,
+		[]debtGorm.DebtItem{product(pomada, "Pomada", 1, 3000)}, installment(3000, 30))
+	s.createSale(tenantCtx, maria, "canceled", -1,
+		[]debtGorm.DebtItem{product(shampoo, "Shampoo", 10, 2500)}, installment(25000, 30))
+
+	clients, err := repo.TopClients(tenantCtx, report.SalesFilter{Limit: 5})
+	s.NoError(err)
+	s.Len(clients, 2)
+	s.Equal(joao, clients[0].ClientId.String())
+	s.Equal("João Silva", clients[0].ClientName)
+	s.Equal(2, clients[0].Sales)
+	s.Equal(money.FromCents(8500), clients[0].Sold)
+	s.Equal(money.FromCents(3000), clients[1].Sold)
+
+	products, err := repo.TopProducts(tenantCtx, report.SalesFilter{Limit: 1})
+	s.NoError(err)
+	s.Len(products, 1)
+	s.Equal(pomada, products[0].ProductId.String())
+	s.Equal("Pomada", products[0].Description)
+	s.Equal(3, products[0].Quantity)
+	s.Equal(money.FromCents(9000), products[0].Total)
+}
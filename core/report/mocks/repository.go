@@ -0,0 +1,148 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: report/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=report/repository.go -destination=report/mocks/repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	report "github.com/henriquerocha2004/quem-me-deve-api/core/report"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AgingByClient mocks base method.
+func (m *MockRepository) AgingByClient(ctx context.Context, filter report.AgingFilter) ([]report.ClientAging, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AgingByClient", ctx, filter)
+	ret0, _ := ret[0].([]report.ClientAging)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AgingByClient indicates an expected call of AgingByClient.
+func (mr *MockRepositoryMockRecorder) AgingByClient(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AgingByClient", reflect.TypeOf((*MockRepository)(nil).AgingByClient), ctx, filter)
+}
+
+// ExpectedInflows mocks base method.
+func (m *MockRepository) ExpectedInflows(ctx context.Context, filter report.InflowFilter) ([]report.ClientInflow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpectedInflows", ctx, filter)
+	ret0, _ := ret[0].([]report.ClientInflow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpectedInflows indicates an expected call of ExpectedInflows.
+func (mr *MockRepositoryMockRecorder) ExpectedInflows(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpectedInflows", reflect.TypeOf((*MockRepository)(nil).ExpectedInflows), ctx, filter)
+}
+
+// PaymentHistories mocks base method.
+func (m *MockRepository) PaymentHistories(ctx context.Context, reference time.Time) ([]report.PaymentHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentHistories", ctx, reference)
+	ret0, _ := ret[0].([]report.PaymentHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PaymentHistories indicates an expected call of PaymentHistories.
+func (mr *MockRepositoryMockRecorder) PaymentHistories(ctx, reference any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentHistories", reflect.TypeOf((*MockRepository)(nil).PaymentHistories), ctx, reference)
+}
+
+// RealizedInflows mocks base method.
+func (m *MockRepository) RealizedInflows(ctx context.Context, filter report.InflowFilter) ([]report.Inflow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RealizedInflows", ctx, filter)
+	ret0, _ := ret[0].([]report.Inflow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RealizedInflows indicates an expected call of RealizedInflows.
+func (mr *MockRepositoryMockRecorder) RealizedInflows(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RealizedInflows", reflect.TypeOf((*MockRepository)(nil).RealizedInflows), ctx, filter)
+}
+
+// SalesByPeriod mocks base method.
+func (m *MockRepository) SalesByPeriod(ctx context.Context, filter report.SalesFilter) ([]report.SalesPeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SalesByPeriod", ctx, filter)
+	ret0, _ := ret[0].([]report.SalesPeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SalesByPeriod indicates an expected call of SalesByPeriod.
+func (mr *MockRepositoryMockRecorder) SalesByPeriod(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SalesByPeriod", reflect.TypeOf((*MockRepository)(nil).SalesByPeriod), ctx, filter)
+}
+
+// TopClients mocks base method.
+func (m *MockRepository) TopClients(ctx context.Context, filter report.SalesFilter) ([]report.ClientSales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopClients", ctx, filter)
+	ret0, _ := ret[0].([]report.ClientSales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopClients indicates an expected call of TopClients.
+func (mr *MockRepositoryMockRecorder) TopClients(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopClients", reflect.TypeOf((*MockRepository)(nil).TopClients), ctx, filter)
+}
+
+// TopProducts mocks base method.
+func (m *MockRepository) TopProducts(ctx context.Context, filter report.SalesFilter) ([]report.ProductSales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopProducts", ctx, filter)
+	ret0, _ := ret[0].([]report.ProductSales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopProducts indicates an expected call of TopProducts.
+func (mr *MockRepositoryMockRecorder) TopProducts(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopProducts", reflect.TypeOf((*MockRepository)(nil).TopProducts), ctx, filter)
+}
@@ -0,0 +1,33 @@
+package report
+
+import (
+	"context"
+	"time"
+)
+
+// Repository computes the reports in the database, from the installments
+// and the payments of the account, without loading the debts.
+type Repository interface {
+	// AgingByClient returns the aging of every client with open
+	// installments matching the filter, fine and interest included, the
+	// ones owing the most first.
+	AgingByClient(ctx context.Context, filter AgingFilter) ([]ClientAging, error)
+	// RealizedInflows returns the payments received in each period of the
+	// filter.
+	RealizedInflows(ctx context.Context, filter InflowFilter) ([]Inflow, error)
+	// ExpectedInflows returns what is still owed on the open installments
+	// due in each period of the filter, per client.
+	ExpectedInflows(ctx context.Context, filter InflowFilter) ([]ClientInflow, error)
+	// PaymentHistories returns, per client, the installments due before the
+	// reference day and how many of them were paid late.
+	PaymentHistories(ctx context.Context, reference time.Time) ([]PaymentHistory, error)
+	// SalesByPeriod returns the totals of the sales matching the filter in
+	// each period they were made, the periods without sales left out.
+	SalesByPeriod(ctx context.Context, filter SalesFilter) ([]SalesPeriod, error)
+	// TopClients returns the clients who bought the most, among the sales
+	// matching the filter that were not canceled or reversed.
+	TopClients(ctx context.Context, filter SalesFilter) ([]ClientSales, error)
+	// TopProducts returns the products sold the most, among the sales
+	// matching the filter that were not canceled or reversed.
+	TopProducts(ctx context.Context, filter SalesFilter) ([]ProductSales, error)
+}
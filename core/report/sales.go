@@ -0,0 +1,102 @@
+package report
+
+import (
+	"math"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+)
+
+// SalesFilter narrows the debts of the sales report. The dates are the days
+// of the sales, both included. With a payment method, only the debts with a
+// payment by it are taken, and only those payments are counted as received.
+// Limit caps the rankings of clients and products.
+type SalesFilter struct {
+	GroupBy       Granularity
+	From          *time.Time
+	To            *time.Time
+	ClientId      *ulid.ULID
+	Status        string
+	PaymentMethod string
+	Limit         int
+}
+
+// SalesTotals adds up the sales, what was received on them and what was
+// taken back by cancellations and reversals.
+type SalesTotals struct {
+	Sales    int
+	Sold     money.Money
+	Received money.Money
+	Canceled money.Money
+	Reversed money.Money
+}
+
+// AverageTicket is the average value of the sales, rounded to the nearest
+// cent.
+func (t SalesTotals) AverageTicket() money.Money {
+	if t.Sales == 0 {
+		return money.Money{}
+	}
+
+	return money.FromCents(int64(math.Round(float64(t.Sold.Cents()) / float64(t.Sales))))
+}
+
+func (t SalesTotals) Add(other SalesTotals) SalesTotals {
+	return SalesTotals{
+		Sales:    t.Sales + other.Sales,
+		Sold:     t.Sold.Add(other.Sold),
+		Received: t.Received.Add(other.Received),
+		Canceled: t.Canceled.Add(other.Canceled),
+		Reversed: t.Reversed.Add(other.Reversed),
+	}
+}
+
+// SalesPeriod is the totals of the sales made in the period starting at
+// Start.
+type SalesPeriod struct {
+	Start time.Time
+	SalesTotals
+}
+
+// ClientSales is the totals of the sales made to a client.
+type ClientSales struct {
+	ClientId   ulid.ULID
+	ClientName string
+	SalesTotals
+}
+
+// ProductSales is how much of a product was sold, from the items of the
+// debts.
+type ProductSales struct {
+	ProductId   ulid.ULID
+	Description string
+	Quantity    int
+	Total       money.Money
+}
+
+// Sales is the sales report: the totals of the sales per period, and the
+// clients and products that sold the most.
+type Sales struct {
+	GroupBy     Granularity
+	Totals      SalesTotals
+	Periods     []SalesPeriod
+	TopClients  []ClientSales
+	TopProducts []ProductSales
+}
+
+// NewSales sums up the periods in the totals of the report.
+func NewSales(groupBy Granularity, periods []SalesPeriod, topClients []ClientSales, topProducts []ProductSales) *Sales {
+	sales := &Sales{
+		GroupBy:     groupBy,
+		Periods:     periods,
+		TopClients:  topClients,
+		TopProducts: topProducts,
+	}
+
+	for _, period := range periods {
+		sales.Totals = sales.Totals.Add(period.SalesTotals)
+	}
+
+	return sales
+}
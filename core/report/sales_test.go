@@ -0,0 +1,38 @@
+package report_test
+
+import (
+	"testing"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/report"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSales(t *testing.T) {
+	t.Run("Deve somar os períodos no total das vendas", func(t *testing.T) {
+		sales := report.NewSales(report.Month, []report.SalesPeriod{
+			{Start: date(2026, 5, 1), SalesTotals: report.SalesTotals{
+				Sales:    2,
+				Sold:     money.FromCents(15000),
+				Received: money.FromCents(5000),
+				Canceled: money.FromCents(5000),
+			}},
+			{Start: date(2026, 6, 1), SalesTotals: report.SalesTotals{
+				Sales:    1,
+				Sold:     money.FromCents(10000),
+				Reversed: money.FromCents(10000),
+			}},
+		}, nil, nil)
+
+		assert.Equal(t, 3, sales.Totals.Sales)
+		assert.Equal(t, money.FromCents(25000), sales.Totals.Sold)
+		assert.Equal(t, money.FromCents(5000), sales.Totals.Received)
+		assert.Equal(t, money.FromCents(5000), sales.Totals.Canceled)
+		assert.Equal(t, money.FromCents(10000), sales.Totals.Reversed)
+		assert.Equal(t, money.FromCents(8333), sales.Totals.AverageTicket())
+	})
+
+	t.Run("Deve ter ticket médio zero sem vendas", func(t *testing.T) {
+		assert.True(t, report.SalesTotals{}.AverageTicket().IsZero())
+	})
+}
@@ -0,0 +1,330 @@
+package report
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/oklog/ulid/v2"
+)
+
+// Defaults of the forecast when the request leaves them out.
+const (
+	defaultForecastGroupBy = Month
+	defaultForecastMonths  = 3
+)
+
+// Defaults of the sales report.
+const (
+	defaultSalesGroupBy = Month
+	topSalesLimit       = 5
+)
+
+type Service interface {
+	Aging(ctx context.Context, request *AgingRequestDto) shared.ServiceResponse
+	Forecast(ctx context.Context, request *ForecastRequestDto) shared.ServiceResponse
+	Sales(ctx context.Context, request *SalesRequestDto) shared.ServiceResponse
+}
+
+type ReportService struct {
+	reports Repository
+}
+
+func NewReportService(reports Repository) *ReportService {
+	return &ReportService{
+		reports: reports,
+	}
+}
+
+// Aging reports the open amounts of the account by days past due, as of
+// today.
+func (s *ReportService) Aging(ctx context.Context, request *AgingRequestDto) shared.ServiceResponse {
+	from, to, ok := parsePeriod(request.From, request.To)
+	if !ok {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "from must not be after to",
+		}
+	}
+
+	filter := AgingFilter{
+		Reference: time.Now(),
+		From:      from,
+		To:        to,
+	}
+
+	if request.Status != "" {
+		filter.Statuses = []string{request.Status}
+	}
+
+	clients, err := s.reports.AgingByClient(ctx, filter)
+	if err != nil {
+		log.Println("Error getting aging report:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in get aging report",
+		}
+	}
+
+	aging := NewAging(filter.Reference, clients)
+
+	agingDto := AgingDto{
+		ReferenceDate: aging.Reference.Format(time.DateOnly),
+		Overall:       s.convertToAgingBucketsDto(aging.Overall),
+		Clients:       []ClientAgingDto{},
+	}
+
+	for _, client := range aging.Clients {
+		agingDto.Clients = append(agingDto.Clients, ClientAgingDto{
+			ClientId:        client.ClientId.String(),
+			ClientName:      client.ClientName,
+			AgingBucketsDto: s.convertToAgingBucketsDto(client.AgingBuckets),
+		})
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "aging report generated successfully",
+		Data:    agingDto,
+	}
+}
+
+// Forecast reports the inflows received in the past months and the ones
+// expected in the next months, from the due dates of the open installments.
+// Installments already past due are left out of the expected inflows, as
+// there is no telling when they will be paid.
+func (s *ReportService) Forecast(ctx context.Context, request *ForecastRequestDto) shared.ServiceResponse {
+	groupBy := Granularity(request.GroupBy)
+	if groupBy == "" {
+		groupBy = defaultForecastGroupBy
+	}
+
+	months := request.Months
+	if months == 0 {
+		months = defaultForecastMonths
+	}
+
+	reference := time.Now()
+	today := Day.Start(reference)
+	from, to := ForecastWindow(groupBy, reference, months)
+
+	realized, err := s.reports.RealizedInflows(ctx, InflowFilter{GroupBy: groupBy, From: from, To: Day.Next(today)})
+	if err != nil {
+		log.Println("Error getting realized inflows:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in get forecast report",
+		}
+	}
+
+	expected, err := s.reports.ExpectedInflows(ctx, InflowFilter{GroupBy: groupBy, From: today, To: to})
+	if err != nil {
+		log.Println("Error getting expected inflows:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in get forecast report",
+		}
+	}
+
+	var lateRatios map[ulid.ULID]float64
+	if request.RiskAdjusted {
+		histories, err := s.reports.PaymentHistories(ctx, today)
+		if err != nil {
+			log.Println("Error getting payment histories:", err)
+			return shared.ServiceResponse{
+				Status:  "error",
+				Message: "error in get forecast report",
+			}
+		}
+
+		lateRatios = map[ulid.ULID]float64{}
+		for _, history := range histories {
+			lateRatios[history.ClientId] = history.LateRatio()
+		}
+	}
+
+	forecast := NewForecast(groupBy, reference, months, realized, expected, lateRatios)
+
+	forecastDto := ForecastDto{
+		GroupBy:       string(forecast.GroupBy),
+		ReferenceDate: forecast.Reference.Format(time.DateOnly),
+		From:          forecast.From.Format(time.DateOnly),
+		To:            forecast.To.AddDate(0, 0, -1).Format(time.DateOnly),
+		RiskAdjusted:  forecast.RiskAdjusted,
+		Totals:        s.convertToCashFlowPeriodDto(forecast.Totals(), forecast.RiskAdjusted),
+		Periods:       []CashFlowPeriodDto{},
+	}
+
+	for _, period := range forecast.Periods {
+		forecastDto.Periods = append(forecastDto.Periods, s.convertToCashFlowPeriodDto(period, forecast.RiskAdjusted))
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "forecast report generated successfully",
+		Data:    forecastDto,
+	}
+}
+
+func (s *ReportService) convertToCashFlowPeriodDto(period CashFlowPeriod, riskAdjusted bool) CashFlowPeriodDto {
+	periodDto := CashFlowPeriodDto{
+		Start:    period.Start.Format(time.DateOnly),
+		End:      period.End.Format(time.DateOnly),
+		Realized: period.Realized,
+		Expected: period.Expected,
+	}
+
+	if riskAdjusted {
+		periodDto.RiskAdjusted = &period.RiskAdjusted
+	}
+
+	return periodDto
+}
+
+// Sales reports the sales matching the request per period, with the
+// clients and products that sold the most.
+func (s *ReportService) Sales(ctx context.Context, request *SalesRequestDto) shared.ServiceResponse {
+	from, to, ok := parsePeriod(request.From, request.To)
+	if !ok {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "from must not be after to",
+		}
+	}
+
+	filter := SalesFilter{
+		GroupBy:       Granularity(request.GroupBy),
+		From:          from,
+		To:            to,
+		Status:        request.Status,
+		PaymentMethod: request.PaymentMethod,
+		Limit:         topSalesLimit,
+	}
+
+	if filter.GroupBy == "" {
+		filter.GroupBy = defaultSalesGroupBy
+	}
+
+	if request.ClientId != "" {
+		clientId := ulid.MustParse(request.ClientId)
+		filter.ClientId = &clientId
+	}
+
+	periods, err := s.reports.SalesByPeriod(ctx, filter)
+	if err != nil {
+		log.Println("Error getting sales by period:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in get sales report",
+		}
+	}
+
+	topClients, err := s.reports.TopClients(ctx, filter)
+	if err != nil {
+		log.Println("Error getting top clients:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in get sales report",
+		}
+	}
+
+	topProducts, err := s.reports.TopProducts(ctx, filter)
+	if err != nil {
+		log.Println("Error getting top products:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in get sales report",
+		}
+	}
+
+	sales := NewSales(filter.GroupBy, periods, topClients, topProducts)
+
+	salesDto := SalesDto{
+		GroupBy:     string(sales.GroupBy),
+		From:        request.From,
+		To:          request.To,
+		Totals:      s.convertToSalesTotalsDto(sales.Totals),
+		Periods:     []SalesPeriodDto{},
+		TopClients:  []ClientSalesDto{},
+		TopProducts: []ProductSalesDto{},
+	}
+
+	for _, period := range sales.Periods {
+		salesDto.Periods = append(salesDto.Periods, SalesPeriodDto{
+			Start:          period.Start.Format(time.DateOnly),
+			SalesTotalsDto: s.convertToSalesTotalsDto(period.SalesTotals),
+		})
+	}
+
+	for _, client := range sales.TopClients {
+		salesDto.TopClients = append(salesDto.TopClients, ClientSalesDto{
+			ClientId:      client.ClientId.String(),
+			ClientName:    client.ClientName,
+			Sales:         client.Sales,
+			Sold:          client.Sold,
+			Received:      client.Received,
+			AverageTicket: client.AverageTicket(),
+		})
+	}
+
+	for _, product := range sales.TopProducts {
+		salesDto.TopProducts = append(salesDto.TopProducts, ProductSalesDto{
+			ProductId:   product.ProductId.String(),
+			Description: product.Description,
+			Quantity:    product.Quantity,
+			Total:       product.Total,
+		})
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "sales report generated successfully",
+		Data:    salesDto,
+	}
+}
+
+func (s *ReportService) convertToSalesTotalsDto(totals SalesTotals) SalesTotalsDto {
+	return SalesTotalsDto{
+		Sales:         totals.Sales,
+		Sold:          totals.Sold,
+		Received:      totals.Received,
+		Canceled:      totals.Canceled,
+		Reversed:      totals.Reversed,
+		AverageTicket: totals.AverageTicket(),
+	}
+}
+
+func (s *ReportService) convertToAgingBucketsDto(buckets AgingBuckets) AgingBucketsDto {
+	return AgingBucketsDto{
+		Current:      buckets.Current,
+		Days1To30:    buckets.Days1To30,
+		Days31To60:   buckets.Days31To60,
+		Days61To90:   buckets.Days61To90,
+		Over90:       buckets.Over90,
+		PastDue:      buckets.PastDue(),
+		Total:        buckets.Total(),
+		Installments: buckets.Installments,
+	}
+}
+
+// parsePeriod reads the days of a period, already validated, in the time
+// zone of the server, reporting false when it ends before it starts.
+func parsePeriod(fromDay, toDay string) (*time.Time, *time.Time, bool) {
+	var from, to *time.Time
+	if fromDay != "" {
+		day, _ := time.ParseInLocation(time.DateOnly, fromDay, time.Local)
+		from = &day
+	}
+
+	if toDay != "" {
+		day, _ := time.ParseInLocation(time.DateOnly, toDay, time.Local)
+		to = &day
+	}
+
+	if from != nil && to != nil && to.Before(*from) {
+		return from, to, false
+	}
+
+	return from, to, true
+}
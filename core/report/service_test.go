@@ -0,0 +1,302 @@
+package report_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/report"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/report/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReportService(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Deve somar o aging dos clientes no total geral", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reports := mocks.NewMockRepository(ctrl)
+		service := report.NewReportService(reports)
+
+		reports.EXPECT().AgingByClient(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filter report.AgingFilter) ([]report.ClientAging, error) {
+			assert.Equal(t, []string{"overdue"}, filter.Statuses)
+			assert.Equal(t, "2026-01-01", filter.From.Format("2006-01-02"))
+			assert.Equal(t, "2026-06-30", filter.To.Format("2006-01-02"))
+			return []report.ClientAging{
+				{
+					ClientId:   ulid.Make(),
+					ClientName: "João Silva",
+					AgingBuckets: report.AgingBuckets{
+						Days1To30:    money.FromCents(5000),
+						Over90:       money.FromCents(20000),
+						Installments: 2,
+					},
+				},
+				{
+					ClientId:   ulid.Make(),
+					ClientName: "Maria Souza",
+					AgingBuckets: report.AgingBuckets{
+						Current:      money.FromCents(3000),
+						Days31To60:   money.FromCents(1000),
+						Installments: 2,
+					},
+				},
+			}, nil
+		})
+
+		response := service.Aging(ctx, &report.AgingRequestDto{From: "2026-01-01", To: "2026-06-30", Status: "overdue"})
+
+		assert.Equal(t, "success", response.Status)
+		aging := response.Data.(report.AgingDto)
+		assert.Equal(t, money.FromCents(3000), aging.Overall.Current)
+		assert.Equal(t, money.FromCents(5000), aging.Overall.Days1To30)
+		assert.Equal(t, money.FromCents(1000), aging.Overall.Days31To60)
+		assert.Equal(t, money.FromCents(20000), aging.Overall.Over90)
+		assert.Equal(t, money.FromCents(26000), aging.Overall.PastDue)
+		assert.Equal(t, money.FromCents(29000), aging.Overall.Total)
+		assert.Equal(t, 4, aging.Overall.Installments)
+		assert.Len(t, aging.Clients, 2)
+		assert.Equal(t, money.FromCents(25000), aging.Clients[0].Total)
+	})
+
+	t.Run("Deve considerar todas as parcelas em aberto sem filtro de status", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reports := mocks.NewMockRepository(ctrl)
+		reports.EXPECT().AgingByClient(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filter report.AgingFilter) ([]report.ClientAging, error) {
+			assert.Empty(t, filter.Statuses)
+			assert.Nil(t, filter.From)
+			assert.Nil(t, filter.To)
+			return nil, nil
+		})
+		service := report.NewReportService(reports)
+
+		response := service.Aging(ctx, &report.AgingRequestDto{})
+
+		assert.Equal(t, "success", response.Status)
+		aging := response.Data.(report.AgingDto)
+		assert.Empty(t, aging.Clients)
+		assert.True(t, aging.Overall.Total.IsZero())
+	})
+
+	t.Run("Deve recusar um período que termina antes de começar", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reports := mocks.NewMockRepository(ctrl)
+		reports.EXPECT().AgingByClient(gomock.Any(), gomock.Any()).Times(0)
+		service := report.NewReportService(reports)
+
+		response := service.Aging(ctx, &report.AgingRequestDto{From: "2026-02-01", To: "2026-01-01"})
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "from must not be after to", response.Message)
+	})
+
+	t.Run("Deve retornar erro quando falhar ao calcular o aging", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reports := mocks.NewMockRepository(ctrl)
+		reports.EXPECT().AgingByClient(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+		service := report.NewReportService(reports)
+
+		response := service.Aging(ctx, &report.AgingRequestDto{})
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "error in get aging report", response.Message)
+	})
+
+	t.Run("Deve prever os recebimentos dos próximos meses sem ajuste de risco", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reports := mocks.NewMockRepository(ctrl)
+		service := report.NewReportService(reports)
+		today := report.Day.Start(time.Now())
+		nextMonth := report.Month.Next(report.Month.Start(today))
+
+		reports.EXPECT().RealizedInflows(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filter report.InflowFilter) ([]report.Inflow, error) {
+			assert.Equal(t, report.Month, filter.GroupBy)
+			assert.Equal(t, report.Month.Start(today.AddDate(0, -3, 0)), filter.From)
+			assert.Equal(t, today.AddDate(0, 0, 1), filter.To)
+			return []report.Inflow{{Period: report.Month.Start(today), Amount: money.FromCents(3000)}}, nil
+		})
+		reports.EXPECT().ExpectedInflows(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filter report.InflowFilter) ([]report.ClientInflow, error) {
+			assert.Equal(t, today, filter.From)
+			return []report.ClientInflow{
+				{ClientId: ulid.Make(), Inflow: report.Inflow{Period: nextMonth, Amount: money.FromCents(8000)}},
+			}, nil
+		})
+		reports.EXPECT().PaymentHistories(gomock.Any(), gomock.Any()).Times(0)
+
+		response := service.Forecast(ctx, &report.ForecastRequestDto{})
+
+		assert.Equal(t, "success", response.Status)
+		forecast := response.Data.(report.ForecastDto)
+		assert.Equal(t, "month", forecast.GroupBy)
+		assert.False(t, forecast.RiskAdjusted)
+		assert.Len(t, forecast.Periods, 7)
+		assert.Equal(t, money.FromCents(3000), forecast.Periods[3].Realized)
+		assert.Equal(t, money.FromCents(8000), forecast.Periods[4].Expected)
+		assert.Nil(t, forecast.Periods[4].RiskAdjusted)
+		assert.Equal(t, money.FromCents(8000), forecast.Totals.Expected)
+	})
+
+	t.Run("Deve ajustar a previsão pelo histórico de atrasos dos clientes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reports := mocks.NewMockRepository(ctrl)
+		service := report.NewReportService(reports)
+		today := report.Day.Start(time.Now())
+		joao := ulid.Make()
+
+		reports.EXPECT().RealizedInflows(gomock.Any(), gomock.Any()).Return(nil, nil)
+		reports.EXPECT().ExpectedInflows(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filter report.InflowFilter) ([]report.ClientInflow, error) {
+			assert.Equal(t, report.Day, filter.GroupBy)
+			assert.Equal(t, today.AddDate(0, 1, 1), filter.To)
+			return []report.ClientInflow{
+				{ClientId: joao, Inflow: report.Inflow{Period: today, Amount: money.FromCents(10000)}},
+			}, nil
+		})
+		reports.EXPECT().PaymentHistories(gomock.Any(), today).Return([]report.PaymentHistory{
+			{ClientId: joao, Installments: 10, Late: 3},
+		}, nil)
+
+		response := service.Forecast(ctx, &report.ForecastRequestDto{GroupBy: "day", Months: 1, RiskAdjusted: true})
+
+		assert.Equal(t, "success", response.Status)
+		forecast := response.Data.(report.ForecastDto)
+		assert.True(t, forecast.RiskAdjusted)
+		assert.Equal(t, money.FromCents(7000), *forecast.Totals.RiskAdjusted)
+		assert.Equal(t, money.FromCents(10000), forecast.Totals.Expected)
+	})
+
+	t.Run("Deve retornar erro quando falhar ao buscar o histórico de pagamentos", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reports := mocks.NewMockRepository(ctrl)
+		reports.EXPECT().RealizedInflows(gomock.Any(), gomock.Any()).Return(nil, nil)
+		reports.EXPECT().ExpectedInflows(gomock.Any(), gomock.Any()).Return(nil, nil)
+		reports.EXPECT().PaymentHistories(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+		service := report.NewReportService(reports)
+
+		response := service.Forecast(ctx, &report.ForecastRequestDto{RiskAdjusted: true})
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "error in get forecast report", response.Message)
+	})
+
+	t.Run("Deve gerar o relatório de vendas com os filtros da requisição", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reports := mocks.NewMockRepository(ctrl)
+		service := report.NewReportService(reports)
+		clientId := ulid.Make()
+		productId := ulid.Make()
+
+		checkFilter := func(filter report.SalesFilter) {
+			assert.Equal(t, report.Week, filter.GroupBy)
+			assert.Equal(t, clientId, *filter.ClientId)
+			assert.Equal(t, "paid", filter.Status)
+			assert.Equal(t, "pix", filter.PaymentMethod)
+			assert.Equal(t, "2026-05-01", filter.From.Format("2006-01-02"))
+			assert.Nil(t, filter.To)
+			assert.Equal(t, 5, filter.Limit)
+		}
+
+		reports.EXPECT().SalesByPeriod(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filter report.SalesFilter) ([]report.SalesPeriod, error) {
+			checkFilter(filter)
+			return []report.SalesPeriod{
+				{Start: time.Date(2026, 5, 4, 0, 0, 0, 0, time.Local), SalesTotals: report.SalesTotals{Sales: 2, Sold: money.FromCents(9000), Received: money.FromCents(9000)}},
+			}, nil
+		})
+		reports.EXPECT().TopClients(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filter report.SalesFilter) ([]report.ClientSales, error) {
+			checkFilter(filter)
+			return []report.ClientSales{
+				{ClientId: clientId, ClientName: "João Silva", SalesTotals: report.SalesTotals{Sales: 2, Sold: money.FromCents(9000)}},
+			}, nil
+		})
+		reports.EXPECT().TopProducts(gomock.Any(), gomock.Any()).Return([]report.ProductSales{
+			{ProductId: productId, Description: "Pomada", Quantity: 3, Total: money.FromCents(6000)},
+		}, nil)
+
+		response := service.Sales(ctx, &report.SalesRequestDto{
+			From:          "2026-05-01",
+			ClientId:      clientId.String(),
+			Status:        "paid",
+			PaymentMethod: "pix",
+			GroupBy:       "week",
+		})
+
+		assert.Equal(t, "success", response.Status)
+		sales := response.Data.(report.SalesDto)
+		assert.Equal(t, "week", sales.GroupBy)
+		assert.Equal(t, 2, sales.Totals.Sales)
+		assert.Equal(t, money.FromCents(4500), sales.Totals.AverageTicket)
+		assert.Equal(t, "2026-05-04", sales.Periods[0].Start)
+		assert.Equal(t, money.FromCents(4500), sales.TopClients[0].AverageTicket)
+		assert.Equal(t, productId.String(), sales.TopProducts[0].ProductId)
+	})
+
+	t.Run("Deve agrupar as vendas por mês quando não informado", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reports := mocks.NewMockRepository(ctrl)
+		reports.EXPECT().SalesByPeriod(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filter report.SalesFilter) ([]report.SalesPeriod, error) {
+			assert.Equal(t, report.Month, filter.GroupBy)
+			assert.Nil(t, filter.ClientId)
+			return nil, nil
+		})
+		reports.EXPECT().TopClients(gomock.Any(), gomock.Any()).Return(nil, nil)
+		reports.EXPECT().TopProducts(gomock.Any(), gomock.Any()).Return(nil, nil)
+		service := report.NewReportService(reports)
+
+		response := service.Sales(ctx, &report.SalesRequestDto{})
+
+		assert.Equal(t, "success", response.Status)
+		sales := response.Data.(report.SalesDto)
+		assert.Empty(t, sales.Periods)
+		assert.NotNil(t, sales.TopClients)
+		assert.True(t, sales.Totals.AverageTicket.IsZero())
+	})
+
+	t.Run("Deve retornar erro quando falhar ao buscar os produtos mais vendidos", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reports := mocks.NewMockRepository(ctrl)
+		reports.EXPECT().SalesByPeriod(gomock.Any(), gomock.Any()).Return(nil, nil)
+		reports.EXPECT().TopClients(gomock.Any(), gomock.Any()).Return(nil, nil)
+		reports.EXPECT().TopProducts(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+		service := report.NewReportService(reports)
+
+		response := service.Sales(ctx, &report.SalesRequestDto{})
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "error in get sales report", response.Message)
+	})
+
+	t.Run("Deve recusar um período de vendas que termina antes de começar", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service := report.NewReportService(mocks.NewMockRepository(ctrl))
+
+		response := service.Sales(ctx, &report.SalesRequestDto{From: "2026-05-02", To: "2026-05-01"})
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "from must not be after to", response.Message)
+	})
+}
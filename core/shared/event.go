@@ -0,0 +1,38 @@
+package shared
+
+import (
+	"context"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+)
+
+// EventName identifies something that happened to the debts of an account
+// that other parts of the system react to.
+type EventName string
+
+const (
+	InstallmentDueSoon EventName = "installment_due_soon"
+	InstallmentOverdue EventName = "installment_overdue"
+	PaymentReceived    EventName = "payment_received"
+	DebtPaid           EventName = "debt_paid"
+)
+
+// Event happened to a debt, or to one of its installments, of the account
+// in the context it is published with. InstallmentId is zero for the events
+// of the whole debt.
+type Event struct {
+	Name              EventName
+	DebtId            ulid.ULID
+	InstallmentId     ulid.ULID
+	ClientId          ulid.ULID
+	InstallmentNumber int
+	Amount            money.Money
+	DueDate           time.Time
+	OccurredAt        time.Time
+}
+
+type EventPublisher interface {
+	Publish(ctx context.Context, event Event) error
+}
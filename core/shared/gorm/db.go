@@ -11,5 +11,9 @@ func NewGorm(dsn string) (*gorm.DB, error) {
 		return nil, err
 	}
 
+	if err := RegisterTenantScope(db); err != nil {
+		return nil, err
+	}
+
 	return db, nil
 }
@@ -0,0 +1,86 @@
+package gorm
+
+import (
+	"context"
+	"database/sql"
+	"hash/fnv"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+// AdvisoryLocker takes Postgres session advisory locks, so that a job is run
+// by a single API instance at a time, or a check and the write depending on
+// it are not interleaved with another request.
+type AdvisoryLocker struct {
+	db *gorm.DB
+}
+
+func NewAdvisoryLocker(db *gorm.DB) *AdvisoryLocker {
+	return &AdvisoryLocker{db: db}
+}
+
+// TryLock takes the lock named by name without waiting for it. A session lock
+// belongs to the connection that took it, so the connection is held until
+// the lock is released.
+func (a *AdvisoryLocker) TryLock(ctx context.Context, name string) (func(), bool, error) {
+	sqlDB, err := a.db.DB()
+	if err != nil {
+		return nil, false, err
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	key := lockKey(name)
+
+	var acquired bool
+	err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&acquired)
+	if err != nil || !acquired {
+		conn.Close()
+		return nil, false, err
+	}
+
+	return unlocker(conn, name, key), true, nil
+}
+
+// Lock takes the lock named by name, waiting for it while another session
+// holds it.
+func (a *AdvisoryLocker) Lock(ctx context.Context, name string) (func(), error) {
+	sqlDB, err := a.db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key := lockKey(name)
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", key); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return unlocker(conn, name, key), nil
+}
+
+func unlocker(conn *sql.Conn, name string, key int64) func() {
+	return func() {
+		defer conn.Close()
+
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", key); err != nil {
+			log.Println("Error releasing advisory lock", name, ":", err)
+		}
+	}
+}
+
+func lockKey(name string) int64 {
+	hash := fnv.New64a()
+	hash.Write([]byte(name))
+	return int64(hash.Sum64())
+}
@@ -0,0 +1,128 @@
+package gorm
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+const tenantColumn = "tenant_id"
+
+var ErrTenantRequired = errors.New("tenant not found in context")
+
+// RegisterTenantScope scopes every statement over a model with a tenant_id
+// column to the tenant of the statement context: creates have the column
+// filled, while queries, updates and deletes are filtered by it. Statements
+// over those models without a tenant in the context fail, unless the context
+// was explicitly made to reach across tenants.
+func RegisterTenantScope(db *gorm.DB) error {
+	callback := db.Callback()
+
+	err := callback.Create().Before("gorm:create").Register("tenant:create", setTenant)
+	if err != nil {
+		return err
+	}
+
+	err = callback.Query().Before("gorm:query").Register("tenant:query", filterTenant)
+	if err != nil {
+		return err
+	}
+
+	err = callback.Update().Before("gorm:update").Register("tenant:update", filterTenantUpdate)
+	if err != nil {
+		return err
+	}
+
+	err = callback.Delete().Before("gorm:delete").Register("tenant:delete", filterTenant)
+	if err != nil {
+		return err
+	}
+
+	return callback.Row().Before("gorm:row").Register("tenant:row", filterTenant)
+}
+
+func setTenant(db *gorm.DB) {
+	field, tenantId, ok := tenantOf(db)
+	if !ok {
+		return
+	}
+
+	ctx := db.Statement.Context
+	value := db.Statement.ReflectValue
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if err := field.Set(ctx, reflect.Indirect(value.Index(i)), tenantId); err != nil {
+				db.AddError(err)
+				return
+			}
+		}
+	case reflect.Struct:
+		if err := field.Set(ctx, value, tenantId); err != nil {
+			db.AddError(err)
+		}
+	}
+}
+
+func filterTenant(db *gorm.DB) {
+	_, tenantId, ok := tenantOf(db)
+	if !ok {
+		return
+	}
+
+	tenantFilter := clause.Eq{
+		Column: clause.Column{Table: clause.CurrentTable, Name: tenantColumn},
+		Value:  tenantId,
+	}
+
+	// The conditions already set are grouped before the tenant filter is
+	// added, so that an OR among them can not reach other tenants.
+	if c, ok := db.Statement.Clauses["WHERE"]; ok {
+		if where, ok := c.Expression.(clause.Where); ok && len(where.Exprs) > 0 {
+			c.Expression = clause.Where{Exprs: []clause.Expression{clause.And(where.Exprs...), tenantFilter}}
+			db.Statement.Clauses["WHERE"] = c
+			return
+		}
+	}
+
+	db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{tenantFilter}})
+}
+
+// filterTenantUpdate also keeps a row from moving to another tenant,
+// whatever the model being saved carries in its tenant field.
+func filterTenantUpdate(db *gorm.DB) {
+	filterTenant(db)
+	db.Statement.Omits = append(db.Statement.Omits, tenantColumn)
+}
+
+// tenantOf returns the tenant field of the statement model along with the
+// tenant of the context. It reports false when the model is not scoped by
+// tenant, when the context reaches across tenants, or when the statement
+// already failed for the lack of a tenant.
+func tenantOf(db *gorm.DB) (*schema.Field, string, bool) {
+	if db.Error != nil || db.Statement.Schema == nil {
+		return nil, "", false
+	}
+
+	field := db.Statement.Schema.LookUpField(tenantColumn)
+	if field == nil {
+		return nil, "", false
+	}
+
+	if shared.IsAcrossTenants(db.Statement.Context) {
+		return nil, "", false
+	}
+
+	tenantId, ok := shared.TenantFromContext(db.Statement.Context)
+	if !ok {
+		db.AddError(ErrTenantRequired)
+		return nil, "", false
+	}
+
+	return field, tenantId.String(), true
+}
@@ -0,0 +1,156 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type scopedModel struct {
+	ID       string `gorm:"column:id;primaryKey"`
+	Name     string `gorm:"column:name"`
+	TenantID string `gorm:"column:tenant_id"`
+}
+
+type unscopedModel struct {
+	ID   string `gorm:"column:id;primaryKey"`
+	Name string `gorm:"column:name"`
+}
+
+func dryRunDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := RegisterTenantScope(db); err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func TestTenantScope(t *testing.T) {
+	db := dryRunDB(t)
+	tenantId := ulid.Make()
+	ctx := shared.WithTenant(context.Background(), tenantId)
+
+	tests := []struct {
+		name string
+		run  func(tx *gorm.DB) *gorm.DB
+		want string
+	}{
+		{
+			name: "query",
+			run: func(tx *gorm.DB) *gorm.DB {
+				return tx.Where("id = ?", "1").Find(&[]scopedModel{})
+			},
+			want: `WHERE id = $1 AND "scoped_models"."tenant_id" = $2`,
+		},
+		{
+			name: "query with or conditions",
+			run: func(tx *gorm.DB) *gorm.DB {
+				return tx.Where("name = ?", "a").Or("name = ?", "b").Find(&[]scopedModel{})
+			},
+			want: `WHERE (name = $1 OR name = $2) AND "scoped_models"."tenant_id" = $3`,
+		},
+		{
+			name: "update",
+			run: func(tx *gorm.DB) *gorm.DB {
+				return tx.Model(&scopedModel{}).Where("id = ?", "1").Updates(&scopedModel{Name: "a", TenantID: "other"})
+			},
+			want: `UPDATE "scoped_models" SET "name"=$1 WHERE id = $2 AND "scoped_models"."tenant_id" = $3`,
+		},
+		{
+			name: "delete",
+			run: func(tx *gorm.DB) *gorm.DB {
+				return tx.Where("id = ?", "1").Delete(&scopedModel{})
+			},
+			want: `DELETE FROM "scoped_models" WHERE id = $1 AND "scoped_models"."tenant_id" = $2`,
+		},
+		{
+			name: "model without tenant",
+			run: func(tx *gorm.DB) *gorm.DB {
+				return tx.Where("id = ?", "1").Find(&[]unscopedModel{})
+			},
+			want: `SELECT * FROM "unscoped_models" WHERE id = $1`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := test.run(db.WithContext(ctx))
+			if result.Error != nil {
+				t.Fatalf("unexpected error: %v", result.Error)
+			}
+
+			sql := result.Statement.SQL.String()
+			if !strings.HasSuffix(sql, test.want) {
+				t.Errorf("got %s, want suffix %s", sql, test.want)
+			}
+
+			if strings.Contains(test.name, "without") {
+				return
+			}
+
+			if last := result.Statement.Vars[len(result.Statement.Vars)-1]; last != tenantId.String() {
+				t.Errorf("got tenant %v, want %s", last, tenantId)
+			}
+		})
+	}
+}
+
+func TestTenantScopeCreate(t *testing.T) {
+	db := dryRunDB(t)
+	tenantId := ulid.Make()
+	ctx := shared.WithTenant(context.Background(), tenantId)
+
+	models := []scopedModel{{ID: "1", TenantID: "other"}, {ID: "2"}}
+	if err := db.WithContext(ctx).Create(&models).Error; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, model := range models {
+		if model.TenantID != tenantId.String() {
+			t.Errorf("got tenant %s, want %s", model.TenantID, tenantId)
+		}
+	}
+}
+
+func TestTenantScopeWithoutTenant(t *testing.T) {
+	db := dryRunDB(t)
+
+	err := db.WithContext(context.Background()).Find(&[]scopedModel{}).Error
+	if !errors.Is(err, ErrTenantRequired) {
+		t.Errorf("got %v, want %v", err, ErrTenantRequired)
+	}
+
+	err = db.WithContext(context.Background()).Find(&[]unscopedModel{}).Error
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestTenantScopeAcrossTenants(t *testing.T) {
+	db := dryRunDB(t)
+
+	result := db.WithContext(shared.AcrossTenants(context.Background())).Where("id = ?", "1").Find(&[]scopedModel{})
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+
+	want := `SELECT * FROM "scoped_models" WHERE id = $1`
+	if sql := result.Statement.SQL.String(); sql != want {
+		t.Errorf("got %s, want %s", sql, want)
+	}
+}
@@ -4,4 +4,5 @@ type ServiceResponse struct {
 	Status  string `json:"status"`
 	Message string `json:"message"`
 	Data    any    `json:"data"`
+	Code    string `json:"code,omitempty"`
 }
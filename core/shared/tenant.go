@@ -0,0 +1,34 @@
+package shared
+
+import (
+	"context"
+
+	"github.com/oklog/ulid/v2"
+)
+
+type tenantKey struct{}
+
+type acrossTenantsKey struct{}
+
+// WithTenant returns a copy of ctx scoped to the account that owns the data
+// being read or written.
+func WithTenant(ctx context.Context, tenantId ulid.ULID) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantId)
+}
+
+// TenantFromContext returns the account the request is scoped to, if any.
+func TenantFromContext(ctx context.Context) (ulid.ULID, bool) {
+	tenantId, ok := ctx.Value(tenantKey{}).(ulid.ULID)
+	return tenantId, ok
+}
+
+// AcrossTenants returns a copy of ctx allowed to reach the data of every
+// tenant. It is meant for background jobs, never for requests.
+func AcrossTenants(ctx context.Context) context.Context {
+	return context.WithValue(ctx, acrossTenantsKey{}, true)
+}
+
+func IsAcrossTenants(ctx context.Context) bool {
+	across, _ := ctx.Value(acrossTenantsKey{}).(bool)
+	return across
+}
@@ -0,0 +1,193 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/document"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/pdf"
+)
+
+// Layout of the printed statement, in points.
+const (
+	pageMargin     = 40.0
+	rowHeight      = 15.0
+	tableSize      = 8.5
+	footerY        = pdf.PageHeight - 30
+	lastRowY       = footerY - 25
+	dateLayout     = "02/01/2006"
+	dateTimeLayout = "02/01/2006 15:04"
+)
+
+// Columns of the table of entries. Amounts are aligned to the right.
+const (
+	dateColumn        = pageMargin
+	kindColumn        = pageMargin + 55
+	descriptionColumn = pageMargin + 130
+	amountColumn      = pdf.PageWidth - pageMargin - 85
+	balanceColumn     = pdf.PageWidth - pageMargin
+)
+
+// PDF writes the statement on as many A4 pages as its entries take.
+func (s *Statement) PDF() []byte {
+	doc := pdf.New()
+	doc.SetTitle("Extrato de " + s.Client.Name)
+
+	page := doc.AddPage()
+	right := pdf.PageWidth - pageMargin
+	page.Text(pageMargin, 70, pdf.HelveticaBold, 20, "EXTRATO")
+	page.TextRight(right, 70, pdf.Helvetica, 9, "Emitido em "+s.GeneratedAt.Format(dateTimeLayout))
+
+	page.Text(pageMargin, 100, pdf.HelveticaBold, 12, s.Client.Name)
+	y := 100.0
+	if s.Client.Document != "" {
+		y += 14
+		page.Text(pageMargin, y, pdf.Helvetica, 9, document.Document(s.Client.Document).Labeled())
+	}
+	y += 14
+	page.Text(pageMargin, y, pdf.Helvetica, 9, "Período: "+s.periodText())
+
+	y = s.drawSummary(page, y+18)
+
+	y = drawTableHeader(page, y+25)
+	y = drawRow(page, y, pdf.Helvetica, "", "", "Saldo anterior", "", s.OpeningBalance.Format())
+
+	for _, entry := range s.Entries {
+		if y > lastRowY {
+			page = doc.AddPage()
+			y = drawTableHeader(page, 60)
+		}
+
+		y = drawRow(page, y, pdf.Helvetica, entry.Date.Format(dateLayout), entry.Kind.Name(),
+			entry.Description, entry.Amount.Format(), entry.Balance.Format())
+	}
+
+	if y > lastRowY {
+		page = doc.AddPage()
+		y = drawTableHeader(page, 60)
+	}
+	drawRow(page, y, pdf.HelveticaBold, "", "", "Saldo final", "", s.ClosingBalance.Format())
+
+	pages := doc.Pages()
+	for i, p := range pages {
+		p.Text(pageMargin, footerY, pdf.Helvetica, 7, "Extrato de "+s.Client.Name)
+		p.TextRight(right, footerY, pdf.Helvetica, 7, fmt.Sprintf("Página %d de %d", i+1, len(pages)))
+	}
+
+	return doc.Bytes()
+}
+
+// drawSummary draws the totals side by side in boxes, returning where the
+// boxes end.
+func (s *Statement) drawSummary(page *pdf.Page, y float64) float64 {
+	totals := []struct {
+		label string
+		value money.Money
+	}{
+		{"Cobrado no período", s.Summary.Charged},
+		{"Pago no período", s.Summary.Paid},
+		{"Em aberto", s.Summary.Open},
+		{"Em atraso", s.Summary.Overdue},
+	}
+
+	const gap, height = 10.0, 40.0
+	width := (pdf.PageWidth - 2*pageMargin - gap*float64(len(totals)-1)) / float64(len(totals))
+	for i, total := range totals {
+		x := pageMargin + float64(i)*(width+gap)
+		page.Rect(x, y, width, height, 0.5)
+		page.Text(x+8, y+14, pdf.Helvetica, 8, total.label)
+		page.TextRight(x+width-8, y+31, pdf.HelveticaBold, 12, total.value.Format())
+	}
+
+	return y + height
+}
+
+// drawTableHeader draws the titles of the columns, returning the baseline
+// of the first row.
+func drawTableHeader(page *pdf.Page, y float64) float64 {
+	page.FillRect(pageMargin, y-11, pdf.PageWidth-2*pageMargin, rowHeight+2, 0.9)
+	drawRow(page, y, pdf.HelveticaBold, "Data", "Tipo", "Descrição", "Valor", "Saldo")
+
+	return y + rowHeight + 4
+}
+
+// drawRow draws a row of the table, cutting the description to its column,
+// and returns the baseline of the next row.
+func drawRow(page *pdf.Page, y float64, font pdf.Font, date, kind, description, amount, balance string) float64 {
+	page.Text(dateColumn, y, font, tableSize, date)
+	page.Text(kindColumn, y, font, tableSize, kind)
+	page.Text(descriptionColumn, y, font, tableSize, fit(font, description, amountColumn-70-descriptionColumn))
+	page.TextRight(amountColumn, y, font, tableSize, amount)
+	page.TextRight(balanceColumn, y, font, tableSize, balance)
+
+	return y + rowHeight
+}
+
+// fit cuts the text to the width, ending it with an ellipsis when cut.
+func fit(font pdf.Font, text string, width float64) string {
+	if pdf.TextWidth(font, tableSize, text) <= width {
+		return text
+	}
+
+	runes := []rune(text)
+	for len(runes) > 0 && pdf.TextWidth(font, tableSize, string(runes)+"…") > width {
+		runes = runes[:len(runes)-1]
+	}
+
+	return strings.TrimSpace(string(runes)) + "…"
+}
+
+func (s *Statement) periodText() string {
+	switch {
+	case s.Period.From != nil && s.Period.To != nil:
+		return s.Period.From.Format(dateLayout) + " a " + s.Period.To.Format(dateLayout)
+	case s.Period.From != nil:
+		return "a partir de " + s.Period.From.Format(dateLayout)
+	case s.Period.To != nil:
+		return "até " + s.Period.To.Format(dateLayout)
+	}
+
+	return "todo o histórico"
+}
+
+// CSV writes the entries of the statement the way spreadsheets in Brazil
+// open them: separated by semicolons, with decimal commas and a byte order
+// mark telling the text is UTF-8.
+func (s *Statement) CSV() []byte {
+	var buf bytes.Buffer
+	buf.WriteString("\ufeff")
+
+	w := csv.NewWriter(&buf)
+	w.Comma = ';'
+	w.Write([]string{"data", "tipo", "descricao", "valor", "saldo", "divida", "parcela"})
+	w.Write([]string{"", "", "Saldo anterior", "", csvAmount(s.OpeningBalance), "", ""})
+
+	for _, entry := range s.Entries {
+		installmentId := ""
+		if entry.InstallmentId != nil {
+			installmentId = entry.InstallmentId.String()
+		}
+
+		w.Write([]string{
+			entry.Date.Format(dateLayout),
+			entry.Kind.Name(),
+			entry.Description,
+			csvAmount(entry.Amount),
+			csvAmount(entry.Balance),
+			entry.DebtId.String(),
+			installmentId,
+		})
+	}
+
+	w.Write([]string{"", "", "Saldo final", "", csvAmount(s.ClosingBalance), "", ""})
+	w.Flush()
+
+	return buf.Bytes()
+}
+
+func csvAmount(amount money.Money) string {
+	return strings.Replace(amount.String(), ".", ",", 1)
+}
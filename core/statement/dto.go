@@ -0,0 +1,43 @@
+package statement
+
+import "github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+
+// StatementRequestDto is the period of the statement, both days included,
+// and the format it is exported as: json, the default, pdf or csv.
+type StatementRequestDto struct {
+	From   string `json:"from" validate:"omitempty,dateFormat:YYYY-MM-DD"`
+	To     string `json:"to" validate:"omitempty,dateFormat:YYYY-MM-DD"`
+	Format string `json:"format" validate:"omitempty,oneof=json pdf csv"`
+}
+
+type StatementDto struct {
+	ClientId       string      `json:"client_id"`
+	ClientName     string      `json:"client_name"`
+	ClientDocument string      `json:"client_document"`
+	From           string      `json:"from,omitempty"`
+	To             string      `json:"to,omitempty"`
+	OpeningBalance money.Money `json:"opening_balance"`
+	ClosingBalance money.Money `json:"closing_balance"`
+	Summary        SummaryDto  `json:"summary"`
+	Entries        []EntryDto  `json:"entries"`
+	GeneratedAt    string      `json:"generated_at"`
+	// File is the statement exported as pdf or csv, served on its own.
+	File []byte `json:"-"`
+}
+
+type SummaryDto struct {
+	Charged money.Money `json:"charged"`
+	Paid    money.Money `json:"paid"`
+	Open    money.Money `json:"open"`
+	Overdue money.Money `json:"overdue"`
+}
+
+type EntryDto struct {
+	Date          string      `json:"date"`
+	Kind          string      `json:"kind"`
+	Description   string      `json:"description"`
+	DebtId        string      `json:"debt_id"`
+	InstallmentId string      `json:"installment_id,omitempty"`
+	Amount        money.Money `json:"amount"`
+	Balance       money.Money `json:"balance"`
+}
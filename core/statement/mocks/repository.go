@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: statement/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=statement/repository.go -destination=statement/mocks/repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	client "github.com/henriquerocha2004/quem-me-deve-api/core/client"
+	debt "github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	ulid "github.com/oklog/ulid/v2"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDebtReader is a mock of DebtReader interface.
+type MockDebtReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockDebtReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockDebtReaderMockRecorder is the mock recorder for MockDebtReader.
+type MockDebtReaderMockRecorder struct {
+	mock *MockDebtReader
+}
+
+// NewMockDebtReader creates a new mock instance.
+func NewMockDebtReader(ctrl *gomock.Controller) *MockDebtReader {
+	mock := &MockDebtReader{ctrl: ctrl}
+	mock.recorder = &MockDebtReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDebtReader) EXPECT() *MockDebtReaderMockRecorder {
+	return m.recorder
+}
+
+// ClientUserDebts mocks base method.
+func (m *MockDebtReader) ClientUserDebts(ctx context.Context, clientUserId ulid.ULID) ([]*debt.Debt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientUserDebts", ctx, clientUserId)
+	ret0, _ := ret[0].([]*debt.Debt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClientUserDebts indicates an expected call of ClientUserDebts.
+func (mr *MockDebtReaderMockRecorder) ClientUserDebts(ctx, clientUserId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientUserDebts", reflect.TypeOf((*MockDebtReader)(nil).ClientUserDebts), ctx, clientUserId)
+}
+
+// MockClientFinder is a mock of ClientFinder interface.
+type MockClientFinder struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientFinderMockRecorder
+	isgomock struct{}
+}
+
+// MockClientFinderMockRecorder is the mock recorder for MockClientFinder.
+type MockClientFinderMockRecorder struct {
+	mock *MockClientFinder
+}
+
+// NewMockClientFinder creates a new mock instance.
+func NewMockClientFinder(ctrl *gomock.Controller) *MockClientFinder {
+	mock := &MockClientFinder{ctrl: ctrl}
+	mock.recorder = &MockClientFinderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientFinder) EXPECT() *MockClientFinderMockRecorder {
+	return m.recorder
+}
+
+// FindById mocks base method.
+func (m *MockClientFinder) FindById(ctx context.Context, id ulid.ULID) (*client.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*client.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockClientFinderMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockClientFinder)(nil).FindById), ctx, id)
+}
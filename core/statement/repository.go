@@ -0,0 +1,20 @@
+package statement
+
+import (
+	"context"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/client"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	"github.com/oklog/ulid/v2"
+)
+
+// DebtReader finds every debt of the client, with their installments and
+// payments.
+type DebtReader interface {
+	ClientUserDebts(ctx context.Context, clientUserId ulid.ULID) ([]*debt.Debt, error)
+}
+
+// ClientFinder finds the client the statement is about.
+type ClientFinder interface {
+	FindById(ctx context.Context, id ulid.ULID) (*client.Client, error)
+}
@@ -0,0 +1,150 @@
+package statement
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/oklog/ulid/v2"
+)
+
+type Service interface {
+	Statement(ctx context.Context, clientId ulid.ULID, request *StatementRequestDto) shared.ServiceResponse
+}
+
+type StatementService struct {
+	debts   DebtReader
+	clients ClientFinder
+}
+
+func NewStatementService(debts DebtReader, clients ClientFinder) *StatementService {
+	return &StatementService{
+		debts:   debts,
+		clients: clients,
+	}
+}
+
+// Statement makes the statement of the client for the period asked, exported
+// as the format asked.
+func (s *StatementService) Statement(ctx context.Context, clientId ulid.ULID, request *StatementRequestDto) shared.ServiceResponse {
+	period, ok := s.parsePeriod(request)
+	if !ok {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "from must not be after to",
+		}
+	}
+
+	client, err := s.clients.FindById(ctx, clientId)
+	if err != nil {
+		log.Println("Error finding client:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in find client",
+		}
+	}
+
+	if client == nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "client not found",
+		}
+	}
+
+	debts, err := s.debts.ClientUserDebts(ctx, clientId)
+	if err != nil {
+		log.Println("Error retrieving debts:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error retrieving debts",
+		}
+	}
+
+	statement := New(Client{
+		Id:       client.Id,
+		Name:     strings.TrimSpace(client.Name + " " + client.LastName),
+		Document: string(client.Document),
+	}, debts, period, time.Now())
+
+	statementDto := s.convertToStatementDto(statement)
+	switch request.Format {
+	case "pdf":
+		statementDto.File = statement.PDF()
+	case "csv":
+		statementDto.File = statement.CSV()
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "statement generated successfully",
+		Data:    statementDto,
+	}
+}
+
+// parsePeriod reads the days of the period, already validated, in the time
+// zone of the server, reporting false when it ends before it starts.
+func (s *StatementService) parsePeriod(request *StatementRequestDto) (Period, bool) {
+	var period Period
+	if request.From != "" {
+		from, _ := time.ParseInLocation(time.DateOnly, request.From, time.Local)
+		period.From = &from
+	}
+
+	if request.To != "" {
+		to, _ := time.ParseInLocation(time.DateOnly, request.To, time.Local)
+		period.To = &to
+	}
+
+	if period.From != nil && period.To != nil && period.To.Before(*period.From) {
+		return period, false
+	}
+
+	return period, true
+}
+
+func (s *StatementService) convertToStatementDto(statement *Statement) StatementDto {
+	statementDto := StatementDto{
+		ClientId:       statement.Client.Id.String(),
+		ClientName:     statement.Client.Name,
+		ClientDocument: statement.Client.Document,
+		OpeningBalance: statement.OpeningBalance,
+		ClosingBalance: statement.ClosingBalance,
+		Summary: SummaryDto{
+			Charged: statement.Summary.Charged,
+			Paid:    statement.Summary.Paid,
+			Open:    statement.Summary.Open,
+			Overdue: statement.Summary.Overdue,
+		},
+		Entries:     []EntryDto{},
+		GeneratedAt: statement.GeneratedAt.Format(time.DateTime),
+	}
+
+	if statement.Period.From != nil {
+		statementDto.From = statement.Period.From.Format(time.DateOnly)
+	}
+
+	if statement.Period.To != nil {
+		statementDto.To = statement.Period.To.Format(time.DateOnly)
+	}
+
+	for _, entry := range statement.Entries {
+		entryDto := EntryDto{
+			Date:        entry.Date.Format(time.DateTime),
+			Kind:        string(entry.Kind),
+			Description: entry.Description,
+			DebtId:      entry.DebtId.String(),
+			Amount:      entry.Amount,
+			Balance:     entry.Balance,
+		}
+
+		if entry.InstallmentId != nil {
+			entryDto.InstallmentId = entry.InstallmentId.String()
+		}
+
+		statementDto.Entries = append(statementDto.Entries, entryDto)
+	}
+
+	return statementDto
+}
@@ -0,0 +1,105 @@
+package statement_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/client"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/statement"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/statement/mocks"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestStatementService(t *testing.T) {
+	ctx := context.Background()
+	clientId := ulid.Make()
+	cli := &client.Client{Id: clientId, Name: "João", LastName: "Silva", Document: "52998224725"}
+
+	t.Run("Deve gerar o extrato do cliente", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		debts := mocks.NewMockDebtReader(ctrl)
+		clients := mocks.NewMockClientFinder(ctrl)
+		service := statement.NewStatementService(debts, clients)
+
+		clients.EXPECT().FindById(gomock.Any(), clientId).Return(cli, nil)
+		debts.EXPECT().ClientUserDebts(gomock.Any(), clientId).Return([]*debt.Debt{paidDebt()}, nil)
+
+		response := service.Statement(ctx, clientId, &statement.StatementRequestDto{From: "2026-03-01", To: "2026-03-31"})
+
+		assert.Equal(t, "success", response.Status)
+		statementDto := response.Data.(statement.StatementDto)
+		assert.Equal(t, "João Silva", statementDto.ClientName)
+		assert.Equal(t, "2026-03-01", statementDto.From)
+		assert.Len(t, statementDto.Entries, 4)
+		assert.Equal(t, "payment", statementDto.Entries[1].Kind)
+		assert.NotEmpty(t, statementDto.Entries[1].InstallmentId)
+		assert.Nil(t, statementDto.File)
+	})
+
+	t.Run("Deve exportar o extrato no formato pedido", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		debts := mocks.NewMockDebtReader(ctrl)
+		clients := mocks.NewMockClientFinder(ctrl)
+		service := statement.NewStatementService(debts, clients)
+
+		clients.EXPECT().FindById(gomock.Any(), clientId).Return(cli, nil).Times(2)
+		debts.EXPECT().ClientUserDebts(gomock.Any(), clientId).Return([]*debt.Debt{paidDebt()}, nil).Times(2)
+
+		response := service.Statement(ctx, clientId, &statement.StatementRequestDto{Format: "pdf"})
+		assert.True(t, bytes.HasPrefix(response.Data.(statement.StatementDto).File, []byte("%PDF-")))
+
+		response = service.Statement(ctx, clientId, &statement.StatementRequestDto{Format: "csv"})
+		assert.True(t, bytes.Contains(response.Data.(statement.StatementDto).File, []byte("data;tipo;descricao")))
+	})
+
+	t.Run("Deve recusar um período que termina antes de começar", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service := statement.NewStatementService(mocks.NewMockDebtReader(ctrl), mocks.NewMockClientFinder(ctrl))
+
+		response := service.Statement(ctx, clientId, &statement.StatementRequestDto{From: "2026-03-31", To: "2026-03-01"})
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "from must not be after to", response.Message)
+	})
+
+	t.Run("Deve retornar erro quando o cliente não for encontrado", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clients := mocks.NewMockClientFinder(ctrl)
+		clients.EXPECT().FindById(gomock.Any(), clientId).Return(nil, nil)
+		service := statement.NewStatementService(mocks.NewMockDebtReader(ctrl), clients)
+
+		response := service.Statement(ctx, clientId, &statement.StatementRequestDto{})
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "client not found", response.Message)
+	})
+
+	t.Run("Deve retornar erro quando falhar ao buscar as dívidas", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		debts := mocks.NewMockDebtReader(ctrl)
+		clients := mocks.NewMockClientFinder(ctrl)
+		clients.EXPECT().FindById(gomock.Any(), clientId).Return(cli, nil)
+		debts.EXPECT().ClientUserDebts(gomock.Any(), clientId).Return(nil, errors.New("db error"))
+		service := statement.NewStatementService(debts, clients)
+
+		response := service.Statement(ctx, clientId, &statement.StatementRequestDto{})
+
+		assert.Equal(t, "error", response.Status)
+		assert.Equal(t, "error retrieving debts", response.Message)
+	})
+}
@@ -0,0 +1,269 @@
+package statement
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+)
+
+// Kind is what made an entry of the statement.
+type Kind string
+
+const (
+	// Charge is a sale charged to the client, or the fine and interest of an
+	// installment paid or renegotiated late.
+	Charge        Kind = "charge"
+	Payment       Kind = "payment"
+	Cancellation  Kind = "cancellation"
+	Reversal      Kind = "reversal"
+	Renegotiation Kind = "renegotiation"
+)
+
+var kindNames = map[Kind]string{
+	Charge:        "Cobrança",
+	Payment:       "Pagamento",
+	Cancellation:  "Cancelamento",
+	Reversal:      "Estorno",
+	Renegotiation: "Renegociação",
+}
+
+// Name writes the kind the way it is printed for the client.
+func (k Kind) Name() string {
+	return kindNames[k]
+}
+
+// Entry is a line of the statement. Charges increase what the client owes
+// and the other kinds decrease it. Balance is what the client owed right
+// after the entry.
+type Entry struct {
+	Date          time.Time
+	Kind          Kind
+	DebtId        ulid.ULID
+	InstallmentId *ulid.ULID
+	Description   string
+	Amount        money.Money
+	Balance       money.Money
+}
+
+// Period limits the entries of the statement by their date, both days
+// included. A period without From starts at the first entry and one without
+// To ends at the last.
+type Period struct {
+	From *time.Time
+	To   *time.Time
+}
+
+func (p Period) before(date time.Time) bool {
+	return p.From != nil && date.Before(*p.From)
+}
+
+func (p Period) after(date time.Time) bool {
+	return p.To != nil && !date.Before(p.To.AddDate(0, 0, 1))
+}
+
+// Summary totals the statement. Charged and Paid are the amounts of the
+// period, while Open and Overdue are what the client owes at the moment the
+// statement is made, whatever the period, with the fine and interest of the
+// installments late by then.
+type Summary struct {
+	Charged money.Money
+	Paid    money.Money
+	Open    money.Money
+	Overdue money.Money
+}
+
+// Client is the client the statement is about, as printed on it.
+type Client struct {
+	Id       ulid.ULID
+	Name     string
+	Document string
+}
+
+// Statement is the ledger of a client: every charge, payment, cancellation
+// and reversal of their debts in chronological order, with the running
+// balance. The entries before the period are summed up in the opening
+// balance.
+type Statement struct {
+	Client         Client
+	Period         Period
+	OpeningBalance money.Money
+	Entries        []Entry
+	ClosingBalance money.Money
+	Summary        Summary
+	GeneratedAt    time.Time
+}
+
+// New makes the statement of the client from their debts, as of now.
+func New(client Client, debts []*debt.Debt, period Period, now time.Time) *Statement {
+	// The entries of a debt are told in the order they happen, so that the
+	// ones made at the same moment keep it: the debts go from the oldest, a
+	// renegotiated debt right after the one it replaced.
+	ordered := make([]*debt.Debt, len(debts))
+	copy(ordered, debts)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		di, dj := debtDate(ordered[i]), debtDate(ordered[j])
+		if di.Equal(dj) {
+			return ordered[i].OriginDebtId == nil && ordered[j].OriginDebtId != nil
+		}
+
+		return di.Before(dj)
+	})
+
+	var entries []Entry
+	for _, d := range ordered {
+		entries = append(entries, debtEntries(d)...)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Date.Before(entries[j].Date)
+	})
+
+	s := &Statement{
+		Client:      client,
+		Period:      period,
+		GeneratedAt: now,
+	}
+
+	balance := money.Money{}
+	for _, entry := range entries {
+		if period.after(entry.Date) {
+			break
+		}
+
+		balance = balance.Add(entry.Amount)
+		entry.Balance = balance
+
+		if period.before(entry.Date) {
+			s.OpeningBalance = balance
+			continue
+		}
+
+		switch entry.Kind {
+		case Charge:
+			s.Summary.Charged = s.Summary.Charged.Add(entry.Amount)
+		case Payment:
+			s.Summary.Paid = s.Summary.Paid.Sub(entry.Amount)
+		}
+
+		s.Entries = append(s.Entries, entry)
+	}
+
+	s.ClosingBalance = balance
+
+	for _, d := range debts {
+		for _, installment := range d.Intallments {
+			if !installment.IsOpen() {
+				continue
+			}
+
+			charges := d.ChargePolicy.Calculate(installment.Value, installment.DueDate, now)
+			open := charges.Total().Sub(installment.PaidAmount())
+			if !open.IsPositive() {
+				continue
+			}
+
+			s.Summary.Open = s.Summary.Open.Add(open)
+			if installment.Status == debt.Overdue || installment.IsOverdue(now) {
+				s.Summary.Overdue = s.Summary.Overdue.Add(open)
+			}
+		}
+	}
+
+	return s
+}
+
+// debtEntries tells the history of the debt as entries, in the order it
+// happened. Cancellations, reversals and renegotiations close what was left
+// of the debt, so that it adds nothing more to the balance afterwards; what
+// is renegotiated comes back as the charge of the new debt.
+func debtEntries(d *debt.Debt) []Entry {
+	var entries []Entry
+	add := func(date *time.Time, kind Kind, installmentId *ulid.ULID, description string, amount money.Money) {
+		if date == nil {
+			return
+		}
+
+		entries = append(entries, Entry{
+			Date:          *date,
+			Kind:          kind,
+			DebtId:        d.Id,
+			InstallmentId: installmentId,
+			Description:   description,
+			Amount:        amount,
+		})
+	}
+
+	description := d.Description
+	if d.OriginDebtId != nil {
+		description = "Renegociação de " + d.Description
+	}
+
+	if date := debtDate(d); !date.IsZero() {
+		add(&date, Charge, nil, description, d.TotalValue)
+	}
+
+	balance := d.TotalValue
+	for _, installment := range d.Intallments {
+		installmentId := installment.Id
+
+		lateCharges := installment.Charges.Fine.Add(installment.Charges.Interest)
+		if lateCharges.IsPositive() {
+			chargedAt := installment.PaymentDate
+			if installment.Status == debt.Renegotiated && d.RenegotiationInfo != nil {
+				chargedAt = d.RenegotiationInfo.RenegotiationDate
+			}
+
+			add(chargedAt, Charge, &installmentId,
+				fmt.Sprintf("Multa e juros da parcela %d - %s", installment.Number, d.Description), lateCharges)
+			balance = balance.Add(lateCharges)
+		}
+
+		for _, payment := range installment.Payments {
+			add(payment.PaymentDate, Payment, &installmentId,
+				fmt.Sprintf("%s - %s", installmentName(d, installment), d.Description), money.Money{}.Sub(payment.Amount))
+			balance = balance.Sub(payment.Amount)
+		}
+	}
+
+	closing := money.Money{}.Sub(balance)
+	if d.CancelInfo != nil {
+		add(d.CancelInfo.CancelDate, Cancellation, nil, "Cancelamento de "+d.Description, closing)
+	}
+
+	if d.ReversalInfo != nil {
+		add(d.ReversalInfo.ReversalDate, Reversal, nil, "Estorno de "+d.Description, closing)
+	}
+
+	if d.RenegotiationInfo != nil {
+		add(d.RenegotiationInfo.RenegotiationDate, Renegotiation, nil, "Saldo renegociado de "+d.Description, closing)
+	}
+
+	return entries
+}
+
+// installmentName is how the payment is told apart: the down payment or
+// the installment among the others of the debt.
+func installmentName(d *debt.Debt, installment debt.Installment) string {
+	if installment.Number == 0 {
+		return "Pagamento da entrada"
+	}
+
+	return fmt.Sprintf("Pagamento da parcela %d/%d", installment.Number, d.InstallmentsQuantity)
+}
+
+// debtDate is when the debt was made, or the zero time when it is unknown.
+func debtDate(d *debt.Debt) time.Time {
+	if d.DebtDate != nil {
+		return *d.DebtDate
+	}
+
+	if len(d.Intallments) > 0 && d.Intallments[0].DebDate != nil {
+		return *d.Intallments[0].DebDate
+	}
+
+	return time.Time{}
+}
@@ -0,0 +1,203 @@
+package statement_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/statement"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func day(d int) *time.Time {
+	date := time.Date(2026, 3, d, 10, 0, 0, 0, time.Local)
+	return &date
+}
+
+func paidDebt() *debt.Debt {
+	return &debt.Debt{
+		Id:                   ulid.Make(),
+		Description:          "Corte e barba",
+		TotalValue:           money.FromCents(10000),
+		InstallmentsQuantity: 2,
+		DebtDate:             day(1),
+		Status:               debt.PartiallyPaid,
+		Intallments: []debt.Installment{
+			{
+				Id:      ulid.Make(),
+				Value:   money.FromCents(5000),
+				DueDate: day(5),
+				Status:  debt.Paid,
+				Number:  1,
+				Charges: debt.ChargeBreakdown{
+					Principal: money.FromCents(5000),
+					Fine:      money.FromCents(100),
+					Interest:  money.FromCents(50),
+				},
+				PaymentDate: day(8),
+				Payments: []debt.Payment{
+					{Id: ulid.Make(), Amount: money.FromCents(2000), Method: "pix", PaymentDate: day(6)},
+					{Id: ulid.Make(), Amount: money.FromCents(3150), Method: "cash", PaymentDate: day(8)},
+				},
+			},
+			{
+				Id:      ulid.Make(),
+				Value:   money.FromCents(5000),
+				DueDate: day(20),
+				Status:  debt.Overdue,
+				Number:  2,
+			},
+		},
+	}
+}
+
+func TestStatement(t *testing.T) {
+	client := statement.Client{Id: ulid.Make(), Name: "João Silva", Document: "52998224725"}
+
+	t.Run("Deve listar os lançamentos em ordem cronológica com o saldo corrente", func(t *testing.T) {
+		s := statement.New(client, []*debt.Debt{paidDebt()}, statement.Period{}, *day(25))
+
+		kinds := []statement.Kind{}
+		balances := []money.Money{}
+		for _, entry := range s.Entries {
+			kinds = append(kinds, entry.Kind)
+			balances = append(balances, entry.Balance)
+		}
+
+		assert.Equal(t, []statement.Kind{statement.Charge, statement.Payment, statement.Charge, statement.Payment}, kinds)
+		assert.Equal(t, []money.Money{
+			money.FromCents(10000),
+			money.FromCents(8000),
+			money.FromCents(8150),
+			money.FromCents(5000),
+		}, balances)
+		assert.Equal(t, "Pagamento da parcela 1/2 - Corte e barba", s.Entries[1].Description)
+		assert.True(t, s.OpeningBalance.IsZero())
+		assert.Equal(t, money.FromCents(5000), s.ClosingBalance)
+		assert.Equal(t, money.FromCents(10150), s.Summary.Charged)
+		assert.Equal(t, money.FromCents(5150), s.Summary.Paid)
+		assert.Equal(t, money.FromCents(5000), s.Summary.Open)
+		assert.Equal(t, money.FromCents(5000), s.Summary.Overdue)
+	})
+
+	t.Run("Deve somar multa e juros ao saldo em aberto das parcelas atrasadas", func(t *testing.T) {
+		d := paidDebt()
+		d.ChargePolicy = debt.ChargePolicy{FinePercentage: 2, InterestRate: 0.1, InterestPeriod: debt.DailyInterest}
+
+		s := statement.New(client, []*debt.Debt{d}, statement.Period{}, *day(25))
+
+		assert.Equal(t, money.FromCents(5000), s.ClosingBalance)
+		assert.Equal(t, money.FromCents(5125), s.Summary.Open)
+		assert.Equal(t, money.FromCents(5125), s.Summary.Overdue)
+	})
+
+	t.Run("Deve resumir os lançamentos anteriores ao período no saldo anterior", func(t *testing.T) {
+		s := statement.New(client, []*debt.Debt{paidDebt()}, statement.Period{
+			From: day(7),
+			To:   day(7),
+		}, *day(25))
+
+		assert.Empty(t, s.Entries)
+		assert.Equal(t, money.FromCents(8000), s.OpeningBalance)
+		assert.Equal(t, money.FromCents(8000), s.ClosingBalance)
+		assert.True(t, s.Summary.Paid.IsZero())
+
+		s = statement.New(client, []*debt.Debt{paidDebt()}, statement.Period{From: day(6), To: day(8)}, *day(25))
+
+		assert.Len(t, s.Entries, 3)
+		assert.Equal(t, money.FromCents(10000), s.OpeningBalance)
+		assert.Equal(t, money.FromCents(5000), s.ClosingBalance)
+		assert.Equal(t, money.FromCents(150), s.Summary.Charged)
+	})
+
+	t.Run("Deve zerar o saldo da dívida cancelada ou estornada", func(t *testing.T) {
+		canceled := &debt.Debt{
+			Id:          ulid.Make(),
+			Description: "Produto",
+			TotalValue:  money.FromCents(3000),
+			DebtDate:    day(2),
+			Status:      debt.Canceled,
+			CancelInfo:  &debt.CancelInfo{Reason: "desistência", CancelDate: day(3)},
+		}
+		reversed := paidDebt()
+		reversed.Status = debt.Reversed
+		reversed.ReversalInfo = &debt.ReversalInfo{Reason: "devolução", ReversalDate: day(10)}
+		reversed.Intallments[1].Status = debt.Canceled
+
+		s := statement.New(client, []*debt.Debt{reversed, canceled}, statement.Period{}, *day(25))
+
+		last := s.Entries[len(s.Entries)-1]
+		assert.Equal(t, statement.Reversal, last.Kind)
+		assert.Equal(t, money.FromCents(-5000), last.Amount)
+		assert.Equal(t, statement.Cancellation, s.Entries[2].Kind)
+		assert.Equal(t, money.FromCents(-3000), s.Entries[2].Amount)
+		assert.True(t, s.ClosingBalance.IsZero())
+		assert.True(t, s.Summary.Open.IsZero())
+	})
+
+	t.Run("Deve transferir o saldo renegociado para a nova dívida", func(t *testing.T) {
+		original := paidDebt()
+		renegotiatedAt := day(22)
+		newDebt := &debt.Debt{
+			Id:           ulid.Make(),
+			Description:  "Corte e barba",
+			TotalValue:   money.FromCents(5300),
+			DebtDate:     renegotiatedAt,
+			Status:       debt.Pending,
+			OriginDebtId: &original.Id,
+		}
+		original.Status = debt.Renegotiated
+		original.Intallments[1].Status = debt.Renegotiated
+		original.Intallments[1].Charges = debt.ChargeBreakdown{
+			Principal: money.FromCents(5000),
+			Fine:      money.FromCents(100),
+			Interest:  money.FromCents(20),
+		}
+		original.RenegotiationInfo = &debt.RenegotiationInfo{
+			RenegotiatedDebtId: newDebt.Id,
+			OpenBalance:        money.FromCents(5120),
+			RenegotiationDate:  renegotiatedAt,
+		}
+
+		s := statement.New(client, []*debt.Debt{newDebt, original}, statement.Period{}, *day(25))
+
+		n := len(s.Entries)
+		assert.Equal(t, statement.Charge, s.Entries[n-3].Kind)
+		assert.Equal(t, money.FromCents(120), s.Entries[n-3].Amount)
+		assert.Equal(t, statement.Renegotiation, s.Entries[n-2].Kind)
+		assert.Equal(t, money.FromCents(-5120), s.Entries[n-2].Amount)
+		assert.True(t, s.Entries[n-2].Balance.IsZero())
+		assert.Equal(t, "Renegociação de Corte e barba", s.Entries[n-1].Description)
+		assert.Equal(t, money.FromCents(5300), s.ClosingBalance)
+	})
+
+	t.Run("Deve exportar o extrato em PDF e CSV", func(t *testing.T) {
+		s := statement.New(client, []*debt.Debt{paidDebt()}, statement.Period{From: day(1), To: day(31)}, *day(25))
+
+		document := s.PDF()
+		assert.True(t, bytes.HasPrefix(document, []byte("%PDF-")))
+		assert.True(t, bytes.Contains(document, []byte("CPF 529.982.247-25")))
+		assert.True(t, bytes.Contains(document, []byte("01/03/2026 a 31/03/2026")))
+
+		lines := strings.Split(strings.TrimSpace(strings.TrimPrefix(string(s.CSV()), "\ufeff")), "\n")
+		assert.Len(t, lines, 7)
+		assert.Equal(t, "data;tipo;descricao;valor;saldo;divida;parcela", lines[0])
+		assert.True(t, strings.HasPrefix(lines[3], "06/03/2026;Pagamento;Pagamento da parcela 1/2 - Corte e barba;-20,00;80,00;"))
+		assert.Equal(t, ";;Saldo final;;50,00;;", lines[6])
+	})
+
+	t.Run("Deve paginar o PDF de extratos longos", func(t *testing.T) {
+		var debts []*debt.Debt
+		for range 40 {
+			debts = append(debts, paidDebt())
+		}
+
+		s := statement.New(client, debts, statement.Period{}, *day(25))
+
+		assert.True(t, bytes.Contains(s.PDF(), []byte("/Count 4")))
+	})
+}
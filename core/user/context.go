@@ -0,0 +1,20 @@
+package user
+
+import (
+	"context"
+
+	"github.com/oklog/ulid/v2"
+)
+
+type contextKey struct{}
+
+// WithUserId returns a copy of ctx carrying the authenticated user.
+func WithUserId(ctx context.Context, userId ulid.ULID) context.Context {
+	return context.WithValue(ctx, contextKey{}, userId)
+}
+
+// IdFromContext returns the authenticated user of the request, if any.
+func IdFromContext(ctx context.Context) (ulid.ULID, bool) {
+	userId, ok := ctx.Value(contextKey{}).(ulid.ULID)
+	return userId, ok
+}
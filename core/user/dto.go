@@ -0,0 +1,45 @@
+package user
+
+type RegisterDto struct {
+	Name     string `json:"name" validate:"required"`
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required,min=8"`
+}
+
+type LoginDto struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+}
+
+type RefreshDto struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+type TokenDto struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+type UserDto struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type PixAccountDto struct {
+	Key          string `json:"key" validate:"required"`
+	MerchantName string `json:"merchant_name" validate:"required"`
+	MerchantCity string `json:"merchant_city" validate:"required"`
+}
+
+type BoletoAccountDto struct {
+	Bank                string `json:"bank" validate:"required,len=3,numeric"`
+	Agency              string `json:"agency" validate:"required"`
+	Account             string `json:"account" validate:"required"`
+	Wallet              string `json:"wallet" validate:"required"`
+	Agreement           string `json:"agreement"`
+	BeneficiaryName     string `json:"beneficiary_name" validate:"required"`
+	BeneficiaryDocument string `json:"beneficiary_document" validate:"required"`
+}
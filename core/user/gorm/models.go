@@ -0,0 +1,37 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type User struct {
+	ID                  string     `gorm:"column:id;primaryKey;type:char(26)"`
+	Name                string     `gorm:"column:name;type:text;not null"`
+	Email               string     `gorm:"column:email;type:varchar(255);not null"`
+	PasswordHash        string     `gorm:"column:password_hash;type:varchar(255);not null"`
+	CreatedAt           *time.Time `gorm:"column:created_at;type:timestamp"`
+	PixKey              *string    `gorm:"column:pix_key;type:varchar(77)"`
+	PixName             *string    `gorm:"column:pix_merchant_name;type:varchar(25)"`
+	PixCity             *string    `gorm:"column:pix_merchant_city;type:varchar(15)"`
+	BoletoBank          *string    `gorm:"column:boleto_bank;type:char(3)"`
+	BoletoAgency        *string    `gorm:"column:boleto_agency;type:varchar(10)"`
+	BoletoAccount       *string    `gorm:"column:boleto_account;type:varchar(15)"`
+	BoletoWallet        *string    `gorm:"column:boleto_wallet;type:varchar(3)"`
+	BoletoAgreement     *string    `gorm:"column:boleto_agreement;type:varchar(10)"`
+	BeneficiaryName     *string    `gorm:"column:boleto_beneficiary_name;type:varchar(255)"`
+	BeneficiaryDocument *string    `gorm:"column:boleto_beneficiary_document;type:varchar(14)"`
+}
+
+func (d *User) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == "" {
+		d.ID = ulid.Make().String()
+	}
+	return nil
+}
+
+func (d *User) TableName() string {
+	return "users"
+}
@@ -0,0 +1,115 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/boleto"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type GormUserRepository struct {
+	db *gorm.DB
+}
+
+func NewGormUserRepository(db *gorm.DB) *GormUserRepository {
+	return &GormUserRepository{db: db}
+}
+
+func (u *GormUserRepository) Create(ctx context.Context, user *user.User) error {
+	model := User{
+		ID:           user.Id.String(),
+		Name:         user.Name,
+		Email:        user.Email,
+		PasswordHash: user.PasswordHash,
+		CreatedAt:    user.CreatedAt,
+	}
+
+	return u.db.WithContext(ctx).Create(&model).Error
+}
+
+func (u *GormUserRepository) SavePixAccount(ctx context.Context, id ulid.ULID, account *user.PixAccount) error {
+	return u.db.WithContext(ctx).Model(&User{}).
+		Where("id = ?", id.String()).
+		Updates(map[string]any{
+			"pix_key":           account.Key,
+			"pix_merchant_name": account.MerchantName,
+			"pix_merchant_city": account.MerchantCity,
+		}).Error
+}
+
+func (u *GormUserRepository) SaveBoletoAccount(ctx context.Context, id ulid.ULID, account *user.BoletoAccount) error {
+	return u.db.WithContext(ctx).Model(&User{}).
+		Where("id = ?", id.String()).
+		Updates(map[string]any{
+			"boleto_bank":                 account.Account.Bank,
+			"boleto_agency":               account.Account.Agency,
+			"boleto_account":              account.Account.Number,
+			"boleto_wallet":               account.Account.Wallet,
+			"boleto_agreement":            account.Account.Agreement,
+			"boleto_beneficiary_name":     account.BeneficiaryName,
+			"boleto_beneficiary_document": account.BeneficiaryDocument,
+		}).Error
+}
+
+func (u *GormUserRepository) FindById(ctx context.Context, id ulid.ULID) (*user.User, error) {
+	return u.findBy(ctx, "id = ?", id.String())
+}
+
+func (u *GormUserRepository) FindByEmail(ctx context.Context, email string) (*user.User, error) {
+	return u.findBy(ctx, "email = ?", email)
+}
+
+func (u *GormUserRepository) findBy(ctx context.Context, query string, arg any) (*user.User, error) {
+	var model User
+
+	err := u.db.WithContext(ctx).Where(query, arg).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	found := &user.User{
+		Id:           ulid.MustParse(model.ID),
+		Name:         model.Name,
+		Email:        model.Email,
+		PasswordHash: model.PasswordHash,
+		CreatedAt:    model.CreatedAt,
+	}
+
+	if model.PixKey != nil {
+		found.Pix = &user.PixAccount{
+			Key:          *model.PixKey,
+			MerchantName: valueOf(model.PixName),
+			MerchantCity: valueOf(model.PixCity),
+		}
+	}
+
+	if model.BoletoBank != nil {
+		found.Boleto = &user.BoletoAccount{
+			Account: boleto.Account{
+				Bank:      *model.BoletoBank,
+				Agency:    valueOf(model.BoletoAgency),
+				Number:    valueOf(model.BoletoAccount),
+				Wallet:    valueOf(model.BoletoWallet),
+				Agreement: valueOf(model.BoletoAgreement),
+			},
+			BeneficiaryName:     valueOf(model.BeneficiaryName),
+			BeneficiaryDocument: valueOf(model.BeneficiaryDocument),
+		}
+	}
+
+	return found, nil
+}
+
+func valueOf(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
@@ -0,0 +1,141 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	setupdbtests "github.com/henriquerocha2004/quem-me-deve-api/config/setupDbTests"
+	ormdb "github.com/henriquerocha2004/quem-me-deve-api/core/shared/gorm"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/boleto"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/helpers"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/suite"
+	orm "gorm.io/gorm"
+)
+
+var gormDB *orm.DB = nil
+
+func TestMain(m *testing.M) {
+	envPath := helpers.ProjetctRoot() + ".env.testing"
+	err := godotenv.Overload(envPath)
+	if err != nil {
+		log.Println(err)
+		panic("Error loading .env file")
+	}
+
+	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
+		os.Getenv("DB_USER"),
+		os.Getenv("DB_PASSWORD"),
+		os.Getenv("DB_HOST"),
+		os.Getenv("DB_PORT"),
+		os.Getenv("DB_NAME"),
+	)
+
+	gormDB, err = ormdb.NewGorm(dsn)
+	if err != nil {
+		log.Println(err)
+		panic(err)
+	}
+	sql, err := gormDB.DB()
+	if err != nil {
+		log.Println(err)
+		panic(err)
+	}
+
+	sql.SetMaxIdleConns(10)
+	sql.SetMaxOpenConns(100)
+	sql.SetConnMaxLifetime(30 * time.Minute)
+
+	defer sql.Close()
+	m.Run()
+}
+
+type UserRepositorySuiteTest struct {
+	suite.Suite
+}
+
+func (s *UserRepositorySuiteTest) TearDownTest() {
+	err := setupdbtests.TruncateTables(gormDB)
+	if err != nil {
+		s.Fail("Failed to truncate tables: %v", err)
+	}
+}
+
+func TestUserRepositorySuite(t *testing.T) {
+	suite.Run(t, new(UserRepositorySuiteTest))
+}
+
+func (s *UserRepositorySuiteTest) TestShouldCreateAndFindUser() {
+	repo := NewGormUserRepository(gormDB)
+	u, err := user.NewUser("Maria", "maria@email.com", "12345678")
+	s.NoError(err)
+
+	err = repo.Create(context.Background(), u)
+	s.NoError(err)
+
+	byEmail, err := repo.FindByEmail(context.Background(), "maria@email.com")
+	s.NoError(err)
+	s.Equal(u.Id, byEmail.Id)
+	s.True(byEmail.CheckPassword("12345678"))
+
+	byId, err := repo.FindById(context.Background(), u.Id)
+	s.NoError(err)
+	s.Equal("Maria", byId.Name)
+
+	notFound, err := repo.FindByEmail(context.Background(), "joao@email.com")
+	s.NoError(err)
+	s.Nil(notFound)
+}
+
+func (s *UserRepositorySuiteTest) TestShouldNotCreateUserWithDuplicatedEmail() {
+	repo := NewGormUserRepository(gormDB)
+	first, _ := user.NewUser("Maria", "maria@email.com", "12345678")
+	second, _ := user.NewUser("Maria Silva", "maria@email.com", "87654321")
+
+	s.NoError(repo.Create(context.Background(), first))
+	s.Error(repo.Create(context.Background(), second))
+}
+
+func (s *UserRepositorySuiteTest) TestShouldSavePixAccount() {
+	repo := NewGormUserRepository(gormDB)
+	u, err := user.NewUser("Maria", "maria@email.com", "12345678")
+	s.NoError(err)
+	s.NoError(repo.Create(context.Background(), u))
+
+	found, err := repo.FindById(context.Background(), u.Id)
+	s.NoError(err)
+	s.Nil(found.Pix)
+
+	account, err := user.NewPixAccount("maria@email.com", "Maria Silva", "Salvador")
+	s.NoError(err)
+	s.NoError(repo.SavePixAccount(context.Background(), u.Id, account))
+
+	found, err = repo.FindById(context.Background(), u.Id)
+	s.NoError(err)
+	s.Equal(account, found.Pix)
+}
+
+func (s *UserRepositorySuiteTest) TestShouldSaveBoletoAccount() {
+	repo := NewGormUserRepository(gormDB)
+	u, err := user.NewUser("Maria", "maria@email.com", "12345678")
+	s.NoError(err)
+	s.NoError(repo.Create(context.Background(), u))
+
+	account, err := user.NewBoletoAccount(boleto.Account{
+		Bank:   boleto.BradescoCode,
+		Agency: "1234-5",
+		Number: "98765-4",
+		Wallet: "09",
+	}, "Maria Silva ME", "11222333000181")
+	s.NoError(err)
+	s.NoError(repo.SaveBoletoAccount(context.Background(), u.Id, account))
+
+	found, err := repo.FindById(context.Background(), u.Id)
+	s.NoError(err)
+	s.Equal(account, found.Boleto)
+}
@@ -0,0 +1,235 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: user/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=user/repository.go -destination=user/mocks/repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	user "github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	ulid "github.com/oklog/ulid/v2"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReader is a mock of Reader interface.
+type MockReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockReaderMockRecorder is the mock recorder for MockReader.
+type MockReaderMockRecorder struct {
+	mock *MockReader
+}
+
+// NewMockReader creates a new mock instance.
+func NewMockReader(ctrl *gomock.Controller) *MockReader {
+	mock := &MockReader{ctrl: ctrl}
+	mock.recorder = &MockReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReader) EXPECT() *MockReaderMockRecorder {
+	return m.recorder
+}
+
+// FindByEmail mocks base method.
+func (m *MockReader) FindByEmail(ctx context.Context, email string) (*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmail", ctx, email)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEmail indicates an expected call of FindByEmail.
+func (mr *MockReaderMockRecorder) FindByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockReader)(nil).FindByEmail), ctx, email)
+}
+
+// FindById mocks base method.
+func (m *MockReader) FindById(ctx context.Context, id ulid.ULID) (*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockReaderMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockReader)(nil).FindById), ctx, id)
+}
+
+// MockWriter is a mock of Writer interface.
+type MockWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockWriterMockRecorder
+	isgomock struct{}
+}
+
+// MockWriterMockRecorder is the mock recorder for MockWriter.
+type MockWriterMockRecorder struct {
+	mock *MockWriter
+}
+
+// NewMockWriter creates a new mock instance.
+func NewMockWriter(ctrl *gomock.Controller) *MockWriter {
+	mock := &MockWriter{ctrl: ctrl}
+	mock.recorder = &MockWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWriter) EXPECT() *MockWriterMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWriter) Create(ctx context.Context, arg1 *user.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWriterMockRecorder) Create(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWriter)(nil).Create), ctx, arg1)
+}
+
+// SaveBoletoAccount mocks base method.
+func (m *MockWriter) SaveBoletoAccount(ctx context.Context, id ulid.ULID, account *user.BoletoAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveBoletoAccount", ctx, id, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBoletoAccount indicates an expected call of SaveBoletoAccount.
+func (mr *MockWriterMockRecorder) SaveBoletoAccount(ctx, id, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBoletoAccount", reflect.TypeOf((*MockWriter)(nil).SaveBoletoAccount), ctx, id, account)
+}
+
+// SavePixAccount mocks base method.
+func (m *MockWriter) SavePixAccount(ctx context.Context, id ulid.ULID, account *user.PixAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePixAccount", ctx, id, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePixAccount indicates an expected call of SavePixAccount.
+func (mr *MockWriterMockRecorder) SavePixAccount(ctx, id, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePixAccount", reflect.TypeOf((*MockWriter)(nil).SavePixAccount), ctx, id, account)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(ctx context.Context, arg1 *user.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, arg1)
+}
+
+// FindByEmail mocks base method.
+func (m *MockRepository) FindByEmail(ctx context.Context, email string) (*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmail", ctx, email)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEmail indicates an expected call of FindByEmail.
+func (mr *MockRepositoryMockRecorder) FindByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockRepository)(nil).FindByEmail), ctx, email)
+}
+
+// FindById mocks base method.
+func (m *MockRepository) FindById(ctx context.Context, id ulid.ULID) (*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockRepository)(nil).FindById), ctx, id)
+}
+
+// SaveBoletoAccount mocks base method.
+func (m *MockRepository) SaveBoletoAccount(ctx context.Context, id ulid.ULID, account *user.BoletoAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveBoletoAccount", ctx, id, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBoletoAccount indicates an expected call of SaveBoletoAccount.
+func (mr *MockRepositoryMockRecorder) SaveBoletoAccount(ctx, id, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBoletoAccount", reflect.TypeOf((*MockRepository)(nil).SaveBoletoAccount), ctx, id, account)
+}
+
+// SavePixAccount mocks base method.
+func (m *MockRepository) SavePixAccount(ctx context.Context, id ulid.ULID, account *user.PixAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePixAccount", ctx, id, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePixAccount indicates an expected call of SavePixAccount.
+func (mr *MockRepositoryMockRecorder) SavePixAccount(ctx, id, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePixAccount", reflect.TypeOf((*MockRepository)(nil).SavePixAccount), ctx, id, account)
+}
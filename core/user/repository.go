@@ -0,0 +1,23 @@
+package user
+
+import (
+	"context"
+
+	"github.com/oklog/ulid/v2"
+)
+
+type Reader interface {
+	FindById(ctx context.Context, id ulid.ULID) (*User, error)
+	FindByEmail(ctx context.Context, email string) (*User, error)
+}
+
+type Writer interface {
+	Create(ctx context.Context, user *User) error
+	SavePixAccount(ctx context.Context, id ulid.ULID, account *PixAccount) error
+	SaveBoletoAccount(ctx context.Context, id ulid.ULID, account *BoletoAccount) error
+}
+
+type Repository interface {
+	Reader
+	Writer
+}
@@ -0,0 +1,296 @@
+package user
+
+import (
+	"context"
+	"log"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/boleto"
+)
+
+type Service interface {
+	Register(ctx context.Context, dto *RegisterDto) shared.ServiceResponse
+	Login(ctx context.Context, dto *LoginDto) shared.ServiceResponse
+	Refresh(ctx context.Context, dto *RefreshDto) shared.ServiceResponse
+	PixAccount(ctx context.Context) shared.ServiceResponse
+	SetPixAccount(ctx context.Context, dto *PixAccountDto) shared.ServiceResponse
+	BoletoAccount(ctx context.Context) shared.ServiceResponse
+	SetBoletoAccount(ctx context.Context, dto *BoletoAccountDto) shared.ServiceResponse
+}
+
+type UserService struct {
+	repository Repository
+	tokens     *TokenIssuer
+}
+
+func NewUserService(repository Repository, tokens *TokenIssuer) *UserService {
+	return &UserService{
+		repository: repository,
+		tokens:     tokens,
+	}
+}
+
+func (s *UserService) Register(ctx context.Context, dto *RegisterDto) shared.ServiceResponse {
+	registered, err := s.repository.FindByEmail(ctx, normalizeEmail(dto.Email))
+	if err != nil {
+		log.Println("Error finding user:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in register user",
+		}
+	}
+
+	if registered != nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "email already registered",
+		}
+	}
+
+	user, err := NewUser(dto.Name, dto.Email, dto.Password)
+	if err != nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: err.Error(),
+		}
+	}
+
+	if err := s.repository.Create(ctx, user); err != nil {
+		log.Println("Error creating user:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in register user",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "user registered successfully",
+		Data: UserDto{
+			Id:    user.Id.String(),
+			Name:  user.Name,
+			Email: user.Email,
+		},
+	}
+}
+
+func (s *UserService) Login(ctx context.Context, dto *LoginDto) shared.ServiceResponse {
+	user, err := s.repository.FindByEmail(ctx, normalizeEmail(dto.Email))
+	if err != nil {
+		log.Println("Error finding user:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in login",
+		}
+	}
+
+	// The same message is used for an unknown email and a wrong password so
+	// the response does not reveal which accounts exist.
+	if user == nil || !user.CheckPassword(dto.Password) {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "invalid email or password",
+		}
+	}
+
+	return s.issueTokens(user)
+}
+
+func (s *UserService) Refresh(ctx context.Context, dto *RefreshDto) shared.ServiceResponse {
+	userId, err := s.tokens.validate(dto.RefreshToken, refreshToken)
+	if err != nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "invalid refresh token",
+		}
+	}
+
+	user, err := s.repository.FindById(ctx, userId)
+	if err != nil {
+		log.Println("Error finding user:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in refresh token",
+		}
+	}
+
+	if user == nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "invalid refresh token",
+		}
+	}
+
+	return s.issueTokens(user)
+}
+
+func (s *UserService) PixAccount(ctx context.Context) shared.ServiceResponse {
+	userId, ok := IdFromContext(ctx)
+	if !ok {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "user not found in context",
+		}
+	}
+
+	user, err := s.repository.FindById(ctx, userId)
+	if err != nil {
+		log.Println("Error finding user:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in find pix account",
+		}
+	}
+
+	if user == nil || user.Pix == nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "pix account not set",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "pix account found",
+		Data: PixAccountDto{
+			Key:          user.Pix.Key,
+			MerchantName: user.Pix.MerchantName,
+			MerchantCity: user.Pix.MerchantCity,
+		},
+	}
+}
+
+func (s *UserService) SetPixAccount(ctx context.Context, dto *PixAccountDto) shared.ServiceResponse {
+	userId, ok := IdFromContext(ctx)
+	if !ok {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "user not found in context",
+		}
+	}
+
+	account, err := NewPixAccount(dto.Key, dto.MerchantName, dto.MerchantCity)
+	if err != nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: err.Error(),
+		}
+	}
+
+	if err := s.repository.SavePixAccount(ctx, userId, account); err != nil {
+		log.Println("Error saving pix account:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in save pix account",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "pix account saved successfully",
+		Data: PixAccountDto{
+			Key:          account.Key,
+			MerchantName: account.MerchantName,
+			MerchantCity: account.MerchantCity,
+		},
+	}
+}
+
+func (s *UserService) BoletoAccount(ctx context.Context) shared.ServiceResponse {
+	userId, ok := IdFromContext(ctx)
+	if !ok {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "user not found in context",
+		}
+	}
+
+	user, err := s.repository.FindById(ctx, userId)
+	if err != nil {
+		log.Println("Error finding user:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in find boleto account",
+		}
+	}
+
+	if user == nil || user.Boleto == nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "boleto account not set",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "boleto account found",
+		Data:    boletoAccountDto(user.Boleto),
+	}
+}
+
+func (s *UserService) SetBoletoAccount(ctx context.Context, dto *BoletoAccountDto) shared.ServiceResponse {
+	userId, ok := IdFromContext(ctx)
+	if !ok {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "user not found in context",
+		}
+	}
+
+	account, err := NewBoletoAccount(boleto.Account{
+		Bank:      dto.Bank,
+		Agency:    dto.Agency,
+		Number:    dto.Account,
+		Wallet:    dto.Wallet,
+		Agreement: dto.Agreement,
+	}, dto.BeneficiaryName, dto.BeneficiaryDocument)
+	if err != nil {
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: err.Error(),
+		}
+	}
+
+	if err := s.repository.SaveBoletoAccount(ctx, userId, account); err != nil {
+		log.Println("Error saving boleto account:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in save boleto account",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "boleto account saved successfully",
+		Data:    boletoAccountDto(account),
+	}
+}
+
+func boletoAccountDto(account *BoletoAccount) BoletoAccountDto {
+	return BoletoAccountDto{
+		Bank:                account.Account.Bank,
+		Agency:              account.Account.Agency,
+		Account:             account.Account.Number,
+		Wallet:              account.Account.Wallet,
+		Agreement:           account.Account.Agreement,
+		BeneficiaryName:     account.BeneficiaryName,
+		BeneficiaryDocument: account.BeneficiaryDocument,
+	}
+}
+
+func (s *UserService) issueTokens(user *User) shared.ServiceResponse {
+	tokens, err := s.tokens.Issue(user.Id)
+	if err != nil {
+		log.Println("Error issuing tokens:", err)
+		return shared.ServiceResponse{
+			Status:  "error",
+			Message: "error in issue tokens",
+		}
+	}
+
+	return shared.ServiceResponse{
+		Status:  "success",
+		Message: "authenticated successfully",
+		Data:    tokens,
+	}
+}
@@ -0,0 +1,246 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/boleto"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUserService(t *testing.T) {
+	tokens, err := user.NewTokenIssuer([]byte("a-secret-long-enough-for-hs256!!"), 15*time.Minute, time.Hour)
+	assert.NoError(t, err)
+
+	t.Run("should register a user storing only the password hash", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().FindByEmail(gomock.Any(), "maria@email.com").Return(nil, nil)
+		repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, u *user.User) error {
+			assert.Equal(t, "maria@email.com", u.Email)
+			assert.NotEqual(t, "12345678", u.PasswordHash)
+			assert.True(t, u.CheckPassword("12345678"))
+			return nil
+		})
+
+		service := user.NewUserService(repo, tokens)
+		result := service.Register(context.Background(), &user.RegisterDto{
+			Name:     "Maria",
+			Email:    " Maria@Email.com ",
+			Password: "12345678",
+		})
+
+		assert.Equal(t, "success", result.Status)
+		assert.Equal(t, "user registered successfully", result.Message)
+	})
+
+	t.Run("should not register an email already in use", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		registered, _ := user.NewUser("Maria", "maria@email.com", "12345678")
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().FindByEmail(gomock.Any(), "maria@email.com").Return(registered, nil)
+		repo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		service := user.NewUserService(repo, tokens)
+		result := service.Register(context.Background(), &user.RegisterDto{
+			Name:     "Maria",
+			Email:    "maria@email.com",
+			Password: "12345678",
+		})
+
+		assert.Equal(t, "error", result.Status)
+		assert.Equal(t, "email already registered", result.Message)
+	})
+
+	t.Run("should login and issue tokens for the user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		registered, _ := user.NewUser("Maria", "maria@email.com", "12345678")
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().FindByEmail(gomock.Any(), "maria@email.com").Return(registered, nil)
+
+		service := user.NewUserService(repo, tokens)
+		result := service.Login(context.Background(), &user.LoginDto{
+			Email:    "maria@email.com",
+			Password: "12345678",
+		})
+
+		assert.Equal(t, "success", result.Status)
+
+		issued := result.Data.(*user.TokenDto)
+		userId, err := tokens.Authenticate(issued.AccessToken)
+		assert.NoError(t, err)
+		assert.Equal(t, registered.Id, userId)
+	})
+
+	t.Run("should not login with a wrong password", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		registered, _ := user.NewUser("Maria", "maria@email.com", "12345678")
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().FindByEmail(gomock.Any(), "maria@email.com").Return(registered, nil)
+
+		service := user.NewUserService(repo, tokens)
+		result := service.Login(context.Background(), &user.LoginDto{
+			Email:    "maria@email.com",
+			Password: "87654321",
+		})
+
+		assert.Equal(t, "error", result.Status)
+		assert.Equal(t, "invalid email or password", result.Message)
+	})
+
+	t.Run("should refresh tokens only with a refresh token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		registered, _ := user.NewUser("Maria", "maria@email.com", "12345678")
+		issued, err := tokens.Issue(registered.Id)
+		assert.NoError(t, err)
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().FindById(gomock.Any(), registered.Id).Return(registered, nil)
+
+		service := user.NewUserService(repo, tokens)
+
+		result := service.Refresh(context.Background(), &user.RefreshDto{RefreshToken: issued.AccessToken})
+		assert.Equal(t, "error", result.Status)
+		assert.Equal(t, "invalid refresh token", result.Message)
+
+		result = service.Refresh(context.Background(), &user.RefreshDto{RefreshToken: issued.RefreshToken})
+		assert.Equal(t, "success", result.Status)
+	})
+
+	t.Run("should not authenticate with a refresh token", func(t *testing.T) {
+		issued, err := tokens.Issue(user.User{}.Id)
+		assert.NoError(t, err)
+
+		_, err = tokens.Authenticate(issued.RefreshToken)
+		assert.Error(t, err)
+	})
+
+	t.Run("should save the pix account of the user in context", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userId := ulid.Make()
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().SavePixAccount(gomock.Any(), userId, &user.PixAccount{
+			Key:          "maria@email.com",
+			MerchantName: "Maria Silva",
+			MerchantCity: "Salvador",
+		}).Return(nil)
+
+		service := user.NewUserService(repo, tokens)
+		result := service.SetPixAccount(user.WithUserId(context.Background(), userId), &user.PixAccountDto{
+			Key:          " maria@email.com ",
+			MerchantName: "Maria Silva",
+			MerchantCity: "Salvador",
+		})
+
+		assert.Equal(t, "success", result.Status)
+	})
+
+	t.Run("should not save an invalid pix key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().SavePixAccount(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		service := user.NewUserService(repo, tokens)
+		result := service.SetPixAccount(user.WithUserId(context.Background(), ulid.Make()), &user.PixAccountDto{
+			Key:          "not a key",
+			MerchantName: "Maria Silva",
+			MerchantCity: "Salvador",
+		})
+
+		assert.Equal(t, "error", result.Status)
+		assert.Equal(t, "the pix key informed is invalid", result.Message)
+	})
+
+	t.Run("should report a pix account not set", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		registered, _ := user.NewUser("Maria", "maria@email.com", "12345678")
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().FindById(gomock.Any(), registered.Id).Return(registered, nil)
+
+		service := user.NewUserService(repo, tokens)
+		result := service.PixAccount(user.WithUserId(context.Background(), registered.Id))
+
+		assert.Equal(t, "error", result.Status)
+		assert.Equal(t, "pix account not set", result.Message)
+	})
+
+	t.Run("should save the boleto account of the user in context", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userId := ulid.Make()
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().SaveBoletoAccount(gomock.Any(), userId, &user.BoletoAccount{
+			Account: boleto.Account{
+				Bank:      "001",
+				Agency:    "1234-5",
+				Number:    "12345-6",
+				Wallet:    "17",
+				Agreement: "1234567",
+			},
+			BeneficiaryName:     "Maria Silva ME",
+			BeneficiaryDocument: "11222333000181",
+		}).Return(nil)
+
+		service := user.NewUserService(repo, tokens)
+		result := service.SetBoletoAccount(user.WithUserId(context.Background(), userId), &user.BoletoAccountDto{
+			Bank:                "001",
+			Agency:              "1234-5",
+			Account:             " 12345-6",
+			Wallet:              "17",
+			Agreement:           "1234567",
+			BeneficiaryName:     "Maria Silva ME",
+			BeneficiaryDocument: "11.222.333/0001-81",
+		})
+
+		assert.Equal(t, "success", result.Status)
+	})
+
+	t.Run("should not save a boleto account of an unsupported bank", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().SaveBoletoAccount(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		service := user.NewUserService(repo, tokens)
+		result := service.SetBoletoAccount(user.WithUserId(context.Background(), ulid.Make()), &user.BoletoAccountDto{
+			Bank:                "999",
+			Agency:              "1234",
+			Account:             "12345",
+			Wallet:              "09",
+			BeneficiaryName:     "Maria Silva ME",
+			BeneficiaryDocument: "11222333000181",
+		})
+
+		assert.Equal(t, "error", result.Status)
+		assert.Equal(t, boleto.ErrUnsupportedBank.Error(), result.Message)
+	})
+}
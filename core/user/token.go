@@ -0,0 +1,88 @@
+package user
+
+import (
+	"errors"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/jwt"
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	accessToken  = "access"
+	refreshToken = "refresh"
+)
+
+// minSecretLength is the shortest secret accepted to sign the tokens, the
+// size of the HMAC-SHA256 key.
+const minSecretLength = 32
+
+// TokenIssuer issues the short lived access token used on every request and
+// the long lived refresh token used only to get a new pair.
+type TokenIssuer struct {
+	secret     []byte
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+// NewTokenIssuer refuses a missing or short secret, as tokens signed with
+// it could be forged by anyone.
+func NewTokenIssuer(secret []byte, accessTTL, refreshTTL time.Duration) (*TokenIssuer, error) {
+	if len(secret) < minSecretLength {
+		return nil, errors.New("the token secret must have at least 32 bytes")
+	}
+
+	return &TokenIssuer{
+		secret:     secret,
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+	}, nil
+}
+
+func (t *TokenIssuer) Issue(userId ulid.ULID) (*TokenDto, error) {
+	access, err := t.sign(userId, accessToken, t.accessTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	refresh, err := t.sign(userId, refreshToken, t.refreshTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenDto{
+		AccessToken:  access,
+		RefreshToken: refresh,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(t.accessTTL.Seconds()),
+	}, nil
+}
+
+// Authenticate returns the user of a valid access token.
+func (t *TokenIssuer) Authenticate(token string) (ulid.ULID, error) {
+	return t.validate(token, accessToken)
+}
+
+func (t *TokenIssuer) validate(token, tokenType string) (ulid.ULID, error) {
+	claims, err := jwt.Parse(token, t.secret)
+	if err != nil {
+		return ulid.ULID{}, err
+	}
+
+	if claims.TokenType != tokenType {
+		return ulid.ULID{}, errors.New("unexpected token type")
+	}
+
+	return ulid.Parse(claims.Subject)
+}
+
+func (t *TokenIssuer) sign(userId ulid.ULID, tokenType string, ttl time.Duration) (string, error) {
+	now := time.Now()
+
+	return jwt.Sign(jwt.Claims{
+		Subject:   userId.String(),
+		TokenType: tokenType,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(ttl).Unix(),
+	}, t.secret)
+}
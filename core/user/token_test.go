@@ -0,0 +1,20 @@
+package user_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTokenIssuer(t *testing.T) {
+	t.Run("should refuse a missing or short secret", func(t *testing.T) {
+		for _, secret := range []string{"", "secret"} {
+			tokens, err := user.NewTokenIssuer([]byte(secret), 15*time.Minute, time.Hour)
+
+			assert.Error(t, err)
+			assert.Nil(t, tokens)
+		}
+	})
+}
@@ -0,0 +1,129 @@
+package user
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/boleto"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/document"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/pix"
+	"github.com/oklog/ulid/v2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const minPasswordLength = 8
+
+type User struct {
+	Id           ulid.ULID
+	Name         string
+	Email        string
+	PasswordHash string
+	CreatedAt    *time.Time
+	Pix          *PixAccount
+	Boleto       *BoletoAccount
+}
+
+// PixAccount is where the professional receives the Pix payments of the
+// debts, along with the name and the city shown to the payer.
+type PixAccount struct {
+	Key          string
+	MerchantName string
+	MerchantCity string
+}
+
+func NewPixAccount(key, merchantName, merchantCity string) (*PixAccount, error) {
+	key = strings.TrimSpace(key)
+	if _, err := pix.ParseKey(key); err != nil {
+		return nil, errors.New("the pix key informed is invalid")
+	}
+
+	if err := pix.ValidateMerchant(merchantName, merchantCity); err != nil {
+		return nil, err
+	}
+
+	return &PixAccount{
+		Key:          key,
+		MerchantName: strings.TrimSpace(merchantName),
+		MerchantCity: strings.TrimSpace(merchantCity),
+	}, nil
+}
+
+// BoletoAccount is the bank account where the professional receives the
+// boletos of the debts, along with the beneficiary printed on them.
+type BoletoAccount struct {
+	Account             boleto.Account
+	BeneficiaryName     string
+	BeneficiaryDocument string
+}
+
+func NewBoletoAccount(account boleto.Account, beneficiaryName, beneficiaryDocument string) (*BoletoAccount, error) {
+	account = boleto.Account{
+		Bank:      strings.TrimSpace(account.Bank),
+		Agency:    strings.TrimSpace(account.Agency),
+		Number:    strings.TrimSpace(account.Number),
+		Wallet:    strings.TrimSpace(account.Wallet),
+		Agreement: strings.TrimSpace(account.Agreement),
+	}
+
+	if _, err := boleto.NewBank(account); err != nil {
+		return nil, err
+	}
+
+	beneficiaryName = strings.TrimSpace(beneficiaryName)
+	if beneficiaryName == "" {
+		return nil, errors.New("beneficiary name is required")
+	}
+
+	doc := document.Document(beneficiaryDocument)
+	if err := doc.Validate(); err != nil {
+		return nil, errors.New("the beneficiary document informed is invalid")
+	}
+
+	return &BoletoAccount{
+		Account:             account,
+		BeneficiaryName:     beneficiaryName,
+		BeneficiaryDocument: doc.Digits(),
+	}, nil
+}
+
+// NewUser validates the data of a new account and keeps only the bcrypt hash
+// of the password.
+func NewUser(name, email, password string) (*User, error) {
+	if name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	email = normalizeEmail(email)
+	if _, err := mail.ParseAddress(email); err != nil {
+		return nil, errors.New("the email informed is invalid")
+	}
+
+	if len(password) < minPasswordLength {
+		return nil, errors.New("password must have at least 8 characters")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	return &User{
+		Id:           ulid.Make(),
+		Name:         name,
+		Email:        email,
+		PasswordHash: string(hash),
+		CreatedAt:    &now,
+	}, nil
+}
+
+func (u *User) CheckPassword(password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) == nil
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
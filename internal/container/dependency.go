@@ -1,11 +1,36 @@
 package container
 
 import (
+	"github.com/henriquerocha2004/quem-me-deve-api/core/catalog"
 	"github.com/henriquerocha2004/quem-me-deve-api/core/client"
 	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/notification"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/plan"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/receipt"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/reconciliation"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/reminder"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/report"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/statement"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/scheduler"
 )
 
 type Dependencies struct {
-	DebtService   debt.Service
-	ClientService client.Service
+	DebtService           debt.Service
+	ClientService         client.Service
+	CatalogService        catalog.Service
+	StockService          catalog.StockService
+	UserService           user.Service
+	PlanService           plan.Service
+	PlanAccess            plan.ReadOnlyChecker
+	ReminderService       reminder.Service
+	NotificationService   notification.Service
+	ReconciliationService reconciliation.Service
+	ReceiptService        receipt.Service
+	StatementService      statement.Service
+	ReportService         report.Service
+	Tokens                *user.TokenIssuer
+	// PixWebhookSecret signs the notifications of the PSP.
+	PixWebhookSecret []byte
+	Scheduler        *scheduler.Scheduler
 }
@@ -0,0 +1,10 @@
+ALTER TABLE installments
+    DROP COLUMN IF EXISTS days_late,
+    DROP COLUMN IF EXISTS interest_amount,
+    DROP COLUMN IF EXISTS fine_amount,
+    DROP COLUMN IF EXISTS principal_amount;
+
+ALTER TABLE debts
+    DROP COLUMN IF EXISTS interest_period,
+    DROP COLUMN IF EXISTS interest_rate,
+    DROP COLUMN IF EXISTS fine_percentage;
\ No newline at end of file
@@ -0,0 +1,10 @@
+ALTER TABLE debts
+    ADD COLUMN fine_percentage DECIMAL(5,2) NOT NULL DEFAULT 0,
+    ADD COLUMN interest_rate DECIMAL(7,4) NOT NULL DEFAULT 0,
+    ADD COLUMN interest_period VARCHAR(20) NOT NULL DEFAULT 'monthly';
+
+ALTER TABLE installments
+    ADD COLUMN principal_amount DECIMAL(12,2) NOT NULL DEFAULT 0,
+    ADD COLUMN fine_amount DECIMAL(12,2) NOT NULL DEFAULT 0,
+    ADD COLUMN interest_amount DECIMAL(12,2) NOT NULL DEFAULT 0,
+    ADD COLUMN days_late INTEGER NOT NULL DEFAULT 0;
\ No newline at end of file
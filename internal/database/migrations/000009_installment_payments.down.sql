@@ -0,0 +1 @@
+DROP TABLE IF EXISTS installment_payments;
\ No newline at end of file
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS installment_payments (
+    id CHAR(26) PRIMARY KEY,
+    amount DECIMAL(12,2) NOT NULL,
+    payment_method VARCHAR(255) NOT NULL,
+    payment_date TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    installment_id CHAR(26) NOT NULL REFERENCES installments(id)
+);
+
+CREATE INDEX idx_installment_payments_installment_id ON installment_payments(installment_id);
+CREATE INDEX idx_installment_payments_payment_date ON installment_payments(payment_date);
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS client_wallet_entries;
+DROP TABLE IF EXISTS client_wallets;
\ No newline at end of file
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS client_wallets (
+    client_id CHAR(26) PRIMARY KEY REFERENCES clients(id),
+    balance DECIMAL(12,2) NOT NULL DEFAULT 0 CHECK (balance >= 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS client_wallet_entries (
+    id CHAR(26) PRIMARY KEY,
+    type VARCHAR(10) NOT NULL,
+    amount DECIMAL(12,2) NOT NULL,
+    description TEXT,
+    client_id CHAR(26) NOT NULL REFERENCES client_wallets(client_id),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_client_wallet_entries_client_id ON client_wallet_entries(client_id);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS renegotiation_info;
\ No newline at end of file
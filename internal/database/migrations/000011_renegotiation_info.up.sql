@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS renegotiation_info (
+    id CHAR(26) PRIMARY KEY,
+    renegotiated_debt_id CHAR(26) NOT NULL UNIQUE REFERENCES debts(id),
+    open_balance DECIMAL(12,2) NOT NULL,
+    fees DECIMAL(12,2) NOT NULL DEFAULT 0,
+    discount DECIMAL(12,2) NOT NULL DEFAULT 0,
+    renegotiation_date TIMESTAMP,
+    renegotiated_by CHAR(26) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    debt_id CHAR(26) NOT NULL UNIQUE REFERENCES debts(id)
+);
+
+CREATE INDEX idx_renegotiation_info_renegotiated_by ON renegotiation_info(renegotiated_by);
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE debts
+    DROP COLUMN IF EXISTS next_business_day,
+    DROP COLUMN IF EXISTS interval_days,
+    DROP COLUMN IF EXISTS schedule;
\ No newline at end of file
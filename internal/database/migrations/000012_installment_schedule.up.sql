@@ -0,0 +1,4 @@
+ALTER TABLE debts
+    ADD COLUMN schedule VARCHAR(20) NOT NULL DEFAULT 'monthly',
+    ADD COLUMN interval_days INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN next_business_day BOOLEAN NOT NULL DEFAULT FALSE;
\ No newline at end of file
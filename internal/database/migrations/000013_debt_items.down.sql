@@ -0,0 +1,9 @@
+ALTER TABLE debts
+    ADD COLUMN product_ids CHAR(26)[] DEFAULT '{}',
+    ADD COLUMN service_ids CHAR(26)[] DEFAULT '{}';
+
+UPDATE debts SET
+    product_ids = COALESCE((SELECT array_agg(reference_id) FROM debt_items WHERE debt_items.debt_id = debts.id AND item_type = 'product'), '{}'),
+    service_ids = COALESCE((SELECT array_agg(reference_id) FROM debt_items WHERE debt_items.debt_id = debts.id AND item_type = 'service'), '{}');
+
+DROP TABLE IF EXISTS debt_items;
\ No newline at end of file
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS debt_items (
+    id CHAR(26) PRIMARY KEY,
+    item_type VARCHAR(20) NOT NULL,
+    reference_id CHAR(26) NOT NULL,
+    description TEXT NOT NULL,
+    quantity INTEGER NOT NULL,
+    unit_price DECIMAL(12,2) NOT NULL,
+    discount DECIMAL(12,2) NOT NULL DEFAULT 0,
+    total DECIMAL(12,2) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    debt_id CHAR(26) NOT NULL REFERENCES debts(id)
+);
+
+CREATE INDEX idx_debt_items_debt_id ON debt_items(debt_id);
+CREATE INDEX idx_debt_items_reference_id ON debt_items(reference_id);
+
+ALTER TABLE debts
+    DROP COLUMN IF EXISTS product_ids,
+    DROP COLUMN IF EXISTS service_ids;
\ No newline at end of file
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS catalog_items (
+    id CHAR(26) PRIMARY KEY,
+    kind VARCHAR(20) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    sku VARCHAR(60) NOT NULL DEFAULT '',
+    default_price DECIMAL(12,2) NOT NULL DEFAULT 0,
+    unit VARCHAR(20) NOT NULL DEFAULT 'un',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+
+CREATE INDEX idx_catalog_items_kind ON catalog_items(kind);
+CREATE UNIQUE INDEX idx_catalog_items_kind_sku ON catalog_items(kind, sku) WHERE sku <> '' AND deleted_at IS NULL;
\ No newline at end of file
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS stock_movements;
+
+ALTER TABLE catalog_items
+    DROP COLUMN IF EXISTS low_stock_threshold;
\ No newline at end of file
@@ -0,0 +1,15 @@
+ALTER TABLE catalog_items
+    ADD COLUMN low_stock_threshold INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id CHAR(26) PRIMARY KEY,
+    product_id CHAR(26) NOT NULL REFERENCES catalog_items(id),
+    movement_type VARCHAR(20) NOT NULL,
+    quantity INTEGER NOT NULL,
+    description TEXT,
+    debt_id CHAR(26) REFERENCES debts(id),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_stock_movements_product_id ON stock_movements(product_id);
+CREATE INDEX idx_stock_movements_debt_id ON stock_movements(debt_id);
\ No newline at end of file
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_installments_tenant_id;
+DROP INDEX IF EXISTS idx_debts_tenant_id;
+DROP INDEX IF EXISTS idx_client_wallet_entries_tenant_id;
+DROP INDEX IF EXISTS idx_client_wallets_tenant_id;
+DROP INDEX IF EXISTS idx_clients_tenant_id;
+
+ALTER TABLE installments DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE debts DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE client_wallet_entries DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE client_wallets DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE clients DROP COLUMN IF EXISTS tenant_id;
\ No newline at end of file
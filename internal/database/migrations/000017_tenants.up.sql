@@ -0,0 +1,11 @@
+ALTER TABLE clients ADD COLUMN tenant_id CHAR(26);
+ALTER TABLE client_wallets ADD COLUMN tenant_id CHAR(26);
+ALTER TABLE client_wallet_entries ADD COLUMN tenant_id CHAR(26);
+ALTER TABLE debts ADD COLUMN tenant_id CHAR(26);
+ALTER TABLE installments ADD COLUMN tenant_id CHAR(26);
+
+CREATE INDEX idx_clients_tenant_id ON clients(tenant_id);
+CREATE INDEX idx_client_wallets_tenant_id ON client_wallets(tenant_id);
+CREATE INDEX idx_client_wallet_entries_tenant_id ON client_wallet_entries(tenant_id);
+CREATE INDEX idx_debts_tenant_id ON debts(tenant_id);
+CREATE INDEX idx_installments_tenant_id ON installments(tenant_id);
\ No newline at end of file
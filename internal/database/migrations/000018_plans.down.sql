@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS subscriptions;
+DROP TABLE IF EXISTS plan_features;
+DROP TABLE IF EXISTS plans;
\ No newline at end of file
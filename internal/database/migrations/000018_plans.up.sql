@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS plans (
+    id CHAR(26) PRIMARY KEY,
+    type VARCHAR(20) NOT NULL,
+    price DECIMAL(12,2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_plans_type ON plans(type);
+
+CREATE TABLE IF NOT EXISTS plan_features (
+    id CHAR(26) PRIMARY KEY,
+    name VARCHAR(20) NOT NULL,
+    resource_limit INTEGER NOT NULL,
+    plan_id CHAR(26) NOT NULL REFERENCES plans(id)
+);
+
+CREATE UNIQUE INDEX idx_plan_features_plan_id_name ON plan_features(plan_id, name);
+
+CREATE TABLE IF NOT EXISTS subscriptions (
+    id CHAR(26) PRIMARY KEY,
+    plan_id CHAR(26) NOT NULL REFERENCES plans(id),
+    started_at TIMESTAMP NOT NULL,
+    tenant_id CHAR(26) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_subscriptions_tenant_id ON subscriptions(tenant_id);
+
+INSERT INTO plans (id, type, price) VALUES
+    ('01K0000000000000000000FREE', 'Free', 0),
+    ('01K000000000000000000PR000', 'Pro', 29.90);
+
+INSERT INTO plan_features (id, name, resource_limit, plan_id) VALUES
+    ('01K00000000000000000FEAT01', 'client', 10, '01K0000000000000000000FREE'),
+    ('01K00000000000000000FEAT02', 'debt', 20, '01K0000000000000000000FREE');
\ No newline at end of file
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS subscription_charges;
+
+DROP INDEX IF EXISTS idx_subscriptions_status_current_period_end;
+
+ALTER TABLE subscriptions
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS trial_ends_at,
+    DROP COLUMN IF EXISTS current_period_start,
+    DROP COLUMN IF EXISTS current_period_end,
+    DROP COLUMN IF EXISTS grace_ends_at;
\ No newline at end of file
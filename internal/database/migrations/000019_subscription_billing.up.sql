@@ -0,0 +1,22 @@
+ALTER TABLE subscriptions
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'active',
+    ADD COLUMN trial_ends_at TIMESTAMP NULL,
+    ADD COLUMN current_period_start TIMESTAMP NULL,
+    ADD COLUMN current_period_end TIMESTAMP NULL,
+    ADD COLUMN grace_ends_at TIMESTAMP NULL;
+
+CREATE INDEX idx_subscriptions_status_current_period_end ON subscriptions(status, current_period_end);
+
+CREATE TABLE IF NOT EXISTS subscription_charges (
+    id CHAR(26) PRIMARY KEY,
+    plan_id CHAR(26) NOT NULL REFERENCES plans(id),
+    amount DECIMAL(12,2) NOT NULL,
+    period_start TIMESTAMP NOT NULL,
+    period_end TIMESTAMP NOT NULL,
+    paid BOOLEAN NOT NULL DEFAULT FALSE,
+    reference VARCHAR(100) NULL,
+    tenant_id CHAR(26) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_subscription_charges_tenant_id ON subscription_charges(tenant_id);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_installments_status_due_date;
\ No newline at end of file
@@ -0,0 +1 @@
+CREATE INDEX idx_installments_status_due_date ON installments(status, due_date);
\ No newline at end of file
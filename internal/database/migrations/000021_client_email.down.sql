@@ -0,0 +1 @@
+ALTER TABLE clients DROP COLUMN IF EXISTS email;
\ No newline at end of file
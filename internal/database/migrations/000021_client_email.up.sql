@@ -0,0 +1 @@
+ALTER TABLE clients ADD COLUMN email TEXT NULL;
\ No newline at end of file
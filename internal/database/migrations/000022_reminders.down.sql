@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS reminder_sends;
+DROP TABLE IF EXISTS reminder_rules;
\ No newline at end of file
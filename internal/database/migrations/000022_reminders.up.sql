@@ -0,0 +1,28 @@
+CREATE TABLE reminder_rules (
+    id CHAR(26) PRIMARY KEY,
+    trigger VARCHAR(20) NOT NULL,
+    days INT NOT NULL DEFAULT 0,
+    channel VARCHAR(20) NOT NULL,
+    template TEXT NOT NULL,
+    created_at TIMESTAMP,
+    tenant_id CHAR(26) NOT NULL
+);
+
+CREATE INDEX idx_reminder_rules_tenant_id ON reminder_rules(tenant_id);
+
+CREATE TABLE reminder_sends (
+    id CHAR(26) PRIMARY KEY,
+    rule_id CHAR(26) NOT NULL,
+    installment_id CHAR(26) NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    recipient TEXT,
+    status VARCHAR(20) NOT NULL,
+    error TEXT,
+    reference_date DATE NOT NULL,
+    attempted_at TIMESTAMP NOT NULL,
+    tenant_id CHAR(26) NOT NULL
+);
+
+CREATE INDEX idx_reminder_sends_tenant_id ON reminder_sends(tenant_id);
+CREATE INDEX idx_reminder_sends_installment_id ON reminder_sends(installment_id);
+CREATE UNIQUE INDEX idx_reminder_sends_done ON reminder_sends(rule_id, installment_id, reference_date) WHERE status IN ('sent', 'skipped');
\ No newline at end of file
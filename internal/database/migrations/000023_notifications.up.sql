@@ -0,0 +1,19 @@
+CREATE TABLE notifications (
+    id CHAR(26) PRIMARY KEY,
+    user_id CHAR(26) NOT NULL,
+    kind VARCHAR(40) NOT NULL,
+    title TEXT NOT NULL,
+    body TEXT NOT NULL,
+    debt_id CHAR(26) NOT NULL,
+    installment_id CHAR(26) NULL,
+    client_id CHAR(26) NOT NULL,
+    read_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL,
+    tenant_id CHAR(26) NOT NULL
+);
+
+CREATE INDEX idx_notifications_tenant_id ON notifications(tenant_id);
+CREATE INDEX idx_notifications_user_id_created_at ON notifications(user_id, created_at);
+CREATE INDEX idx_notifications_unread ON notifications(user_id) WHERE read_at IS NULL;
+CREATE UNIQUE INDEX idx_notifications_installment_kind ON notifications(user_id, kind, installment_id)
+    WHERE kind IN ('installment_due_soon', 'installment_overdue');
\ No newline at end of file
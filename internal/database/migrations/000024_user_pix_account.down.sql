@@ -0,0 +1 @@
+ALTER TABLE users DROP COLUMN pix_key, DROP COLUMN pix_merchant_name, DROP COLUMN pix_merchant_city;
\ No newline at end of file
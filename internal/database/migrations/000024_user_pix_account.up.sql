@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN pix_key VARCHAR(77) NULL, ADD COLUMN pix_merchant_name VARCHAR(25) NULL, ADD COLUMN pix_merchant_city VARCHAR(15) NULL;
\ No newline at end of file
@@ -0,0 +1,20 @@
+CREATE TABLE pix_payments (
+    id CHAR(26) PRIMARY KEY,
+    end_to_end_id VARCHAR(35) NOT NULL,
+    txid VARCHAR(35) NOT NULL,
+    amount DECIMAL(12,2) NOT NULL,
+    paid_at TIMESTAMP NOT NULL,
+    received_at TIMESTAMP NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    reason TEXT NULL,
+    debt_id CHAR(26) NULL,
+    installment_id CHAR(26) NULL,
+    resolved_at TIMESTAMP NULL,
+    resolved_by CHAR(26) NULL,
+    note TEXT NULL,
+    tenant_id CHAR(26) NOT NULL
+);
+
+CREATE INDEX idx_pix_payments_tenant_id ON pix_payments(tenant_id);
+CREATE UNIQUE INDEX idx_pix_payments_end_to_end_id ON pix_payments(end_to_end_id);
+CREATE INDEX idx_pix_payments_queue ON pix_payments(tenant_id, received_at) WHERE status IN ('received', 'review');
\ No newline at end of file
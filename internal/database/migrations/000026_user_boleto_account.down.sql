@@ -0,0 +1 @@
+ALTER TABLE users DROP COLUMN boleto_bank, DROP COLUMN boleto_agency, DROP COLUMN boleto_account, DROP COLUMN boleto_wallet, DROP COLUMN boleto_agreement, DROP COLUMN boleto_beneficiary_name, DROP COLUMN boleto_beneficiary_document;
\ No newline at end of file
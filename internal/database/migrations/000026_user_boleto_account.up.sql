@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN boleto_bank CHAR(3) NULL, ADD COLUMN boleto_agency VARCHAR(10) NULL, ADD COLUMN boleto_account VARCHAR(15) NULL, ADD COLUMN boleto_wallet VARCHAR(3) NULL, ADD COLUMN boleto_agreement VARCHAR(10) NULL, ADD COLUMN boleto_beneficiary_name VARCHAR(255) NULL, ADD COLUMN boleto_beneficiary_document VARCHAR(14) NULL;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS boletos;
\ No newline at end of file
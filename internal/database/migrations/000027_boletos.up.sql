@@ -0,0 +1,10 @@
+CREATE TABLE boletos (
+    id CHAR(26) PRIMARY KEY,
+    installment_id CHAR(26) NOT NULL,
+    our_number BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    tenant_id CHAR(26) NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_boletos_installment_id ON boletos(installment_id);
+CREATE UNIQUE INDEX idx_boletos_our_number ON boletos(tenant_id, our_number);
\ No newline at end of file
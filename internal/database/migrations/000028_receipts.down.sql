@@ -0,0 +1 @@
+DROP TABLE IF EXISTS receipts;
\ No newline at end of file
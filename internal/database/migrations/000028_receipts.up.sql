@@ -0,0 +1,25 @@
+CREATE TABLE receipts (
+    id CHAR(26) PRIMARY KEY,
+    number BIGINT NOT NULL,
+    payment_id CHAR(26) NOT NULL,
+    debt_id CHAR(26) NOT NULL,
+    installment_id CHAR(26) NOT NULL,
+    client_id CHAR(26) NOT NULL,
+    issuer_name TEXT NOT NULL,
+    issuer_document VARCHAR(14) NULL,
+    issuer_email VARCHAR(255) NULL,
+    payer_name TEXT NOT NULL,
+    payer_document VARCHAR(20) NULL,
+    payer_email VARCHAR(255) NULL,
+    description TEXT NULL,
+    installment_number INT NOT NULL,
+    installments_quantity INT NOT NULL,
+    amount DECIMAL(12,2) NOT NULL,
+    payment_method TEXT NOT NULL,
+    paid_at TIMESTAMP NOT NULL,
+    issued_at TIMESTAMP NOT NULL,
+    tenant_id CHAR(26) NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_receipts_number ON receipts(tenant_id, number);
+CREATE INDEX idx_receipts_installment_id ON receipts(installment_id);
\ No newline at end of file
@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS idx_catalog_items_tenant_kind_sku;
+CREATE UNIQUE INDEX idx_catalog_items_kind_sku ON catalog_items(kind, sku) WHERE sku <> '' AND deleted_at IS NULL;
+
+DROP INDEX IF EXISTS idx_renegotiation_info_tenant_id;
+DROP INDEX IF EXISTS idx_installment_payments_tenant_id;
+DROP INDEX IF EXISTS idx_debt_items_tenant_id;
+DROP INDEX IF EXISTS idx_stock_movements_tenant_id;
+DROP INDEX IF EXISTS idx_catalog_items_tenant_id;
+
+ALTER TABLE renegotiation_info DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE installment_payments DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE debt_items DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE stock_movements DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE catalog_items DROP COLUMN IF EXISTS tenant_id;
\ No newline at end of file
@@ -0,0 +1,35 @@
+ALTER TABLE catalog_items ADD COLUMN tenant_id CHAR(26);
+ALTER TABLE stock_movements ADD COLUMN tenant_id CHAR(26);
+ALTER TABLE debt_items ADD COLUMN tenant_id CHAR(26);
+ALTER TABLE installment_payments ADD COLUMN tenant_id CHAR(26);
+ALTER TABLE renegotiation_info ADD COLUMN tenant_id CHAR(26);
+
+UPDATE debt_items SET tenant_id = debts.tenant_id
+FROM debts WHERE debts.id = debt_items.debt_id;
+
+UPDATE installment_payments SET tenant_id = installments.tenant_id
+FROM installments WHERE installments.id = installment_payments.installment_id;
+
+UPDATE renegotiation_info SET tenant_id = debts.tenant_id
+FROM debts WHERE debts.id = renegotiation_info.debt_id;
+
+-- Os itens do catálogo passam a pertencer à conta que primeiro os vendeu.
+UPDATE catalog_items SET tenant_id = sold.tenant_id
+FROM (
+    SELECT DISTINCT ON (debt_items.reference_id) debt_items.reference_id, debt_items.tenant_id
+    FROM debt_items
+    ORDER BY debt_items.reference_id, debt_items.created_at
+) AS sold
+WHERE sold.reference_id = catalog_items.id;
+
+UPDATE stock_movements SET tenant_id = catalog_items.tenant_id
+FROM catalog_items WHERE catalog_items.id = stock_movements.product_id;
+
+CREATE INDEX idx_catalog_items_tenant_id ON catalog_items(tenant_id);
+CREATE INDEX idx_stock_movements_tenant_id ON stock_movements(tenant_id);
+CREATE INDEX idx_debt_items_tenant_id ON debt_items(tenant_id);
+CREATE INDEX idx_installment_payments_tenant_id ON installment_payments(tenant_id);
+CREATE INDEX idx_renegotiation_info_tenant_id ON renegotiation_info(tenant_id);
+
+DROP INDEX IF EXISTS idx_catalog_items_kind_sku;
+CREATE UNIQUE INDEX idx_catalog_items_tenant_kind_sku ON catalog_items(tenant_id, kind, sku) WHERE sku <> '' AND deleted_at IS NULL;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS last_charge_at;
\ No newline at end of file
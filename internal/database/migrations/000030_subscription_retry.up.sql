@@ -0,0 +1 @@
+ALTER TABLE subscriptions ADD COLUMN last_charge_at TIMESTAMP NULL;
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_installment_payments_tenant_reference;
+
+ALTER TABLE installment_payments DROP COLUMN IF EXISTS reference;
\ No newline at end of file
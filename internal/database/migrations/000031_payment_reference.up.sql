@@ -0,0 +1,3 @@
+ALTER TABLE installment_payments ADD COLUMN reference VARCHAR(64) NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX idx_installment_payments_tenant_reference ON installment_payments(tenant_id, reference) WHERE reference <> '';
\ No newline at end of file
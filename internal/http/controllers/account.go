@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/customvalidate"
+)
+
+type AccountController struct {
+	UserService user.Service
+}
+
+func NewAccountController(userService user.Service) *AccountController {
+	return &AccountController{
+		UserService: userService,
+	}
+}
+
+func (c *AccountController) PixAccount() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		output := c.UserService.PixAccount(r.Context())
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+func (c *AccountController) SetPixAccount() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var pixRequest user.PixAccountDto
+
+		if err := json.NewDecoder(r.Body).Decode(&pixRequest); err != nil {
+			response(w, http.StatusBadRequest, "Invalid request")
+			return
+		}
+
+		v := customvalidate.Validate(pixRequest)
+		if len(v.Errors) > 0 {
+			response(w, http.StatusUnprocessableEntity, v)
+			return
+		}
+
+		output := c.UserService.SetPixAccount(r.Context(), &pixRequest)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+func (c *AccountController) BoletoAccount() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		output := c.UserService.BoletoAccount(r.Context())
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+func (c *AccountController) SetBoletoAccount() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var boletoRequest user.BoletoAccountDto
+
+		if err := json.NewDecoder(r.Body).Decode(&boletoRequest); err != nil {
+			response(w, http.StatusBadRequest, "Invalid request")
+			return
+		}
+
+		v := customvalidate.Validate(boletoRequest)
+		if len(v.Errors) > 0 {
+			response(w, http.StatusUnprocessableEntity, v)
+			return
+		}
+
+		output := c.UserService.SetBoletoAccount(r.Context(), &boletoRequest)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
@@ -0,0 +1,94 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/customvalidate"
+)
+
+type AuthController struct {
+	UserService user.Service
+}
+
+func NewAuthController(userService user.Service) *AuthController {
+	return &AuthController{
+		UserService: userService,
+	}
+}
+
+func (c *AuthController) Register() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var registerRequest user.RegisterDto
+
+		if err := json.NewDecoder(r.Body).Decode(&registerRequest); err != nil {
+			response(w, http.StatusBadRequest, "Invalid request")
+			return
+		}
+
+		v := customvalidate.Validate(registerRequest)
+		if len(v.Errors) > 0 {
+			response(w, http.StatusUnprocessableEntity, v)
+			return
+		}
+
+		output := c.UserService.Register(r.Context(), &registerRequest)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusCreated, output)
+	})
+}
+
+func (c *AuthController) Login() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var loginRequest user.LoginDto
+
+		if err := json.NewDecoder(r.Body).Decode(&loginRequest); err != nil {
+			response(w, http.StatusBadRequest, "Invalid request")
+			return
+		}
+
+		v := customvalidate.Validate(loginRequest)
+		if len(v.Errors) > 0 {
+			response(w, http.StatusUnprocessableEntity, v)
+			return
+		}
+
+		output := c.UserService.Login(r.Context(), &loginRequest)
+		if output.Status == "error" {
+			response(w, http.StatusUnauthorized, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+func (c *AuthController) Refresh() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var refreshRequest user.RefreshDto
+
+		if err := json.NewDecoder(r.Body).Decode(&refreshRequest); err != nil {
+			response(w, http.StatusBadRequest, "Invalid request")
+			return
+		}
+
+		v := customvalidate.Validate(refreshRequest)
+		if len(v.Errors) > 0 {
+			response(w, http.StatusUnprocessableEntity, v)
+			return
+		}
+
+		output := c.UserService.Refresh(r.Context(), &refreshRequest)
+		if output.Status == "error" {
+			response(w, http.StatusUnauthorized, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
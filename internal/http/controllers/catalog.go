@@ -0,0 +1,150 @@
+package controllers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/catalog"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/customvalidate"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+)
+
+// CatalogController serves the products or the services of the catalog,
+// depending on the kind it was built with.
+type CatalogController struct {
+	CatalogService catalog.Service
+	kind           catalog.Kind
+}
+
+func NewCatalogController(catalogService catalog.Service, kind catalog.Kind) *CatalogController {
+	return &CatalogController{
+		CatalogService: catalogService,
+		kind:           kind,
+	}
+}
+
+func (c *CatalogController) Create() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var itemRequest catalog.ItemDto
+
+		if err := json.NewDecoder(r.Body).Decode(&itemRequest); err != nil {
+			response(w, http.StatusBadRequest, "Invalid request")
+			return
+		}
+
+		v := customvalidate.Validate(itemRequest)
+		if len(v.Errors) > 0 {
+			response(w, http.StatusUnprocessableEntity, v)
+			return
+		}
+
+		output := c.CatalogService.Create(r.Context(), c.kind, &itemRequest)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusCreated, output)
+	})
+}
+
+func (c *CatalogController) Update() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		itemId, ok := c.itemId(w, r)
+		if !ok {
+			return
+		}
+
+		var itemRequest catalog.ItemDto
+		if err := json.NewDecoder(r.Body).Decode(&itemRequest); err != nil {
+			response(w, http.StatusBadRequest, "Invalid request")
+			return
+		}
+
+		v := customvalidate.Validate(itemRequest)
+		if len(v.Errors) > 0 {
+			response(w, http.StatusUnprocessableEntity, v)
+			return
+		}
+
+		output := c.CatalogService.Update(r.Context(), c.kind, itemId, &itemRequest)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+func (c *CatalogController) Delete() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		itemId, ok := c.itemId(w, r)
+		if !ok {
+			return
+		}
+
+		output := c.CatalogService.Delete(r.Context(), c.kind, itemId)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusNoContent, nil)
+	})
+}
+
+func (c *CatalogController) FindOne() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		itemId, ok := c.itemId(w, r)
+		if !ok {
+			return
+		}
+
+		output := c.CatalogService.FindById(r.Context(), c.kind, itemId)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+func (c *CatalogController) FindAll() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pgRequest, err := paginate.GetPaginateParams(r)
+		if err != nil {
+			log.Println("Error getting pagination params:", err)
+			response(w, http.StatusBadRequest, "Invalid pagination params")
+			return
+		}
+
+		output := c.CatalogService.FindByCriteria(r.Context(), c.kind, pgRequest)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+func (c *CatalogController) itemId(w http.ResponseWriter, r *http.Request) (ulid.ULID, bool) {
+	itemId := chi.URLParam(r, "itemId")
+	if itemId == "" {
+		response(w, http.StatusBadRequest, "Missing "+string(c.kind)+" ID")
+		return ulid.ULID{}, false
+	}
+
+	itemIdParsed, err := ulid.Parse(itemId)
+	if err != nil {
+		response(w, http.StatusBadRequest, "Invalid "+string(c.kind)+" ID")
+		return ulid.ULID{}, false
+	}
+
+	return itemIdParsed, true
+}
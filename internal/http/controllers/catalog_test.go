@@ -0,0 +1,105 @@
+package controllers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/catalog"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/catalog/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/controllers"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCatalogController(t *testing.T) {
+	t.Run("deve criar um produto", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		controller := controllers.NewCatalogController(catalog.NewCatalogService(repo), catalog.ProductKind)
+		r := chi.NewRouter()
+		r.Post("/v1/product", controller.Create())
+
+		jsonBody, err := json.Marshal(catalog.ItemDto{
+			Name:         "Camisa",
+			DefaultPrice: money.FromCents(4990),
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/product", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("deve retornar 422 quando o nome nao for informado", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		controller := controllers.NewCatalogController(catalog.NewCatalogService(repo), catalog.ServiceKind)
+		r := chi.NewRouter()
+		r.Post("/v1/service", controller.Create())
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/service", bytes.NewBufferString(`{"default_price": "10.00"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("deve buscar um servico pelo ID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		id := ulid.Make()
+		repo := mocks.NewMockRepository(ctrl)
+		repo.EXPECT().FindById(gomock.Any(), catalog.ServiceKind, id).Return(&catalog.Item{
+			Id:     id,
+			Kind:   catalog.ServiceKind,
+			Name:   "Ajuste",
+			Unit:   "h",
+			Active: true,
+		}, nil)
+
+		controller := controllers.NewCatalogController(catalog.NewCatalogService(repo), catalog.ServiceKind)
+		r := chi.NewRouter()
+		r.Get("/v1/service/{itemId}", controller.FindOne())
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/service/"+id.String(), nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"name":"Ajuste"`)
+	})
+
+	t.Run("deve retornar 400 quando o ID for invalido", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		controller := controllers.NewCatalogController(catalog.NewCatalogService(mocks.NewMockRepository(ctrl)), catalog.ProductKind)
+		r := chi.NewRouter()
+		r.Delete("/v1/product/{itemId}", controller.Delete())
+
+		req := httptest.NewRequest(http.MethodDelete, "/v1/product/invalid", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -38,6 +38,10 @@ func (c *ClientController) Create() http.HandlerFunc {
 		}
 
 		output := c.ClientService.Create(r.Context(), &cliRequest)
+		if limitReached(w, output) {
+			return
+		}
+
 		if output.Status == "error" {
 			response(w, http.StatusInternalServerError, output)
 			return
@@ -3,6 +3,11 @@ package controllers
 import (
 	"encoding/json"
 	"net/http"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/plan"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/oklog/ulid/v2"
 )
 
 func response(w http.ResponseWriter, statusCode int, data any) {
@@ -10,3 +15,25 @@ func response(w http.ResponseWriter, statusCode int, data any) {
 	w.WriteHeader(statusCode)
 	json.NewEncoder(w).Encode(data)
 }
+
+// authenticatedUser returns the user set in the request context by the auth
+// middleware, answering 401 when there is none.
+func authenticatedUser(w http.ResponseWriter, r *http.Request) (ulid.ULID, bool) {
+	userId, ok := user.IdFromContext(r.Context())
+	if !ok {
+		response(w, http.StatusUnauthorized, "Unauthorized")
+	}
+
+	return userId, ok
+}
+
+// limitReached answers 402 when the plan of the account refused to create
+// one more resource, so that the app can offer an upgrade.
+func limitReached(w http.ResponseWriter, output shared.ServiceResponse) bool {
+	if output.Code != plan.LimitReachedCode {
+		return false
+	}
+
+	response(w, http.StatusPaymentRequired, output)
+	return true
+}
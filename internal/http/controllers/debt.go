@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"net/http"
 
@@ -37,6 +38,10 @@ func (c *DebtController) CreateDebt() http.HandlerFunc {
 		}
 
 		output := c.DebtService.CreateDebt(r.Context(), &request)
+		if limitReached(w, output) {
+			return
+		}
+
 		if output.Status == "error" {
 			response(w, http.StatusInternalServerError, output.Data)
 			return
@@ -108,6 +113,93 @@ func (c *DebtController) GetDebtInstallments() http.HandlerFunc {
 	})
 }
 
+// GetInstallmentPix answers the Pix charge of the installment, static unless
+// ?type=dynamic is asked, or only its QR code when ?format=png.
+func (c *DebtController) GetInstallmentPix() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientId, err := ulid.Parse(chi.URLParam(r, "clientId"))
+		if err != nil {
+			response(w, http.StatusBadRequest, "clientId invalid")
+			return
+		}
+
+		debtId, err := ulid.Parse(chi.URLParam(r, "debtId"))
+		if err != nil {
+			response(w, http.StatusBadRequest, "debtId invalid")
+			return
+		}
+
+		installmentId, err := ulid.Parse(chi.URLParam(r, "installmentId"))
+		if err != nil {
+			response(w, http.StatusBadRequest, "installmentId invalid")
+			return
+		}
+
+		dynamic := r.URL.Query().Get("type") == "dynamic"
+
+		result := c.DebtService.InstallmentPix(r.Context(), clientId, debtId, installmentId, dynamic)
+		if result.Status == "error" {
+			response(w, http.StatusInternalServerError, result.Message)
+			return
+		}
+
+		if r.URL.Query().Get("format") == "png" {
+			charge := result.Data.(debt.InstallmentPixDto)
+			w.Header().Set("Content-Type", "image/png")
+			w.WriteHeader(http.StatusOK)
+			w.Write(charge.QRCode)
+			return
+		}
+
+		response(w, http.StatusOK, result)
+	})
+}
+
+func (c *DebtController) GetInstallmentBoleto() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientId, err := ulid.Parse(chi.URLParam(r, "clientId"))
+		if err != nil {
+			response(w, http.StatusBadRequest, "clientId invalid")
+			return
+		}
+
+		debtId, err := ulid.Parse(chi.URLParam(r, "debtId"))
+		if err != nil {
+			response(w, http.StatusBadRequest, "debtId invalid")
+			return
+		}
+
+		installmentId, err := ulid.Parse(chi.URLParam(r, "installmentId"))
+		if err != nil {
+			response(w, http.StatusBadRequest, "installmentId invalid")
+			return
+		}
+
+		result := c.DebtService.InstallmentBoleto(r.Context(), clientId, debtId, installmentId)
+		if result.Status == "error" {
+			response(w, http.StatusInternalServerError, result.Message)
+			return
+		}
+
+		slip := result.Data.(debt.InstallmentBoletoDto)
+		switch r.URL.Query().Get("format") {
+		case "pdf":
+			w.Header().Set("Content-Type", "application/pdf")
+			w.Header().Set("Content-Disposition", fmt.Sprintf(`inline; filename="boleto-%s.pdf"`, installmentId))
+			w.WriteHeader(http.StatusOK)
+			w.Write(slip.PDF)
+			return
+		case "png":
+			w.Header().Set("Content-Type", "image/png")
+			w.WriteHeader(http.StatusOK)
+			w.Write(slip.BarcodeImage)
+			return
+		}
+
+		response(w, http.StatusOK, result)
+	})
+}
+
 func (c *DebtController) GetDebts() http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		pgRequest, err := paginate.GetPaginateParams(r)
@@ -128,6 +220,19 @@ func (c *DebtController) GetDebts() http.HandlerFunc {
 	})
 }
 
+func (c *DebtController) GetOverdueInstallments() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		result := c.DebtService.OverdueInstallments(r.Context())
+
+		if result.Status == "error" {
+			response(w, http.StatusInternalServerError, result.Message)
+			return
+		}
+
+		response(w, http.StatusOK, result)
+	})
+}
+
 func (c *DebtController) PayInstallment() http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var paymentInfo debt.PaymentInfoDto
@@ -152,6 +257,30 @@ func (c *DebtController) PayInstallment() http.HandlerFunc {
 	})
 }
 
+func (c *DebtController) SettleWithCredit() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var settlement debt.CreditSettlementDto
+		if err := json.NewDecoder(r.Body).Decode(&settlement); err != nil {
+			response(w, http.StatusBadRequest, "Invalid request")
+			return
+		}
+
+		v := customvalidate.Validate(settlement)
+		if len(v.Errors) > 0 {
+			response(w, http.StatusUnprocessableEntity, v)
+			return
+		}
+
+		output := c.DebtService.SettleWithCredit(r.Context(), &settlement)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output.Message)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
 func (c *DebtController) CancelDebt() http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var cancelInfo debt.CancelInfoDto
@@ -166,8 +295,11 @@ func (c *DebtController) CancelDebt() http.HandlerFunc {
 			return
 		}
 
-		// TODO: Ajustar para colocar o ID do usuário autenticado
-		cancelInfo.CancelledBy = ulid.Make()
+		userId, ok := authenticatedUser(w, r)
+		if !ok {
+			return
+		}
+		cancelInfo.CancelledBy = userId
 
 		output := c.DebtService.CancelDebt(r.Context(), &cancelInfo)
 		if output.Status == "error" {
@@ -193,8 +325,11 @@ func (c *DebtController) ReversalDebt() http.HandlerFunc {
 			return
 		}
 
-		// TODO: Ajustar para colocar o ID do usuário autenticado
-		reversalInfo.ReversedBy = ulid.Make()
+		userId, ok := authenticatedUser(w, r)
+		if !ok {
+			return
+		}
+		reversalInfo.ReversedBy = userId
 
 		output := c.DebtService.ReverseDebt(r.Context(), &reversalInfo)
 		if output.Status == "error" {
@@ -205,3 +340,33 @@ func (c *DebtController) ReversalDebt() http.HandlerFunc {
 		response(w, http.StatusOK, output)
 	})
 }
+
+func (c *DebtController) RenegotiateDebt() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var renegotiation debt.RenegotiationDto
+		if err := json.NewDecoder(r.Body).Decode(&renegotiation); err != nil {
+			response(w, http.StatusBadRequest, "Invalid request")
+			return
+		}
+
+		v := customvalidate.Validate(renegotiation)
+		if len(v.Errors) > 0 {
+			response(w, http.StatusUnprocessableEntity, v)
+			return
+		}
+
+		userId, ok := authenticatedUser(w, r)
+		if !ok {
+			return
+		}
+		renegotiation.RenegotiatedBy = userId
+
+		output := c.DebtService.RenegotiateDebt(r.Context(), &renegotiation)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output.Message)
+			return
+		}
+
+		response(w, http.StatusCreated, output)
+	})
+}
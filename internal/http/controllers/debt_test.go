@@ -2,6 +2,7 @@ package controllers_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -10,11 +11,17 @@ import (
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/client"
 	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
 	"github.com/henriquerocha2004/quem-me-deve-api/core/debt/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/plan"
+	planMocks "github.com/henriquerocha2004/quem-me-deve-api/core/plan/mocks"
 	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
 	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/controllers"
 	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/customvalidate"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/boleto"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
 	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -30,7 +37,9 @@ func TestDebtController(t *testing.T) {
 
 		cliRepository := mocks.NewMockClientReader(ctrl)
 
-		service := debt.NewDebtService(debtRepo, cliRepository)
+		catalogReader := mocks.NewMockCatalogReader(ctrl)
+		catalogReader.EXPECT().ActiveProductExists(gomock.Any(), gomock.Any()).Return(true, nil)
+		service := debt.NewDebtService(debtRepo, cliRepository, catalogReader, mocks.NewMockCreditWallet(ctrl))
 		controller := controllers.NewDebtController(service)
 
 		r := chi.NewRouter()
@@ -38,12 +47,10 @@ func TestDebtController(t *testing.T) {
 		dueDate := time.Now().AddDate(0, 0, 1)
 		requestBody := debt.DebtDto{
 			Description:          "Test Debt",
-			TotalValue:           1000,
 			DueDate:              dueDate.Format(time.DateOnly),
 			InstallmentsQuantity: 12,
 			UserClientId:         "01F8Z5G4J6K7N3J4X2G4J6K7N3",
-			ProductIds:           []string{"01F8Z5G4J6K7N3J4X2G4J6K7N3"},
-			ServiceIds:           []string{"01F8Z5G4J6K7N3J4X2G4J6K7N3"},
+			Items:                []debt.DebtItemDto{{ProductId: "01F8Z5G4J6K7N3J4X2G4J6K7N3", Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 		}
 
 		jsonBody, err := json.Marshal(requestBody)
@@ -56,6 +63,93 @@ func TestDebtController(t *testing.T) {
 		assert.Equal(t, http.StatusCreated, w.Code)
 	})
 
+	t.Run("Deve retornar 402 ao criar uma divida acima do limite do plano", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		debtRepo := mocks.NewMockRepository(ctrl)
+		debtRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+		limits := planMocks.NewMockLimitChecker(ctrl)
+		limits.EXPECT().ReserveLimit(gomock.Any(), plan.FeatureDebt).Return(nil, plan.ErrLimitReached)
+
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl)).
+			WithPlanLimits(limits)
+		controller := controllers.NewDebtController(service)
+
+		r := chi.NewRouter()
+		r.Post("/v1/debt", controller.CreateDebt())
+		dueDate := time.Now().AddDate(0, 0, 1)
+		requestBody := debt.DebtDto{
+			Description:          "Test Debt",
+			DueDate:              dueDate.Format(time.DateOnly),
+			InstallmentsQuantity: 1,
+			UserClientId:         "01F8Z5G4J6K7N3J4X2G4J6K7N3",
+			Items:                []debt.DebtItemDto{{ProductId: "01F8Z5G4J6K7N3J4X2G4J6K7N3", Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
+		}
+
+		jsonBody, err := json.Marshal(requestBody)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/debt", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		var response shared.ServiceResponse
+		err = json.NewDecoder(w.Body).Decode(&response)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusPaymentRequired, w.Code)
+		assert.Equal(t, plan.LimitReachedCode, response.Code)
+	})
+
+	t.Run("Deve criar uma divida com entrada e parcelas informadas", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dueDate := time.Now().AddDate(0, 0, 1)
+
+		debtRepository := mocks.NewMockRepository(ctrl)
+		debtRepository.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, saved *debt.Debt) error {
+			assert.Len(t, saved.Intallments, 3)
+			assert.Equal(t, debt.Paid, saved.Intallments[0].Status)
+			assert.Equal(t, money.FromCents(70000), saved.Intallments[2].Value)
+			assert.Len(t, saved.StockMovements, 1)
+			assert.Equal(t, -1, saved.StockMovements[0].Quantity)
+			return nil
+		})
+		clientRepository := mocks.NewMockClientReader(ctrl)
+
+		catalogReader := mocks.NewMockCatalogReader(ctrl)
+		catalogReader.EXPECT().ActiveProductExists(gomock.Any(), gomock.Any()).Return(true, nil)
+		service := debt.NewDebtService(debtRepository, clientRepository, catalogReader, mocks.NewMockCreditWallet(ctrl))
+		controller := controllers.NewDebtController(service)
+
+		r := chi.NewRouter()
+		r.Post("/v1/debt", controller.CreateDebt())
+
+		jsonBody, err := json.Marshal(debt.DebtDto{
+			Description:       "Test Debt",
+			DueDate:           dueDate.Format(time.DateOnly),
+			UserClientId:      "01F8Z5G4J6K7N3J4X2G4J6K7N3",
+			Items:             []debt.DebtItemDto{{ProductId: "01F8Z5G4J6K7N3J4X2G4J6K7N3", Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
+			DownPayment:       money.FromCents(20000),
+			DownPaymentMethod: "pix",
+			CustomInstallments: []debt.CustomInstallmentDto{
+				{Value: money.FromCents(10000), DueDate: dueDate.Format(time.DateOnly)},
+				{Value: money.FromCents(70000), DueDate: dueDate.AddDate(0, 1, 0).Format(time.DateOnly)},
+			},
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/debt", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
 	t.Run("deve retornar erro 422 (Unprocessable Entity). Validação dos dados de entrada", func(t *testing.T) {
 
 		ctrl := gomock.NewController(t)
@@ -66,7 +160,7 @@ func TestDebtController(t *testing.T) {
 		cliRepository := mocks.NewMockClientReader(ctrl)
 		cliRepository.EXPECT().ClientExists(gomock.Any(), gomock.Any()).Return(true, nil).Times(0)
 
-		service := debt.NewDebtService(debtRepo, cliRepository)
+		service := debt.NewDebtService(debtRepo, cliRepository, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		controller := controllers.NewDebtController(service)
 
 		r := chi.NewRouter()
@@ -79,25 +173,35 @@ func TestDebtController(t *testing.T) {
 			wantStatus int
 		}{
 			{
-				name: "quando valor total não for fornecido",
+				name: "quando os itens não forem fornecidos",
+				request: debt.DebtDto{
+					Description:          "Test Debt",
+					DueDate:              time.Now().AddDate(0, 0, 1).Format(time.DateOnly),
+					InstallmentsQuantity: 12,
+					UserClientId:         "01F8Z5G4J6K7N3J4X2G4J6K7N3",
+				},
+				wantFields: []string{"Items"},
+				wantStatus: http.StatusUnprocessableEntity,
+			},
+			{
+				name: "quando o item não referenciar produto nem serviço",
 				request: debt.DebtDto{
 					Description:          "Test Debt",
 					DueDate:              time.Now().AddDate(0, 0, 1).Format(time.DateOnly),
 					InstallmentsQuantity: 12,
 					UserClientId:         "01F8Z5G4J6K7N3J4X2G4J6K7N3",
-					ProductIds:           []string{"01F8Z5G4J6K7N3J4X2G4J6K7N3"},
+					Items:                []debt.DebtItemDto{{Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 				},
-				wantFields: []string{"TotalValue"},
+				wantFields: []string{"ProductId", "ServiceId"},
 				wantStatus: http.StatusUnprocessableEntity,
 			},
 			{
 				name: "quando data de vencimento não for fornecida",
 				request: debt.DebtDto{
 					Description:          "Test Debt",
-					TotalValue:           1000,
 					InstallmentsQuantity: 12,
 					UserClientId:         "01F8Z5G4J6K7N3J4X2G4J6K7N3",
-					ProductIds:           []string{"01F8Z5G4J6K7N3J4X2G4J6K7N3"},
+					Items:                []debt.DebtItemDto{{ProductId: "01F8Z5G4J6K7N3J4X2G4J6K7N3", Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 				},
 				wantFields: []string{"DueDate"},
 				wantStatus: http.StatusUnprocessableEntity,
@@ -106,10 +210,9 @@ func TestDebtController(t *testing.T) {
 				name: "quando ID do cliente não for fornecido",
 				request: debt.DebtDto{
 					Description:          "Test Debt",
-					TotalValue:           1000,
 					DueDate:              time.Now().AddDate(0, 0, 1).Format(time.DateOnly),
 					InstallmentsQuantity: 12,
-					ProductIds:           []string{"01F8Z5G4J6K7N3J4X2G4J6K7N3"},
+					Items:                []debt.DebtItemDto{{ProductId: "01F8Z5G4J6K7N3J4X2G4J6K7N3", Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 				},
 				wantFields: []string{"UserClientId"},
 				wantStatus: http.StatusUnprocessableEntity,
@@ -118,15 +221,26 @@ func TestDebtController(t *testing.T) {
 				name: "quando não for fornecido o campo quantidade de parcelas",
 				request: debt.DebtDto{
 					Description:  "Test Debt",
-					TotalValue:   1000,
 					DueDate:      time.Now().AddDate(0, 0, 1).Format(time.DateOnly),
 					UserClientId: "01F8Z5G4J6K7N3J4X2G4J6K7N3",
-					ProductIds:   []string{"01F8Z5G4J6K7N3J4X2G4J6K7N3"},
-					ServiceIds:   []string{"01F8Z5G4J6K7N3J4X2G4J6K7N3"},
+					Items:        []debt.DebtItemDto{{ProductId: "01F8Z5G4J6K7N3J4X2G4J6K7N3", Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 				},
 				wantFields: []string{"InstallmentsQuantity"},
 				wantStatus: http.StatusUnprocessableEntity,
 			},
+			{
+				name: "quando a entrada for informada sem forma de pagamento",
+				request: debt.DebtDto{
+					Description:          "Test Debt",
+					DueDate:              time.Now().AddDate(0, 0, 1).Format(time.DateOnly),
+					InstallmentsQuantity: 2,
+					UserClientId:         "01F8Z5G4J6K7N3J4X2G4J6K7N3",
+					Items:                []debt.DebtItemDto{{ProductId: "01F8Z5G4J6K7N3J4X2G4J6K7N3", Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
+					DownPayment:          money.FromCents(20000),
+				},
+				wantFields: []string{"DownPaymentMethod"},
+				wantStatus: http.StatusUnprocessableEntity,
+			},
 		}
 
 		for _, tc := range testCases {
@@ -180,13 +294,12 @@ func TestDebtController(t *testing.T) {
 			{
 				Description:          "Test Debt",
 				Id:                   ulid.Make(),
-				TotalValue:           1000,
+				TotalValue:           money.FromCents(100000),
 				DueDate:              &duedate,
 				Status:               debt.Pending,
 				UserClientId:         clientId,
 				InstallmentsQuantity: 2,
-				ServiceIds:           []ulid.ULID{ulid.Make()},
-				ProductIds:           []ulid.ULID{ulid.Make()},
+				Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 				DebtDate:             &now,
 			},
 		}
@@ -195,7 +308,7 @@ func TestDebtController(t *testing.T) {
 		debtRepository.EXPECT().ClientUserDebts(gomock.Any(), clientId).Return(debts, nil)
 		cliRepository := mocks.NewMockClientReader(ctrl)
 
-		service := debt.NewDebtService(debtRepository, cliRepository)
+		service := debt.NewDebtService(debtRepository, cliRepository, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		controller := controllers.NewDebtController(service)
 
 		r := chi.NewRouter()
@@ -221,7 +334,7 @@ func TestDebtController(t *testing.T) {
 		debtRepository.EXPECT().ClientUserDebts(gomock.Any(), gomock.Any()).Times(0)
 		clientRepository := mocks.NewMockClientReader(ctrl)
 
-		service := debt.NewDebtService(debtRepository, clientRepository)
+		service := debt.NewDebtService(debtRepository, clientRepository, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		controller := controllers.NewDebtController(service)
 
 		r := chi.NewRouter()
@@ -262,7 +375,7 @@ func TestDebtController(t *testing.T) {
 			{
 				Id:            ulid.Make(),
 				Description:   "Referente a compra de CD",
-				Value:         600,
+				Value:         money.FromCents(60000),
 				DueDate:       &duedateFirstInstallment,
 				DebDate:       &duedateFirstInstallment,
 				Status:        debt.Pending,
@@ -273,7 +386,7 @@ func TestDebtController(t *testing.T) {
 			{
 				Id:            ulid.Make(),
 				Description:   "Referente a compra de CD",
-				Value:         600,
+				Value:         money.FromCents(60000),
 				DueDate:       &duedateSecondInstallment,
 				DebDate:       &duedateSecondInstallment,
 				Status:        debt.Pending,
@@ -287,7 +400,7 @@ func TestDebtController(t *testing.T) {
 		debtRepository.EXPECT().DebtInstallments(gomock.Any(), debtId).Return(installments, nil)
 		clientRepository := mocks.NewMockClientReader(ctrl)
 		clientRepository.EXPECT().ClientExists(gomock.Any(), clientId).Return(true, nil)
-		service := debt.NewDebtService(debtRepository, clientRepository)
+		service := debt.NewDebtService(debtRepository, clientRepository, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		controller := controllers.NewDebtController(service)
 
 		r := chi.NewRouter()
@@ -314,7 +427,7 @@ func TestDebtController(t *testing.T) {
 
 		debtRepository.EXPECT().DebtInstallments(gomock.Any(), gomock.Any()).Times(0)
 		clientRepository.EXPECT().ClientExists(gomock.Any(), gomock.Any()).Times(0)
-		service := debt.NewDebtService(debtRepository, clientRepository)
+		service := debt.NewDebtService(debtRepository, clientRepository, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		controller := controllers.NewDebtController(service)
 		r := chi.NewRouter()
 		r.Get("/v1/debt/{clientId}/{debtId}/installments", controller.GetDebtInstallments())
@@ -341,7 +454,7 @@ func TestDebtController(t *testing.T) {
 
 		debtRepository.EXPECT().DebtInstallments(gomock.Any(), gomock.Any()).Times(0)
 		clientRepository.EXPECT().ClientExists(gomock.Any(), gomock.Any()).Times(0)
-		service := debt.NewDebtService(debtRepository, clientRepository)
+		service := debt.NewDebtService(debtRepository, clientRepository, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		controller := controllers.NewDebtController(service)
 		r := chi.NewRouter()
 		r.Get("/v1/debt/{clientId}/{debtId}/installments", controller.GetDebtInstallments())
@@ -370,13 +483,12 @@ func TestDebtController(t *testing.T) {
 			{
 				Description:          "Test Debt",
 				Id:                   ulid.Make(),
-				TotalValue:           1000,
+				TotalValue:           money.FromCents(100000),
 				DueDate:              &duedate,
 				Status:               debt.Pending,
 				UserClientId:         clientId,
 				InstallmentsQuantity: 2,
-				ServiceIds:           []ulid.ULID{ulid.Make()},
-				ProductIds:           []ulid.ULID{ulid.Make()},
+				Items:                []debt.DebtItem{{Type: debt.ProductItem, ReferenceId: ulid.Make(), Description: "Produto", Quantity: 1, UnitPrice: money.FromCents(100000)}},
 				DebtDate:             &now,
 			},
 		}
@@ -390,7 +502,7 @@ func TestDebtController(t *testing.T) {
 		debtRepository.EXPECT().GetDebts(gomock.Any(), gomock.Any()).Return(pgResult, nil)
 		clientRepository := mocks.NewMockClientReader(ctrl)
 
-		service := debt.NewDebtService(debtRepository, clientRepository)
+		service := debt.NewDebtService(debtRepository, clientRepository, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		controller := controllers.NewDebtController(service)
 
 		r := chi.NewRouter()
@@ -429,7 +541,7 @@ func TestDebtController(t *testing.T) {
 		paymentInfo := &debt.PaymentInfoDto{
 			DebtId:        debtId.String(),
 			InstallmentId: installmentId.String(),
-			Amount:        500,
+			Amount:        money.FromCents(50000),
 			PaymentMethod: "Credit Card",
 		}
 
@@ -441,7 +553,7 @@ func TestDebtController(t *testing.T) {
 				{
 					Id:            installmentId,
 					Description:   "Test Installment",
-					Value:         500,
+					Value:         money.FromCents(50000),
 					DueDate:       nil,
 					DebDate:       nil,
 					Status:        debt.Pending,
@@ -452,7 +564,7 @@ func TestDebtController(t *testing.T) {
 				{
 					Id:            ulid.Make(),
 					Description:   "Test Installment 2",
-					Value:         500,
+					Value:         money.FromCents(50000),
 					DueDate:       nil,
 					DebDate:       nil,
 					Status:        debt.Pending,
@@ -469,7 +581,7 @@ func TestDebtController(t *testing.T) {
 		debtRepository.EXPECT().Update(gomock.Any(), d).Return(nil)
 		clientRepository := mocks.NewMockClientReader(ctrl)
 
-		service := debt.NewDebtService(debtRepository, clientRepository)
+		service := debt.NewDebtService(debtRepository, clientRepository, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		controller := controllers.NewDebtController(service)
 
 		r := chi.NewRouter()
@@ -502,7 +614,7 @@ func TestDebtController(t *testing.T) {
 		debtRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
 		clientRepository.EXPECT().ClientExists(gomock.Any(), gomock.Any()).Times(0)
 
-		service := debt.NewDebtService(debtRepository, clientRepository)
+		service := debt.NewDebtService(debtRepository, clientRepository, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		controller := controllers.NewDebtController(service)
 
 		r := chi.NewRouter()
@@ -511,7 +623,7 @@ func TestDebtController(t *testing.T) {
 		paymentInfo := &debt.PaymentInfoDto{
 			DebtId:        "invalidDebtId",
 			InstallmentId: "invalidInstallmentId",
-			Amount:        0,
+			Amount:        money.FromCents(0),
 			PaymentMethod: "",
 		}
 
@@ -539,6 +651,58 @@ func TestDebtController(t *testing.T) {
 		assert.Equal(t, "This field is required", response.Errors[3].Message)
 	})
 
+	t.Run("Deve quitar uma parcela com o credito do cliente", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientId := ulid.Make()
+		debtId := ulid.Make()
+		installmentId := ulid.Make()
+
+		d := &debt.Debt{
+			Id:                   debtId,
+			UserClientId:         clientId,
+			InstallmentsQuantity: 1,
+			Intallments: []debt.Installment{
+				{
+					Id:          installmentId,
+					Description: "Test Installment",
+					Value:       money.FromCents(10000),
+					Status:      debt.Pending,
+					Number:      1,
+				},
+			},
+			Status: debt.Pending,
+		}
+
+		debtRepository := mocks.NewMockRepository(ctrl)
+		debtRepository.EXPECT().GetDebt(gomock.Any(), debtId).Return(d, nil)
+		debtRepository.EXPECT().UpdateWithPayment(gomock.Any(), d, gomock.Any()).Return(nil)
+		clientRepository := mocks.NewMockClientReader(ctrl)
+		wallet := mocks.NewMockCreditWallet(ctrl)
+		wallet.EXPECT().Balance(gomock.Any(), clientId).Return(money.FromCents(15000), nil)
+
+		service := debt.NewDebtService(debtRepository, clientRepository, mocks.NewMockCatalogReader(ctrl), wallet)
+		controller := controllers.NewDebtController(service)
+
+		r := chi.NewRouter()
+		r.Post("/v1/debt/settle-credit", controller.SettleWithCredit())
+
+		jsonBody, err := json.Marshal(debt.CreditSettlementDto{
+			DebtId:        debtId.String(),
+			InstallmentId: installmentId.String(),
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/debt/settle-credit", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, debt.Paid, d.Intallments[0].Status)
+	})
+
 	t.Run("Deve cancelar uma dívida com sucesso", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -563,7 +727,7 @@ func TestDebtController(t *testing.T) {
 		debtRepository.EXPECT().Update(gomock.Any(), d).Return(nil)
 		clientRepository := mocks.NewMockClientReader(ctrl)
 
-		service := debt.NewDebtService(debtRepository, clientRepository)
+		service := debt.NewDebtService(debtRepository, clientRepository, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		controller := controllers.NewDebtController(service)
 
 		r := chi.NewRouter()
@@ -573,6 +737,7 @@ func TestDebtController(t *testing.T) {
 		assert.NoError(t, err)
 
 		req := httptest.NewRequest(http.MethodPost, "/v1/debt/cancel", bytes.NewBuffer(jsonBody))
+		req = req.WithContext(user.WithUserId(req.Context(), ulid.Make()))
 		req.Header.Set("Content-Type", "application/json")
 		w := httptest.NewRecorder()
 		r.ServeHTTP(w, req)
@@ -585,6 +750,33 @@ func TestDebtController(t *testing.T) {
 		assert.Equal(t, "debt cancelled successfully", response.Message)
 	})
 
+	t.Run("Deve retornar 401 ao cancelar uma dívida sem usuário autenticado", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		debtRepository := mocks.NewMockRepository(ctrl)
+		debtRepository.EXPECT().GetDebt(gomock.Any(), gomock.Any()).Times(0)
+
+		service := debt.NewDebtService(debtRepository, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
+		controller := controllers.NewDebtController(service)
+
+		r := chi.NewRouter()
+		r.Post("/v1/debt/cancel", controller.CancelDebt())
+
+		jsonBody, err := json.Marshal(&debt.CancelInfoDto{
+			DebtId: "01F8Z5G4J6K7N3J4X2G4J6K7N3",
+			Reason: "Client requested cancellation",
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/debt/cancel", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
 	t.Run("Deve retornar um erro ao tentar cancelar uma dívida com dados inválidos", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -596,7 +788,7 @@ func TestDebtController(t *testing.T) {
 		debtRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
 		clientRepository.EXPECT().ClientExists(gomock.Any(), gomock.Any()).Times(0)
 
-		service := debt.NewDebtService(debtRepository, clientRepository)
+		service := debt.NewDebtService(debtRepository, clientRepository, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		controller := controllers.NewDebtController(service)
 
 		r := chi.NewRouter()
@@ -651,7 +843,7 @@ func TestDebtController(t *testing.T) {
 		debtRepository.EXPECT().Update(gomock.Any(), d).Return(nil)
 		clientRepository := mocks.NewMockClientReader(ctrl)
 
-		service := debt.NewDebtService(debtRepository, clientRepository)
+		service := debt.NewDebtService(debtRepository, clientRepository, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		controller := controllers.NewDebtController(service)
 
 		r := chi.NewRouter()
@@ -661,6 +853,7 @@ func TestDebtController(t *testing.T) {
 		assert.NoError(t, err)
 
 		req := httptest.NewRequest(http.MethodPost, "/v1/debt/reversal", bytes.NewBuffer(jsonBody))
+		req = req.WithContext(user.WithUserId(req.Context(), ulid.Make()))
 		req.Header.Set("Content-Type", "application/json")
 		w := httptest.NewRecorder()
 		r.ServeHTTP(w, req)
@@ -684,7 +877,7 @@ func TestDebtController(t *testing.T) {
 		debtRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
 		clientRepository.EXPECT().ClientExists(gomock.Any(), gomock.Any()).Times(0)
 
-		service := debt.NewDebtService(debtRepository, clientRepository)
+		service := debt.NewDebtService(debtRepository, clientRepository, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
 		controller := controllers.NewDebtController(service)
 
 		r := chi.NewRouter()
@@ -714,4 +907,193 @@ func TestDebtController(t *testing.T) {
 		assert.Equal(t, "Reason", response.Errors[1].Field)
 		assert.Equal(t, "This field is required", response.Errors[1].Message)
 	})
+
+	t.Run("Deve renegociar uma dívida com sucesso", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		debtId := ulid.Make()
+		dueDate := time.Now().Add(24 * time.Hour)
+
+		d := &debt.Debt{
+			Id:                   debtId,
+			UserClientId:         ulid.Make(),
+			TotalValue:           money.FromCents(100000),
+			DueDate:              &dueDate,
+			InstallmentsQuantity: 2,
+			Status:               debt.Pending,
+		}
+		d.GenerateInstallments()
+
+		debtRepository := mocks.NewMockRepository(ctrl)
+		debtRepository.EXPECT().GetDebt(gomock.Any(), debtId).Return(d, nil)
+		debtRepository.EXPECT().SaveRenegotiation(gomock.Any(), d, gomock.Any()).Return(nil)
+		clientRepository := mocks.NewMockClientReader(ctrl)
+
+		service := debt.NewDebtService(debtRepository, clientRepository, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
+		controller := controllers.NewDebtController(service)
+
+		r := chi.NewRouter()
+		r.Post("/v1/debt/renegotiate", controller.RenegotiateDebt())
+
+		jsonBody, err := json.Marshal(debt.RenegotiationDto{
+			DebtId:               debtId.String(),
+			InstallmentsQuantity: 4,
+			FirstDueDate:         time.Now().AddDate(0, 1, 0).Format(time.DateOnly),
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/debt/renegotiate", bytes.NewBuffer(jsonBody))
+		req = req.WithContext(user.WithUserId(req.Context(), ulid.Make()))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		var response shared.ServiceResponse
+		err = json.NewDecoder(w.Body).Decode(&response)
+		assert.Nil(t, err)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, "debt renegotiated successfully", response.Message)
+		assert.Equal(t, debt.Renegotiated, d.Status)
+	})
+
+	t.Run("Deve retornar um erro ao tentar renegociar uma dívida com dados inválidos", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		debtRepository := mocks.NewMockRepository(ctrl)
+		clientRepository := mocks.NewMockClientReader(ctrl)
+
+		debtRepository.EXPECT().GetDebt(gomock.Any(), gomock.Any()).Times(0)
+		debtRepository.EXPECT().SaveRenegotiation(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		service := debt.NewDebtService(debtRepository, clientRepository, mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
+		controller := controllers.NewDebtController(service)
+
+		r := chi.NewRouter()
+		r.Post("/v1/debt/renegotiate", controller.RenegotiateDebt())
+
+		jsonBody, err := json.Marshal(debt.RenegotiationDto{
+			DebtId:       ulid.Make().String(),
+			FirstDueDate: "01/02/2030",
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/debt/renegotiate", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		var response customvalidate.ValidationResponse
+		err = json.NewDecoder(w.Body).Decode(&response)
+		assert.Nil(t, err)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, 2, len(response.Errors))
+		assert.Equal(t, "InstallmentsQuantity", response.Errors[0].Field)
+		assert.Equal(t, "FirstDueDate", response.Errors[1].Field)
+		assert.Equal(t, "Invalid date format, expected YYYY-MM-DD", response.Errors[1].Message)
+	})
+
+	t.Run("Deve retornar o QR code pix de uma parcela como imagem", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Status:               debt.Pending,
+			TotalValue:           money.FromCents(50000),
+			InstallmentsQuantity: 1,
+		}
+		d.GenerateInstallments()
+
+		professional, _ := user.NewUser("Maria", "maria@email.com", "12345678")
+		professional.Pix = &user.PixAccount{Key: "maria@email.com", MerchantName: "Maria Silva", MerchantCity: "Salvador"}
+
+		debtRepo := mocks.NewMockRepository(ctrl)
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+		accounts := mocks.NewMockAccountReader(ctrl)
+		accounts.EXPECT().FindById(gomock.Any(), professional.Id).Return(professional, nil)
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl)).
+			WithPix(accounts, "")
+		controller := controllers.NewDebtController(service)
+
+		r := chi.NewRouter()
+		r.Get("/v1/debt/{clientId}/{debtId}/installments/{installmentId}/pix", controller.GetInstallmentPix())
+
+		path := "/v1/debt/" + d.UserClientId.String() + "/" + d.Id.String() + "/installments/" + d.Intallments[0].Id.String() + "/pix?format=png"
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req = req.WithContext(shared.WithTenant(req.Context(), professional.Id))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "image/png", w.Header().Get("Content-Type"))
+		assert.Equal(t, []byte("\x89PNG"), w.Body.Bytes()[:4])
+	})
+
+	t.Run("Deve retornar um erro caso seja informado um installment id inválido ao gerar o pix", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service := debt.NewDebtService(mocks.NewMockRepository(ctrl), mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl))
+		controller := controllers.NewDebtController(service)
+
+		r := chi.NewRouter()
+		r.Get("/v1/debt/{clientId}/{debtId}/installments/{installmentId}/pix", controller.GetInstallmentPix())
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/debt/"+ulid.Make().String()+"/"+ulid.Make().String()+"/installments/abc/pix", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Deve retornar o boleto de uma parcela em PDF", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Status:               debt.Pending,
+			TotalValue:           money.FromCents(50000),
+			InstallmentsQuantity: 1,
+		}
+		d.GenerateInstallments()
+
+		professional, _ := user.NewUser("Maria", "maria@email.com", "12345678")
+		professional.Boleto = &user.BoletoAccount{
+			Account:             boleto.Account{Bank: boleto.BancoDoBrasilCode, Agency: "1234-5", Number: "12345-6", Wallet: "17", Agreement: "1234567"},
+			BeneficiaryName:     "Maria Silva ME",
+			BeneficiaryDocument: "11222333000181",
+		}
+
+		debtRepo := mocks.NewMockRepository(ctrl)
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+		accounts := mocks.NewMockAccountReader(ctrl)
+		accounts.EXPECT().FindById(gomock.Any(), professional.Id).Return(professional, nil)
+		clients := mocks.NewMockClientFinder(ctrl)
+		clients.EXPECT().FindById(gomock.Any(), d.UserClientId).Return(&client.Client{Name: "Padaria", Document: "11444777000161"}, nil)
+		numbers := mocks.NewMockBoletoNumbers(ctrl)
+		numbers.EXPECT().OurNumber(gomock.Any(), d.Intallments[0].Id).Return(int64(1), nil)
+		service := debt.NewDebtService(debtRepo, mocks.NewMockClientReader(ctrl), mocks.NewMockCatalogReader(ctrl), mocks.NewMockCreditWallet(ctrl)).
+			WithBoleto(accounts, clients, numbers)
+		controller := controllers.NewDebtController(service)
+
+		r := chi.NewRouter()
+		r.Get("/v1/debt/{clientId}/{debtId}/installments/{installmentId}/boleto", controller.GetInstallmentBoleto())
+
+		path := "/v1/debt/" + d.UserClientId.String() + "/" + d.Id.String() + "/installments/" + d.Intallments[0].Id.String() + "/boleto?format=pdf"
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req = req.WithContext(shared.WithTenant(req.Context(), professional.Id))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+		assert.Equal(t, []byte("%PDF"), w.Body.Bytes()[:4])
+	})
 }
@@ -0,0 +1,82 @@
+package controllers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/notification"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+)
+
+type NotificationController struct {
+	NotificationService notification.Service
+}
+
+func NewNotificationController(notificationService notification.Service) *NotificationController {
+	return &NotificationController{
+		NotificationService: notificationService,
+	}
+}
+
+func (c *NotificationController) Notifications() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pgRequest, err := paginate.GetPaginateParams(r)
+		if err != nil {
+			log.Println("Error getting pagination params:", err)
+			response(w, http.StatusBadRequest, "Invalid pagination params")
+			return
+		}
+
+		output := c.NotificationService.Notifications(r.Context(), pgRequest)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+func (c *NotificationController) UnreadCount() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		output := c.NotificationService.UnreadCount(r.Context())
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+func (c *NotificationController) MarkRead() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		notificationId, err := ulid.Parse(chi.URLParam(r, "notificationId"))
+		if err != nil {
+			response(w, http.StatusBadRequest, "Invalid notification ID")
+			return
+		}
+
+		output := c.NotificationService.MarkRead(r.Context(), notificationId)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+func (c *NotificationController) MarkAllRead() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		output := c.NotificationService.MarkAllRead(r.Context())
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
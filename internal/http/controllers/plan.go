@@ -0,0 +1,68 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/plan"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/customvalidate"
+)
+
+type PlanController struct {
+	PlanService plan.Service
+}
+
+func NewPlanController(planService plan.Service) *PlanController {
+	return &PlanController{
+		PlanService: planService,
+	}
+}
+
+func (c *PlanController) Plans() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		output := c.PlanService.Plans(r.Context())
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+func (c *PlanController) Subscription() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		output := c.PlanService.Subscription(r.Context())
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+func (c *PlanController) Subscribe() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var subscribeRequest plan.SubscribeDto
+
+		if err := json.NewDecoder(r.Body).Decode(&subscribeRequest); err != nil {
+			response(w, http.StatusBadRequest, "Invalid request")
+			return
+		}
+
+		v := customvalidate.Validate(subscribeRequest)
+		if len(v.Errors) > 0 {
+			response(w, http.StatusUnprocessableEntity, v)
+			return
+		}
+
+		output := c.PlanService.Subscribe(r.Context(), &subscribeRequest)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
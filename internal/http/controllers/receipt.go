@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/receipt"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+)
+
+type ReceiptController struct {
+	ReceiptService receipt.Service
+}
+
+func NewReceiptController(receiptService receipt.Service) *ReceiptController {
+	return &ReceiptController{
+		ReceiptService: receiptService,
+	}
+}
+
+func (c *ReceiptController) Receipts() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pgRequest, err := paginate.GetPaginateParams(r)
+		if err != nil {
+			log.Println("Error getting pagination params:", err)
+			response(w, http.StatusBadRequest, "Invalid pagination params")
+			return
+		}
+
+		output := c.ReceiptService.Receipts(r.Context(), pgRequest)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output.Message)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+// Receipt answers the receipt, or downloads it again when ?format=pdf.
+func (c *ReceiptController) Receipt() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receiptId, err := ulid.Parse(chi.URLParam(r, "receiptId"))
+		if err != nil {
+			response(w, http.StatusBadRequest, "receiptId invalid")
+			return
+		}
+
+		output := c.ReceiptService.Receipt(r.Context(), receiptId)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output.Message)
+			return
+		}
+
+		if r.URL.Query().Get("format") == "pdf" {
+			issued := output.Data.(receipt.ReceiptDto)
+			w.Header().Set("Content-Type", "application/pdf")
+			w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="recibo-%06d.pdf"`, issued.Number))
+			w.WriteHeader(http.StatusOK)
+			w.Write(issued.PDF)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
@@ -0,0 +1,72 @@
+package controllers_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/receipt"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/receipt/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/controllers"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReceiptController(t *testing.T) {
+	t.Run("Deve baixar o PDF do recibo", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		issued := receipt.New(receipt.Payment{
+			Id:                ulid.Make(),
+			DebtId:            ulid.Make(),
+			InstallmentId:     ulid.Make(),
+			ClientId:          ulid.Make(),
+			InstallmentNumber: 1,
+			Installments:      1,
+			Amount:            money.FromCents(10000),
+			Method:            "cash",
+			PaidAt:            time.Now(),
+		}, receipt.Party{Name: "José"}, receipt.Party{Name: "João"}, time.Now())
+		issued.Number = 42
+
+		receipts := mocks.NewMockRepository(ctrl)
+		receipts.EXPECT().FindById(gomock.Any(), issued.Id).Return(issued, nil)
+		service := receipt.NewReceiptService(receipts, mocks.NewMockAccountReader(ctrl), mocks.NewMockClientFinder(ctrl))
+		controller := controllers.NewReceiptController(service)
+
+		r := chi.NewRouter()
+		r.Get("/v1/receipt/{receiptId}", controller.Receipt())
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/receipt/"+issued.Id.String()+"?format=pdf", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), "recibo-000042.pdf")
+		assert.True(t, bytes.HasPrefix(w.Body.Bytes(), []byte("%PDF-")))
+	})
+
+	t.Run("Deve retornar 400 quando o id do recibo for inválido", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service := receipt.NewReceiptService(mocks.NewMockRepository(ctrl), mocks.NewMockAccountReader(ctrl), mocks.NewMockClientFinder(ctrl))
+		controller := controllers.NewReceiptController(service)
+
+		r := chi.NewRouter()
+		r.Get("/v1/receipt/{receiptId}", controller.Receipt())
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/receipt/invalid", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/reconciliation"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/customvalidate"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/pix"
+	"github.com/oklog/ulid/v2"
+)
+
+// maxWebhookBody bounds the body of the webhook notifications read before
+// their signature is checked.
+const maxWebhookBody = 1 << 20
+
+type ReconciliationController struct {
+	ReconciliationService reconciliation.Service
+	WebhookSecret         []byte
+}
+
+func NewReconciliationController(reconciliationService reconciliation.Service, webhookSecret []byte) *ReconciliationController {
+	return &ReconciliationController{
+		ReconciliationService: reconciliationService,
+		WebhookSecret:         webhookSecret,
+	}
+}
+
+// PixWebhook receives the Pix payments the PSP notifies to the account, once
+// the signature of the notification is checked.
+func (c *ReconciliationController) PixWebhook() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accountId, err := ulid.Parse(chi.URLParam(r, "accountId"))
+		if err != nil {
+			response(w, http.StatusBadRequest, "Invalid account ID")
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+		if err != nil {
+			response(w, http.StatusBadRequest, "Invalid request")
+			return
+		}
+
+		if !pix.VerifySignature(c.WebhookSecret, body, r.Header.Get(pix.SignatureHeader)) {
+			response(w, http.StatusUnauthorized, "Invalid signature")
+			return
+		}
+
+		var webhook pix.Webhook
+		if err := json.Unmarshal(body, &webhook); err != nil {
+			log.Println("Error decoding pix webhook:", err)
+			response(w, http.StatusBadRequest, "Invalid request")
+			return
+		}
+
+		output := c.ReconciliationService.ReceivePix(r.Context(), accountId, webhook.Pix)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+func (c *ReconciliationController) Queue() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pgRequest, err := paginate.GetPaginateParams(r)
+		if err != nil {
+			log.Println("Error getting pagination params:", err)
+			response(w, http.StatusBadRequest, "Invalid pagination params")
+			return
+		}
+
+		output := c.ReconciliationService.Queue(r.Context(), pgRequest)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+func (c *ReconciliationController) Resolve() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paymentId, err := ulid.Parse(chi.URLParam(r, "paymentId"))
+		if err != nil {
+			response(w, http.StatusBadRequest, "Invalid payment ID")
+			return
+		}
+
+		var resolveRequest reconciliation.ResolveDto
+		if err := json.NewDecoder(r.Body).Decode(&resolveRequest); err != nil {
+			response(w, http.StatusBadRequest, "Invalid request")
+			return
+		}
+
+		v := customvalidate.Validate(resolveRequest)
+		if len(v.Errors) > 0 {
+			response(w, http.StatusUnprocessableEntity, v)
+			return
+		}
+
+		output := c.ReconciliationService.Resolve(r.Context(), paymentId, &resolveRequest)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
@@ -0,0 +1,104 @@
+package controllers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	debtMocks "github.com/henriquerocha2004/quem-me-deve-api/core/debt/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/reconciliation"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/reconciliation/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/controllers"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/pix"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReconciliationController(t *testing.T) {
+	secret := []byte("webhook-secret")
+	account, _ := user.NewUser("Maria", "maria@email.com", "12345678")
+
+	t.Run("Deve pagar uma vez a parcela do pix entregue pelo PSP", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dueDate := time.Now().AddDate(0, 1, 0)
+		d := &debt.Debt{
+			Id:                   ulid.Make(),
+			UserClientId:         ulid.Make(),
+			Status:               debt.Pending,
+			DueDate:              &dueDate,
+			TotalValue:           money.FromCents(50000),
+			InstallmentsQuantity: 1,
+		}
+		d.GenerateInstallments()
+		installment := d.Intallments[0]
+
+		debtRepo := debtMocks.NewMockRepository(ctrl)
+		debtRepo.EXPECT().InstallmentDebt(gomock.Any(), installment.Id).Return(d, nil)
+		debtRepo.EXPECT().GetDebt(gomock.Any(), d.Id).Return(d, nil)
+		debtRepo.EXPECT().Update(gomock.Any(), d).Return(nil)
+		debtService := debt.NewDebtService(debtRepo, debtMocks.NewMockClientReader(ctrl), debtMocks.NewMockCatalogReader(ctrl), debtMocks.NewMockCreditWallet(ctrl))
+
+		accounts := mocks.NewMockAccountReader(ctrl)
+		accounts.EXPECT().FindById(gomock.Any(), account.Id).Return(account, nil).Times(2)
+
+		received := map[string]bool{}
+		payments := mocks.NewMockRepository(ctrl)
+		payments.EXPECT().Register(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, payment *reconciliation.PixPayment) (bool, error) {
+			registered := !received[payment.EndToEndId]
+			received[payment.EndToEndId] = true
+			return registered, nil
+		}).Times(2)
+		payments.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, payment *reconciliation.PixPayment) error {
+			assert.Equal(t, reconciliation.Applied, payment.Status)
+			return nil
+		})
+
+		service := reconciliation.NewReconciliationService(payments, accounts, debtRepo, debtService)
+		controller := controllers.NewReconciliationController(service, secret)
+
+		r := chi.NewRouter()
+		r.Post("/v1/pix/webhook/{accountId}", controller.PixWebhook())
+		server := httptest.NewServer(r)
+		defer server.Close()
+
+		psp := pix.NewFakePSP(server.URL+"/v1/pix/webhook/"+account.Id.String(), secret)
+		payment, err := psp.Pay(context.Background(), debt.PixTxId(installment.Id), installment.Value)
+		assert.NoError(t, err)
+		assert.NoError(t, psp.Deliver(context.Background(), payment))
+
+		assert.Equal(t, debt.Paid, d.Intallments[0].Status)
+		assert.Len(t, d.Intallments[0].Payments, 1)
+		assert.Equal(t, debt.PixPaymentMethod, d.Intallments[0].Payments[0].Method)
+	})
+
+	t.Run("Deve recusar o webhook com assinatura invalida", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		payments := mocks.NewMockRepository(ctrl)
+		payments.EXPECT().Register(gomock.Any(), gomock.Any()).Times(0)
+		service := reconciliation.NewReconciliationService(payments, mocks.NewMockAccountReader(ctrl), mocks.NewMockDebtReader(ctrl), mocks.NewMockInstallmentPayer(ctrl))
+		controller := controllers.NewReconciliationController(service, secret)
+
+		r := chi.NewRouter()
+		r.Post("/v1/pix/webhook/{accountId}", controller.PixWebhook())
+
+		body := `{"pix":[{"endToEndId":"E1","txid":"TX1","valor":"10.00","horario":"2026-01-01T10:00:00Z"}]}`
+		req := httptest.NewRequest(http.MethodPost, "/v1/pix/webhook/"+account.Id.String(), strings.NewReader(body))
+		req.Header.Set(pix.SignatureHeader, pix.Sign([]byte("other-secret"), []byte(body)))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
@@ -0,0 +1,106 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/reminder"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/customvalidate"
+	"github.com/oklog/ulid/v2"
+)
+
+type ReminderController struct {
+	ReminderService reminder.Service
+}
+
+func NewReminderController(reminderService reminder.Service) *ReminderController {
+	return &ReminderController{
+		ReminderService: reminderService,
+	}
+}
+
+func (c *ReminderController) Rules() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		output := c.ReminderService.Rules(r.Context())
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+func (c *ReminderController) CreateRule() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ruleRequest reminder.RuleDto
+
+		if err := json.NewDecoder(r.Body).Decode(&ruleRequest); err != nil {
+			response(w, http.StatusBadRequest, "Invalid request")
+			return
+		}
+
+		v := customvalidate.Validate(ruleRequest)
+		if len(v.Errors) > 0 {
+			response(w, http.StatusUnprocessableEntity, v)
+			return
+		}
+
+		output := c.ReminderService.CreateRule(r.Context(), &ruleRequest)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusCreated, output)
+	})
+}
+
+func (c *ReminderController) DeleteRule() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ruleId := chi.URLParam(r, "ruleId")
+		if ruleId == "" {
+			response(w, http.StatusBadRequest, "Missing rule ID")
+			return
+		}
+
+		ruleIdParsed, err := ulid.Parse(ruleId)
+		if err != nil {
+			response(w, http.StatusBadRequest, "Invalid rule ID")
+			return
+		}
+
+		output := c.ReminderService.DeleteRule(r.Context(), ruleIdParsed)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusNoContent, nil)
+	})
+}
+
+func (c *ReminderController) Sends() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		installmentId := chi.URLParam(r, "installmentId")
+		if installmentId == "" {
+			response(w, http.StatusBadRequest, "Missing installment ID")
+			return
+		}
+
+		installmentIdParsed, err := ulid.Parse(installmentId)
+		if err != nil {
+			response(w, http.StatusBadRequest, "Invalid installment ID")
+			return
+		}
+
+		output := c.ReminderService.Sends(r.Context(), installmentIdParsed)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
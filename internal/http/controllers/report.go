@@ -0,0 +1,111 @@
+package controllers
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/report"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/customvalidate"
+)
+
+type ReportController struct {
+	ReportService report.Service
+}
+
+func NewReportController(reportService report.Service) *ReportController {
+	return &ReportController{
+		ReportService: reportService,
+	}
+}
+
+// Aging answers the accounts receivable aging of the installments due
+// between ?from and ?to, optionally only the ones of ?status.
+func (c *ReportController) Aging() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := report.AgingRequestDto{
+			From:   r.URL.Query().Get("from"),
+			To:     r.URL.Query().Get("to"),
+			Status: r.URL.Query().Get("status"),
+		}
+
+		v := customvalidate.Validate(request)
+		if len(v.Errors) > 0 {
+			response(w, http.StatusUnprocessableEntity, v)
+			return
+		}
+
+		output := c.ReportService.Aging(r.Context(), &request)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output.Message)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+// Forecast answers the cash flow grouped by ?group_by, reaching ?months back
+// and ahead of today, discounted by the late payments of the clients when
+// ?risk_adjusted=true.
+func (c *ReportController) Forecast() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := report.ForecastRequestDto{
+			GroupBy:      r.URL.Query().Get("group_by"),
+			RiskAdjusted: r.URL.Query().Get("risk_adjusted") == "true",
+		}
+
+		if r.URL.Query().Get("months") != "" {
+			months, err := strconv.Atoi(r.URL.Query().Get("months"))
+			if err != nil {
+				log.Println("Error converting months to int:", err)
+				response(w, http.StatusBadRequest, "Invalid months")
+				return
+			}
+			request.Months = months
+		}
+
+		v := customvalidate.Validate(request)
+		if len(v.Errors) > 0 {
+			response(w, http.StatusUnprocessableEntity, v)
+			return
+		}
+
+		output := c.ReportService.Forecast(r.Context(), &request)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output.Message)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+// Sales answers the sales between ?from and ?to grouped by ?group_by,
+// optionally only the ones of ?client_id, ?status and ?payment_method.
+func (c *ReportController) Sales() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := report.SalesRequestDto{
+			From:          r.URL.Query().Get("from"),
+			To:            r.URL.Query().Get("to"),
+			ClientId:      r.URL.Query().Get("client_id"),
+			Status:        r.URL.Query().Get("status"),
+			PaymentMethod: r.URL.Query().Get("payment_method"),
+			GroupBy:       r.URL.Query().Get("group_by"),
+		}
+
+		v := customvalidate.Validate(request)
+		if len(v.Errors) > 0 {
+			response(w, http.StatusUnprocessableEntity, v)
+			return
+		}
+
+		output := c.ReportService.Sales(r.Context(), &request)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output.Message)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
@@ -0,0 +1,150 @@
+package controllers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/report"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/report/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/controllers"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReportController(t *testing.T) {
+	t.Run("Deve retornar o aging dos clientes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reports := mocks.NewMockRepository(ctrl)
+		reports.EXPECT().AgingByClient(gomock.Any(), gomock.Any()).Return([]report.ClientAging{
+			{
+				ClientId:     ulid.Make(),
+				ClientName:   "João Silva",
+				AgingBuckets: report.AgingBuckets{Days1To30: money.FromCents(5000), Installments: 1},
+			},
+		}, nil)
+		controller := controllers.NewReportController(report.NewReportService(reports))
+
+		r := chi.NewRouter()
+		r.Get("/v1/reports/aging", controller.Aging())
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/reports/aging?from=2026-01-01&status=overdue", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"days_1_30":"50.00"`)
+		assert.Contains(t, w.Body.String(), "João Silva")
+	})
+
+	t.Run("Deve retornar 422 quando o status não for de parcela em aberto", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		controller := controllers.NewReportController(report.NewReportService(mocks.NewMockRepository(ctrl)))
+
+		r := chi.NewRouter()
+		r.Get("/v1/reports/aging", controller.Aging())
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/reports/aging?status=paid", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("Deve retornar a previsão de recebimentos ajustada pelo risco", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reports := mocks.NewMockRepository(ctrl)
+		reports.EXPECT().RealizedInflows(gomock.Any(), gomock.Any()).Return(nil, nil)
+		reports.EXPECT().ExpectedInflows(gomock.Any(), gomock.Any()).Return(nil, nil)
+		reports.EXPECT().PaymentHistories(gomock.Any(), gomock.Any()).Return(nil, nil)
+		controller := controllers.NewReportController(report.NewReportService(reports))
+
+		r := chi.NewRouter()
+		r.Get("/v1/reports/forecast", controller.Forecast())
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/reports/forecast?group_by=week&months=2&risk_adjusted=true", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"group_by":"week"`)
+		assert.Contains(t, w.Body.String(), `"risk_adjusted":"0.00"`)
+	})
+
+	t.Run("Deve recusar parâmetros inválidos da previsão", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		controller := controllers.NewReportController(report.NewReportService(mocks.NewMockRepository(ctrl)))
+
+		r := chi.NewRouter()
+		r.Get("/v1/reports/forecast", controller.Forecast())
+
+		for query, code := range map[string]int{
+			"months=36":     http.StatusUnprocessableEntity,
+			"group_by=year": http.StatusUnprocessableEntity,
+			"months=quatro": http.StatusBadRequest,
+		} {
+			req := httptest.NewRequest(http.MethodGet, "/v1/reports/forecast?"+query, nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, code, w.Code, query)
+		}
+	})
+
+	t.Run("Deve retornar o relatório de vendas filtrado", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientId := ulid.Make()
+		reports := mocks.NewMockRepository(ctrl)
+		reports.EXPECT().SalesByPeriod(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filter report.SalesFilter) ([]report.SalesPeriod, error) {
+			assert.Equal(t, clientId, *filter.ClientId)
+			assert.Equal(t, "cash", filter.PaymentMethod)
+			return []report.SalesPeriod{
+				{Start: time.Date(2026, 5, 1, 0, 0, 0, 0, time.Local), SalesTotals: report.SalesTotals{Sales: 4, Sold: money.FromCents(20000)}},
+			}, nil
+		})
+		reports.EXPECT().TopClients(gomock.Any(), gomock.Any()).Return(nil, nil)
+		reports.EXPECT().TopProducts(gomock.Any(), gomock.Any()).Return(nil, nil)
+		controller := controllers.NewReportController(report.NewReportService(reports))
+
+		r := chi.NewRouter()
+		r.Get("/v1/reports/sales", controller.Sales())
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/reports/sales?client_id="+clientId.String()+"&payment_method=cash&status=paid", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"average_ticket":"50.00"`)
+	})
+
+	t.Run("Deve retornar 422 quando o cliente do relatório de vendas for inválido", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		controller := controllers.NewReportController(report.NewReportService(mocks.NewMockRepository(ctrl)))
+
+		r := chi.NewRouter()
+		r.Get("/v1/reports/sales", controller.Sales())
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/reports/sales?client_id=123", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+}
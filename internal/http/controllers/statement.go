@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/statement"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/customvalidate"
+	"github.com/oklog/ulid/v2"
+)
+
+type StatementController struct {
+	StatementService statement.Service
+}
+
+func NewStatementController(statementService statement.Service) *StatementController {
+	return &StatementController{
+		StatementService: statementService,
+	}
+}
+
+var statementContentTypes = map[string]string{
+	"pdf": "application/pdf",
+	"csv": "text/csv; charset=utf-8",
+}
+
+// Statement answers the statement of the client for ?from and ?to, or
+// downloads it when ?format=pdf or ?format=csv.
+func (c *StatementController) Statement() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientId, err := ulid.Parse(chi.URLParam(r, "clientId"))
+		if err != nil {
+			response(w, http.StatusBadRequest, "clientId invalid")
+			return
+		}
+
+		request := statement.StatementRequestDto{
+			From:   r.URL.Query().Get("from"),
+			To:     r.URL.Query().Get("to"),
+			Format: r.URL.Query().Get("format"),
+		}
+
+		v := customvalidate.Validate(request)
+		if len(v.Errors) > 0 {
+			response(w, http.StatusUnprocessableEntity, v)
+			return
+		}
+
+		output := c.StatementService.Statement(r.Context(), clientId, &request)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output.Message)
+			return
+		}
+
+		if contentType, ok := statementContentTypes[request.Format]; ok {
+			statementDto := output.Data.(statement.StatementDto)
+			w.Header().Set("Content-Type", contentType)
+			w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="extrato-%s-%s.%s"`,
+				clientId, time.Now().Format(time.DateOnly), request.Format))
+			w.WriteHeader(http.StatusOK)
+			w.Write(statementDto.File)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
@@ -0,0 +1,58 @@
+package controllers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/client"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/debt"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/statement"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/statement/mocks"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/controllers"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestStatementController(t *testing.T) {
+	t.Run("Deve baixar o extrato do cliente em CSV", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientId := ulid.Make()
+		clients := mocks.NewMockClientFinder(ctrl)
+		clients.EXPECT().FindById(gomock.Any(), clientId).Return(&client.Client{Id: clientId, Name: "João"}, nil)
+		debts := mocks.NewMockDebtReader(ctrl)
+		debts.EXPECT().ClientUserDebts(gomock.Any(), clientId).Return([]*debt.Debt{}, nil)
+		controller := controllers.NewStatementController(statement.NewStatementService(debts, clients))
+
+		r := chi.NewRouter()
+		r.Get("/v1/client/{clientId}/statement", controller.Statement())
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/client/"+clientId.String()+"/statement?from=2026-01-01&to=2026-01-31&format=csv", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Body.String(), "Saldo final")
+	})
+
+	t.Run("Deve retornar 422 quando o período for inválido", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		controller := controllers.NewStatementController(statement.NewStatementService(mocks.NewMockDebtReader(ctrl), mocks.NewMockClientFinder(ctrl)))
+
+		r := chi.NewRouter()
+		r.Get("/v1/client/{clientId}/statement", controller.Statement())
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/client/"+ulid.Make().String()+"/statement?from=01/01/2026", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+}
@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/catalog"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/customvalidate"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/paginate"
+	"github.com/oklog/ulid/v2"
+)
+
+type StockController struct {
+	StockService catalog.StockService
+}
+
+func NewStockController(stockService catalog.StockService) *StockController {
+	return &StockController{
+		StockService: stockService,
+	}
+}
+
+func (c *StockController) RegisterMovement() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		productId, ok := c.productId(w, r)
+		if !ok {
+			return
+		}
+
+		var movementRequest catalog.StockMovementDto
+		if err := json.NewDecoder(r.Body).Decode(&movementRequest); err != nil {
+			response(w, http.StatusBadRequest, "Invalid request")
+			return
+		}
+
+		v := customvalidate.Validate(movementRequest)
+		if len(v.Errors) > 0 {
+			response(w, http.StatusUnprocessableEntity, v)
+			return
+		}
+
+		output := c.StockService.RegisterMovement(r.Context(), productId, &movementRequest)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusCreated, output)
+	})
+}
+
+func (c *StockController) StockLevel() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		productId, ok := c.productId(w, r)
+		if !ok {
+			return
+		}
+
+		output := c.StockService.StockLevel(r.Context(), productId)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+func (c *StockController) Movements() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		productId, ok := c.productId(w, r)
+		if !ok {
+			return
+		}
+
+		pgRequest, err := paginate.GetPaginateParams(r)
+		if err != nil {
+			log.Println("Error getting pagination params:", err)
+			response(w, http.StatusBadRequest, "Invalid pagination params")
+			return
+		}
+
+		output := c.StockService.Movements(r.Context(), productId, pgRequest)
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+func (c *StockController) LowStock() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		output := c.StockService.LowStock(r.Context())
+		if output.Status == "error" {
+			response(w, http.StatusInternalServerError, output)
+			return
+		}
+
+		response(w, http.StatusOK, output)
+	})
+}
+
+func (c *StockController) productId(w http.ResponseWriter, r *http.Request) (ulid.ULID, bool) {
+	productId, err := ulid.Parse(chi.URLParam(r, "itemId"))
+	if err != nil {
+		response(w, http.StatusBadRequest, "Invalid product ID")
+		return ulid.ULID{}, false
+	}
+
+	return productId, true
+}
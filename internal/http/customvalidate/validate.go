@@ -1,9 +1,11 @@
 package customvalidate
 
 import (
+	"reflect"
 	"time"
 
 	"github.com/go-playground/validator"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
 	"github.com/henriquerocha2004/quem-me-deve-api/pkg/validateErrors"
 	"github.com/oklog/ulid"
 )
@@ -19,6 +21,7 @@ func init() {
 	_ = v.RegisterValidation("dateFormat:YYYY-MM-DD", dateValidate)
 	_ = v.RegisterValidation("dateTimeFormat:YYYY-MM-DDTHH:MM:SS", dateTimeValidate)
 	_ = v.RegisterValidation("ulid", ulidValidate)
+	v.RegisterCustomTypeFunc(moneyCents, money.Money{})
 }
 
 func Validate(data any) *ValidationResponse {
@@ -46,7 +49,7 @@ func Validate(data any) *ValidationResponse {
 
 func getErrorMessage(e validator.FieldError) string {
 	switch e.Tag() {
-	case "required":
+	case "required", "required_without", "required_with":
 		return "This field is required"
 	case "email":
 		return "Invalid email format"
@@ -78,3 +81,9 @@ func ulidValidate(field validator.FieldLevel) bool {
 	_, err := ulid.Parse(ulidStr)
 	return err == nil
 }
+
+// moneyCents lets numeric tags such as gt=0 validate money fields by their
+// amount in cents.
+func moneyCents(field reflect.Value) interface{} {
+	return field.Interface().(money.Money).Cents()
+}
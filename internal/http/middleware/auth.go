@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/oklog/ulid/v2"
+)
+
+type Authenticator interface {
+	Authenticate(token string) (ulid.ULID, error)
+}
+
+// Authenticate rejects requests without a valid Bearer access token and puts
+// the authenticated user and its tenant in the request context.
+func Authenticate(authenticator Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !found || token == "" {
+				unauthorized(w)
+				return
+			}
+
+			userId, err := authenticator.Authenticate(token)
+			if err != nil {
+				unauthorized(w)
+				return
+			}
+
+			// Every professional is an account of its own, so the user is
+			// also the tenant that owns the data of the request.
+			ctx := user.WithUserId(r.Context(), userId)
+			ctx = shared.WithTenant(ctx, userId)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func unauthorized(w http.ResponseWriter) {
+	writeJSON(w, http.StatusUnauthorized, "Unauthorized")
+}
@@ -0,0 +1,58 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/user"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/middleware"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthenticate(t *testing.T) {
+	tokens, err := user.NewTokenIssuer([]byte("a-secret-long-enough-for-hs256!!"), 15*time.Minute, time.Hour)
+	assert.NoError(t, err)
+	userId := ulid.Make()
+	issued, err := tokens.Issue(userId)
+	assert.NoError(t, err)
+
+	var authenticated, tenant ulid.ULID
+	handler := middleware.Authenticate(tokens)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authenticated, _ = user.IdFromContext(r.Context())
+		tenant, _ = shared.TenantFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	t.Run("should put the user and its tenant in the context", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/debt", nil)
+		req.Header.Set("Authorization", "Bearer "+issued.AccessToken)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, userId, authenticated)
+		assert.Equal(t, userId, tenant)
+	})
+
+	tests := map[string]string{
+		"without token":      "",
+		"with refresh token": "Bearer " + issued.RefreshToken,
+		"with invalid token": "Bearer invalid",
+		"without bearer":     issued.AccessToken,
+	}
+
+	for name, header := range tests {
+		t.Run("should reject a request "+name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/debt", nil)
+			req.Header.Set("Authorization", header)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+		})
+	}
+}
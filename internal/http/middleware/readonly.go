@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/plan"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+)
+
+// ReadOnly refuses with 402 the writes of an account that uses more than its
+// plan allows. Its data is kept as is: it can still read everything and
+// delete what it no longer needs to get back within the limits.
+func ReadOnly(checker plan.ReadOnlyChecker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodDelete:
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			readOnly, err := checker.ReadOnly(r.Context())
+			if err != nil {
+				log.Println("Error checking plan usage:", err)
+				writeJSON(w, http.StatusInternalServerError, shared.ServiceResponse{
+					Status:  "error",
+					Message: "error in check plan usage",
+				})
+				return
+			}
+
+			if readOnly {
+				writeJSON(w, http.StatusPaymentRequired, shared.ServiceResponse{
+					Status:  "error",
+					Message: "account is over the plan limits and is read only",
+					Code:    plan.ReadOnlyCode,
+				})
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(data)
+}
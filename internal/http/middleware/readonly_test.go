@@ -0,0 +1,67 @@
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/core/plan"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/shared"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+type readOnlyChecker struct {
+	readOnly bool
+	err      error
+}
+
+func (c readOnlyChecker) ReadOnly(ctx context.Context) (bool, error) {
+	return c.readOnly, c.err
+}
+
+func TestReadOnly(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Run("should refuse writes of an account over the plan limits", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/client", nil)
+		w := httptest.NewRecorder()
+		middleware.ReadOnly(readOnlyChecker{readOnly: true})(next).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusPaymentRequired, w.Code)
+		var output shared.ServiceResponse
+		assert.NoError(t, json.NewDecoder(w.Body).Decode(&output))
+		assert.Equal(t, plan.ReadOnlyCode, output.Code)
+	})
+
+	t.Run("should let an account over the plan limits read and delete", func(t *testing.T) {
+		for _, method := range []string{http.MethodGet, http.MethodDelete} {
+			req := httptest.NewRequest(method, "/v1/client", nil)
+			w := httptest.NewRecorder()
+			middleware.ReadOnly(readOnlyChecker{readOnly: true})(next).ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code, method)
+		}
+	})
+
+	t.Run("should let writes of an account within the plan limits", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/v1/client", nil)
+		w := httptest.NewRecorder()
+		middleware.ReadOnly(readOnlyChecker{})(next).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should answer 500 when the usage can not be checked", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/client", nil)
+		w := httptest.NewRecorder()
+		middleware.ReadOnly(readOnlyChecker{err: errors.New("db down")})(next).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/container"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/controllers"
+)
+
+func AccountRoutes(d *container.Dependencies) http.Handler {
+	r := chi.NewRouter()
+	accountController := controllers.NewAccountController(d.UserService)
+
+	r.Get("/pix", accountController.PixAccount())
+	r.Put("/pix", accountController.SetPixAccount())
+	r.Get("/boleto", accountController.BoletoAccount())
+	r.Put("/boleto", accountController.SetBoletoAccount())
+
+	return r
+}
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/container"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/controllers"
+)
+
+func AuthRoutes(d *container.Dependencies) http.Handler {
+	r := chi.NewRouter()
+	authController := controllers.NewAuthController(d.UserService)
+
+	r.Post("/register", authController.Register())
+	r.Post("/login", authController.Login())
+	r.Post("/refresh", authController.Refresh())
+
+	return r
+}
@@ -0,0 +1,39 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/core/catalog"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/container"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/controllers"
+)
+
+func ProductRoutes(d *container.Dependencies) http.Handler {
+	r := chi.NewRouter()
+	stockController := controllers.NewStockController(d.StockService)
+
+	r.Get("/low-stock", stockController.LowStock())
+	r.Get("/{itemId}/stock", stockController.StockLevel())
+	r.Get("/{itemId}/stock/movements", stockController.Movements())
+	r.Post("/{itemId}/stock/movements", stockController.RegisterMovement())
+
+	catalogRoutes(r, controllers.NewCatalogController(d.CatalogService, catalog.ProductKind))
+
+	return r
+}
+
+func ServiceRoutes(d *container.Dependencies) http.Handler {
+	r := chi.NewRouter()
+	catalogRoutes(r, controllers.NewCatalogController(d.CatalogService, catalog.ServiceKind))
+
+	return r
+}
+
+func catalogRoutes(r chi.Router, catalogController *controllers.CatalogController) {
+	r.Post("/", catalogController.Create())
+	r.Put("/{itemId}", catalogController.Update())
+	r.Delete("/{itemId}", catalogController.Delete())
+	r.Get("/{itemId}", catalogController.FindOne())
+	r.Get("/", catalogController.FindAll())
+}
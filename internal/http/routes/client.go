@@ -11,11 +11,13 @@ import (
 func ClientRoutes(d *container.Dependencies) http.Handler {
 	r := chi.NewRouter()
 	clientController := controllers.NewClientController(d.ClientService)
+	statementController := controllers.NewStatementController(d.StatementService)
 
 	r.Post("/", clientController.Create())
 	r.Put("/{clientId}", clientController.Update())
 	r.Delete("/{clientId}", clientController.Delete())
 	r.Get("/{clientId}", clientController.FindOne())
+	r.Get("/{clientId}/statement", statementController.Statement())
 	r.Get("/", clientController.FindAll())
 
 	return r
@@ -13,10 +13,15 @@ func DebtRoutes(d *container.Dependencies) http.Handler {
 	debtController := controllers.NewDebtController(d.DebtService)
 	r.Post("/", debtController.CreateDebt())
 	r.Post("/pay", debtController.PayInstallment())
+	r.Post("/settle-credit", debtController.SettleWithCredit())
 	r.Post("/cancel", debtController.CancelDebt())
 	r.Post("/reversal", debtController.ReversalDebt())
+	r.Post("/renegotiate", debtController.RenegotiateDebt())
 	r.Get("/", debtController.GetDebts())
+	r.Get("/overdue", debtController.GetOverdueInstallments())
 	r.Get("/{clientId}", debtController.GetClientUserDebts())
 	r.Get("/{clientId}/{debtId}/installments", debtController.GetDebtInstallments())
+	r.Get("/{clientId}/{debtId}/installments/{installmentId}/pix", debtController.GetInstallmentPix())
+	r.Get("/{clientId}/{debtId}/installments/{installmentId}/boleto", debtController.GetInstallmentBoleto())
 	return r
 }
@@ -3,13 +3,33 @@ package routes
 import (
 	"github.com/go-chi/chi/v5"
 	"github.com/henriquerocha2004/quem-me-deve-api/internal/container"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/middleware"
 )
 
 func Start(d *container.Dependencies) *chi.Mux {
 	r := chi.NewRouter()
 	r.Route("/v1", func(r chi.Router) {
-		r.Mount("/debt", DebtRoutes(d))
-		r.Mount("/client", ClientRoutes(d))
+		r.Mount("/auth", AuthRoutes(d))
+		r.Mount("/pix", PixRoutes(d))
+
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.Authenticate(d.Tokens))
+			r.Mount("/plan", PlanRoutes(d))
+			r.Mount("/notifications", NotificationRoutes(d))
+			r.Mount("/account", AccountRoutes(d))
+
+			r.Group(func(r chi.Router) {
+				r.Use(middleware.ReadOnly(d.PlanAccess))
+				r.Mount("/debt", DebtRoutes(d))
+				r.Mount("/client", ClientRoutes(d))
+				r.Mount("/product", ProductRoutes(d))
+				r.Mount("/service", ServiceRoutes(d))
+				r.Mount("/reminder", ReminderRoutes(d))
+				r.Mount("/reconciliation", ReconciliationRoutes(d))
+				r.Mount("/receipt", ReceiptRoutes(d))
+				r.Mount("/reports", ReportRoutes(d))
+			})
+		})
 	})
 
 	return r
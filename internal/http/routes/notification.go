@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/container"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/controllers"
+)
+
+func NotificationRoutes(d *container.Dependencies) http.Handler {
+	r := chi.NewRouter()
+	notificationController := controllers.NewNotificationController(d.NotificationService)
+
+	r.Get("/", notificationController.Notifications())
+	r.Get("/unread", notificationController.UnreadCount())
+	r.Post("/read", notificationController.MarkAllRead())
+	r.Post("/{notificationId}/read", notificationController.MarkRead())
+
+	return r
+}
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/container"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/controllers"
+)
+
+// PixRoutes are called by the PSP, which is not a user: they are checked by
+// the signature of the notifications instead of a token.
+func PixRoutes(d *container.Dependencies) http.Handler {
+	r := chi.NewRouter()
+	reconciliationController := controllers.NewReconciliationController(d.ReconciliationService, d.PixWebhookSecret)
+
+	r.Post("/webhook/{accountId}", reconciliationController.PixWebhook())
+
+	return r
+}
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/container"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/controllers"
+)
+
+func PlanRoutes(d *container.Dependencies) http.Handler {
+	r := chi.NewRouter()
+	planController := controllers.NewPlanController(d.PlanService)
+
+	r.Get("/", planController.Plans())
+	r.Get("/subscription", planController.Subscription())
+	r.Put("/subscription", planController.Subscribe())
+
+	return r
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/container"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/controllers"
+)
+
+func ReceiptRoutes(d *container.Dependencies) http.Handler {
+	r := chi.NewRouter()
+	receiptController := controllers.NewReceiptController(d.ReceiptService)
+
+	r.Get("/", receiptController.Receipts())
+	r.Get("/{receiptId}", receiptController.Receipt())
+
+	return r
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/container"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/controllers"
+)
+
+func ReconciliationRoutes(d *container.Dependencies) http.Handler {
+	r := chi.NewRouter()
+	reconciliationController := controllers.NewReconciliationController(d.ReconciliationService, d.PixWebhookSecret)
+
+	r.Get("/", reconciliationController.Queue())
+	r.Post("/{paymentId}/resolve", reconciliationController.Resolve())
+
+	return r
+}
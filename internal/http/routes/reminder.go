@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/container"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/controllers"
+)
+
+func ReminderRoutes(d *container.Dependencies) http.Handler {
+	r := chi.NewRouter()
+	reminderController := controllers.NewReminderController(d.ReminderService)
+
+	r.Get("/rules", reminderController.Rules())
+	r.Post("/rules", reminderController.CreateRule())
+	r.Delete("/rules/{ruleId}", reminderController.DeleteRule())
+	r.Get("/installments/{installmentId}/sends", reminderController.Sends())
+
+	return r
+}
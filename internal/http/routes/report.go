@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/container"
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/http/controllers"
+)
+
+func ReportRoutes(d *container.Dependencies) http.Handler {
+	r := chi.NewRouter()
+	reportController := controllers.NewReportController(d.ReportService)
+
+	r.Get("/aging", reportController.Aging())
+	r.Get("/forecast", reportController.Forecast())
+	r.Get("/sales", reportController.Sales())
+
+	return r
+}
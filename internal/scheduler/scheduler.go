@@ -0,0 +1,85 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Job is the work run on every tick, given the time of the tick.
+type Job func(ctx context.Context, now time.Time) error
+
+// Locker keeps a job from running at the same time on more than one API
+// instance. TryLock does not wait: it reports false when the lock is taken.
+type Locker interface {
+	TryLock(ctx context.Context, name string) (unlock func(), acquired bool, err error)
+}
+
+type entry struct {
+	name     string
+	interval time.Duration
+	job      Job
+}
+
+// Scheduler runs background jobs of the API at fixed intervals. A failing run
+// is logged and tried again on the next tick.
+type Scheduler struct {
+	entries []entry
+	locker  Locker
+}
+
+func New() *Scheduler {
+	return &Scheduler{}
+}
+
+// WithLocker makes every run of a job take the lock named after it first. A
+// run that finds the lock taken is skipped.
+func (s *Scheduler) WithLocker(locker Locker) *Scheduler {
+	s.locker = locker
+	return s
+}
+
+func (s *Scheduler) Every(interval time.Duration, name string, job Job) *Scheduler {
+	s.entries = append(s.entries, entry{name: name, interval: interval, job: job})
+	return s
+}
+
+// Start runs every job in a goroutine of its own until the context is done.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, e := range s.entries {
+		go s.loop(ctx, e)
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, e entry) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.run(ctx, e, now)
+		}
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, e entry, now time.Time) {
+	if s.locker != nil {
+		unlock, acquired, err := s.locker.TryLock(ctx, e.name)
+		if err != nil {
+			log.Println("Error locking job", e.name, ":", err)
+			return
+		}
+
+		if !acquired {
+			return
+		}
+		defer unlock()
+	}
+
+	if err := e.job(ctx, now); err != nil {
+		log.Println("Error running job", e.name, ":", err)
+	}
+}
@@ -0,0 +1,96 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/internal/scheduler"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler(t *testing.T) {
+	t.Run("should run the job on every tick, even after a failure", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		runs := make(chan time.Time, 2)
+		scheduler.New().Every(time.Millisecond, "failing", func(_ context.Context, now time.Time) error {
+			select {
+			case runs <- now:
+			default:
+			}
+			return errors.New("failed")
+		}).Start(ctx)
+
+		first := <-runs
+		second := <-runs
+		assert.True(t, second.After(first))
+	})
+
+	t.Run("should skip the run when another instance holds the lock", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		locker := &fakeLocker{held: map[string]bool{"held": true}}
+		runs := make(chan string, 10)
+		job := func(name string) scheduler.Job {
+			return func(context.Context, time.Time) error {
+				select {
+				case runs <- name:
+				default:
+				}
+				return nil
+			}
+		}
+
+		scheduler.New().WithLocker(locker).
+			Every(time.Millisecond, "held", job("held")).
+			Every(time.Millisecond, "free", job("free")).
+			Start(ctx)
+
+		for range 3 {
+			assert.Equal(t, "free", <-runs)
+		}
+	})
+
+	t.Run("should stop when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		ran := make(chan struct{}, 1)
+		scheduler.New().Every(time.Millisecond, "stopped", func(context.Context, time.Time) error {
+			ran <- struct{}{}
+			return nil
+		}).Start(ctx)
+
+		select {
+		case <-ran:
+			t.Error("job ran after the context was done")
+		case <-time.After(20 * time.Millisecond):
+		}
+	})
+}
+
+type fakeLocker struct {
+	mu   sync.Mutex
+	held map[string]bool
+}
+
+func (f *fakeLocker) TryLock(ctx context.Context, name string) (func(), bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.held[name] {
+		return nil, false, nil
+	}
+
+	f.held[name] = true
+	return func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		delete(f.held, name)
+	}, true, nil
+}
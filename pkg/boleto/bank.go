@@ -0,0 +1,204 @@
+package boleto
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrUnsupportedBank = errors.New("boleto: the bank is not supported")
+	ErrOurNumber       = errors.New("boleto: the nosso número does not fit the layout of the bank")
+)
+
+// Bank lays out the free field of the barcode, the 25 digits each bank fills
+// its own way, and the codes of the beneficiary printed on the slip.
+type Bank interface {
+	// Code is the 3 digit code of the bank on the clearing.
+	Code() string
+	Name() string
+	// FreeField writes the free field of the boleto with the nosso número,
+	// the number the beneficiary gives to each boleto.
+	FreeField(ourNumber int64) (string, error)
+	// OurNumber writes the nosso número as printed, with its check digit
+	// when the bank has one.
+	OurNumber(ourNumber int64) string
+	// BeneficiaryCode writes the agency and the account of the beneficiary.
+	BeneficiaryCode() string
+	Wallet() string
+}
+
+// Account is the account of the beneficiary at the bank. Agency and Number
+// may carry their check digits after a dash, as in 1234-5.
+type Account struct {
+	Bank      string
+	Agency    string
+	Number    string
+	Wallet    string
+	Agreement string
+}
+
+const (
+	BancoDoBrasilCode = "001"
+	BradescoCode      = "237"
+)
+
+// NewBank returns the layout of the bank of the account, checking the
+// account against it.
+func NewBank(account Account) (Bank, error) {
+	switch account.Bank {
+	case BancoDoBrasilCode:
+		return newBancoDoBrasil(account)
+	case BradescoCode:
+		return newBradesco(account)
+	default:
+		return nil, ErrUnsupportedBank
+	}
+}
+
+// BancoDoBrasil is the layout of the agreements (convênios) of 7 digits: the
+// nosso número is the agreement followed by 10 digits.
+type BancoDoBrasil struct {
+	account Account
+}
+
+func newBancoDoBrasil(account Account) (*BancoDoBrasil, error) {
+	if len(account.Agreement) != 7 || !isDigits(account.Agreement) {
+		return nil, errors.New("boleto: the agreement of Banco do Brasil must have 7 digits")
+	}
+
+	if len(account.Wallet) != 2 || !isDigits(account.Wallet) {
+		return nil, errors.New("boleto: the wallet must have 2 digits")
+	}
+
+	return &BancoDoBrasil{account: account}, nil
+}
+
+func (b *BancoDoBrasil) Code() string {
+	return BancoDoBrasilCode
+}
+
+func (b *BancoDoBrasil) Name() string {
+	return "Banco do Brasil"
+}
+
+func (b *BancoDoBrasil) FreeField(ourNumber int64) (string, error) {
+	number, err := padNumber(ourNumber, 10)
+	if err != nil {
+		return "", err
+	}
+
+	return "000000" + b.account.Agreement + number + b.account.Wallet, nil
+}
+
+func (b *BancoDoBrasil) OurNumber(ourNumber int64) string {
+	return fmt.Sprintf("%s%010d", b.account.Agreement, ourNumber)
+}
+
+func (b *BancoDoBrasil) BeneficiaryCode() string {
+	return b.account.Agency + " / " + b.account.Number
+}
+
+func (b *BancoDoBrasil) Wallet() string {
+	return b.account.Wallet
+}
+
+// Bradesco lays out the agency, the wallet, a nosso número of 11 digits and
+// the account, all of them without their check digits.
+type Bradesco struct {
+	account Account
+	agency  string
+	number  string
+}
+
+func newBradesco(account Account) (*Bradesco, error) {
+	agency := withoutCheckDigit(account.Agency)
+	if len(agency) != 4 || !isDigits(agency) {
+		return nil, errors.New("boleto: the agency of Bradesco must have 4 digits")
+	}
+
+	number := withoutCheckDigit(account.Number)
+	if number == "" || len(number) > 7 || !isDigits(number) {
+		return nil, errors.New("boleto: the account of Bradesco must have up to 7 digits")
+	}
+
+	if len(account.Wallet) != 2 || !isDigits(account.Wallet) {
+		return nil, errors.New("boleto: the wallet must have 2 digits")
+	}
+
+	return &Bradesco{
+		account: account,
+		agency:  agency,
+		number:  strings.Repeat("0", 7-len(number)) + number,
+	}, nil
+}
+
+func (b *Bradesco) Code() string {
+	return BradescoCode
+}
+
+func (b *Bradesco) Name() string {
+	return "Bradesco"
+}
+
+func (b *Bradesco) FreeField(ourNumber int64) (string, error) {
+	number, err := padNumber(ourNumber, 11)
+	if err != nil {
+		return "", err
+	}
+
+	return b.agency + b.account.Wallet + number + b.number + "0", nil
+}
+
+// OurNumber writes the wallet, the nosso número and its modulo 11 check
+// digit, P when the remainder is 1.
+func (b *Bradesco) OurNumber(ourNumber int64) string {
+	number := fmt.Sprintf("%011d", ourNumber)
+
+	digit := "0"
+	switch remainder := Mod11(b.account.Wallet+number, 7); remainder {
+	case 0:
+	case 1:
+		digit = "P"
+	default:
+		digit = fmt.Sprint(11 - remainder)
+	}
+
+	return b.account.Wallet + "/" + number + "-" + digit
+}
+
+func (b *Bradesco) BeneficiaryCode() string {
+	return b.account.Agency + " / " + b.account.Number
+}
+
+func (b *Bradesco) Wallet() string {
+	return b.account.Wallet
+}
+
+// bankCodeDigit is the check digit printed after the code of the bank, as in
+// 001-9.
+func bankCodeDigit(code string) string {
+	digit := 11 - Mod11(code, 9)
+	switch digit {
+	case 10:
+		return "X"
+	case 11:
+		return "0"
+	}
+
+	return fmt.Sprint(digit)
+}
+
+func padNumber(number int64, size int) (string, error) {
+	padded := fmt.Sprintf("%0*d", size, number)
+	if number < 0 || len(padded) > size {
+		return "", ErrOurNumber
+	}
+
+	return padded, nil
+}
+
+func withoutCheckDigit(code string) string {
+	code, _, _ = strings.Cut(code, "-")
+	return strings.TrimSpace(code)
+}
@@ -0,0 +1,154 @@
+package boleto
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+)
+
+const (
+	// BarcodeSize is how many digits the barcode has.
+	BarcodeSize = 44
+	// DigitableLineSize is how many digits the digitable line (linha
+	// digitável) has.
+	DigitableLineSize = 47
+	// FreeFieldSize is how many digits of the barcode each bank lays out.
+	FreeFieldSize = 25
+
+	currencyReal = "9"
+	// maxAmountCents is the largest amount the 10 digits of the barcode hold.
+	maxAmountCents = 9_999_999_999
+)
+
+var (
+	ErrAmount        = errors.New("boleto: the amount must be from 0 to 99999999.99")
+	ErrBankCode      = errors.New("boleto: the bank code must have 3 digits")
+	ErrFreeField     = errors.New("boleto: the free field must have 25 digits")
+	ErrBarcode       = errors.New("boleto: the barcode must have 44 digits")
+	ErrDigitableLine = errors.New("boleto: the digitable line must have 47 digits")
+	ErrCheckDigit    = errors.New("boleto: wrong check digit")
+)
+
+// Barcode is the 44 digits of the barcode of a boleto, in the FEBRABAN
+// layout: bank, currency, check digit, due date factor, amount and the free
+// field of the bank.
+type Barcode string
+
+// NewBarcode writes the barcode of a boleto in reais. A zero due date is
+// written as the factor 0000, of the boletos without due date.
+func NewBarcode(bankCode string, dueDate time.Time, amount money.Money, freeField string) (Barcode, error) {
+	if len(bankCode) != 3 || !isDigits(bankCode) {
+		return "", ErrBankCode
+	}
+
+	if len(freeField) != FreeFieldSize || !isDigits(freeField) {
+		return "", ErrFreeField
+	}
+
+	cents := amount.Cents()
+	if cents < 0 || cents > maxAmountCents {
+		return "", ErrAmount
+	}
+
+	factor := "0000"
+	if !dueDate.IsZero() {
+		factor = DueDateFactor(dueDate)
+	}
+
+	digits := bankCode + currencyReal + factor + fmt.Sprintf("%010d", cents) + freeField
+
+	return Barcode(digits[:4] + barcodeCheckDigit(digits) + digits[4:]), nil
+}
+
+// ParseBarcode checks the digits and the check digit of the barcode.
+func ParseBarcode(digits string) (Barcode, error) {
+	if len(digits) != BarcodeSize || !isDigits(digits) {
+		return "", ErrBarcode
+	}
+
+	if barcodeCheckDigit(digits[:4]+digits[5:]) != digits[4:5] {
+		return "", ErrCheckDigit
+	}
+
+	return Barcode(digits), nil
+}
+
+// ParseDigitableLine returns the barcode of the digitable line, typed with
+// or without its dots and spaces, checking every check digit.
+func ParseDigitableLine(line string) (Barcode, error) {
+	digits := strings.NewReplacer(".", "", " ", "").Replace(line)
+	if len(digits) != DigitableLineSize || !isDigits(digits) {
+		return "", ErrDigitableLine
+	}
+
+	fields := []string{digits[0:10], digits[10:21], digits[21:32]}
+	for _, field := range fields {
+		last := len(field) - 1
+		if Mod10(field[:last]) != int(field[last]-'0') {
+			return "", ErrCheckDigit
+		}
+	}
+
+	return ParseBarcode(digits[0:4] + digits[32:33] + digits[33:47] + digits[4:9] + digits[10:20] + digits[21:31])
+}
+
+func (b Barcode) BankCode() string {
+	return string(b[0:3])
+}
+
+func (b Barcode) FreeField() string {
+	return string(b[19:44])
+}
+
+// DigitableLine returns the 47 digits typed to pay the boleto: the free field
+// split in three fields with their check digits, the check digit of the
+// barcode, the due date factor and the amount.
+func (b Barcode) DigitableLine() string {
+	first := string(b[0:4] + b[19:24])
+	second := string(b[24:34])
+	third := string(b[34:44])
+
+	return first + checkDigit(first) +
+		second + checkDigit(second) +
+		third + checkDigit(third) +
+		string(b[4:5]) + string(b[5:19])
+}
+
+// FormattedDigitableLine returns the digitable line the way it is printed,
+// as in 00190.50095 40144.816069 06809.350314 3 37370000000100.
+func (b Barcode) FormattedDigitableLine() string {
+	line := b.DigitableLine()
+
+	return line[0:5] + "." + line[5:10] + " " +
+		line[10:15] + "." + line[15:21] + " " +
+		line[21:26] + "." + line[26:32] + " " +
+		line[32:33] + " " + line[33:47]
+}
+
+func checkDigit(digits string) string {
+	return fmt.Sprint(Mod10(digits))
+}
+
+// barcodeCheckDigit is the modulo 11 of the 43 other digits of the barcode,
+// written as 1 when it would be 0, 10 or 11.
+func barcodeCheckDigit(digits string) string {
+	digit := 11 - Mod11(digits, 9)
+	if digit == 0 || digit >= 10 {
+		digit = 1
+	}
+
+	return fmt.Sprint(digit)
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,106 @@
+// Package boleto writes boletos bancários in the FEBRABAN layout: the 44
+// digit barcode, the 47 digit digitable line (linha digitável), the
+// Interleaved 2 of 5 bars and the printable slip. The free field of the
+// barcode is laid out by the Bank of the beneficiary.
+package boleto
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/document"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+)
+
+// Boleto is a boleto to be paid in reais.
+type Boleto struct {
+	Bank Bank
+	// OurNumber is the nosso número, the number the beneficiary gives to
+	// the boleto so that the bank can tell it back on the payment.
+	OurNumber int64
+	// DocumentNumber is the number of the document charged in the
+	// beneficiary's books.
+	DocumentNumber string
+	IssuedAt       time.Time
+	DueDate        time.Time
+	Amount         money.Money
+	Beneficiary    Beneficiary
+	Payer          Payer
+	Instructions   []string
+}
+
+type Beneficiary struct {
+	Name     string
+	Document string
+}
+
+type Payer struct {
+	Name     string
+	Document string
+	Address  Address
+}
+
+type Address struct {
+	Street       string
+	Neighborhood string
+	City         string
+	State        string
+	ZipCode      string
+}
+
+var ErrNoBank = errors.New("boleto: the bank is required")
+
+// Barcode writes the barcode of the boleto.
+func (b Boleto) Barcode() (Barcode, error) {
+	if b.Bank == nil {
+		return "", ErrNoBank
+	}
+
+	freeField, err := b.Bank.FreeField(b.OurNumber)
+	if err != nil {
+		return "", err
+	}
+
+	return NewBarcode(b.Bank.Code(), b.DueDate, b.Amount, freeField)
+}
+
+// Lines writes the address the way it goes on an envelope.
+func (a Address) Lines() []string {
+	var lines []string
+
+	first := joinNonEmpty(" - ", a.Street, a.Neighborhood)
+	if first != "" {
+		lines = append(lines, first)
+	}
+
+	second := joinNonEmpty(" - ", formatZipCode(a.ZipCode), joinNonEmpty("/", a.City, a.State))
+	if second != "" {
+		lines = append(lines, second)
+	}
+
+	return lines
+}
+
+func formatDocument(doc string) string {
+	return document.Document(doc).Format()
+}
+
+func joinNonEmpty(sep string, parts ...string) string {
+	kept := parts[:0:0]
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			kept = append(kept, part)
+		}
+	}
+
+	return strings.Join(kept, sep)
+}
+
+func formatZipCode(zipCode string) string {
+	if len(zipCode) == 8 && isDigits(zipCode) {
+		return zipCode[:5] + "-" + zipCode[5:]
+	}
+
+	return zipCode
+}
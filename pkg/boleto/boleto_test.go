@@ -0,0 +1,258 @@
+package boleto
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+)
+
+func TestMod10(t *testing.T) {
+	tests := []struct {
+		digits string
+		want   int
+	}{
+		{"001905009", 5},
+		{"4014481606", 9},
+		{"0680935031", 4},
+		{"0", 0},
+	}
+
+	for _, tt := range tests {
+		if got := Mod10(tt.digits); got != tt.want {
+			t.Errorf("Mod10(%q) = %d, want %d", tt.digits, got, tt.want)
+		}
+	}
+}
+
+func TestDueDateFactor(t *testing.T) {
+	tests := []struct {
+		date time.Time
+		want string
+	}{
+		{time.Date(2000, time.July, 3, 0, 0, 0, 0, time.UTC), "1000"},
+		{time.Date(2025, time.February, 21, 0, 0, 0, 0, time.UTC), "9999"},
+		{time.Date(2025, time.February, 22, 0, 0, 0, 0, time.UTC), "1000"},
+		{time.Date(2025, time.February, 22, 23, 0, 0, 0, time.FixedZone("BRT", -3*3600)), "1000"},
+	}
+
+	for _, tt := range tests {
+		if got := DueDateFactor(tt.date); got != tt.want {
+			t.Errorf("DueDateFactor(%v) = %s, want %s", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestBarcodeAndDigitableLine(t *testing.T) {
+	dueDate := factorBase.AddDate(0, 0, 3737)
+	barcode, err := NewBarcode("001", dueDate, money.FromCents(100), "0500940144816060680935031")
+	if err != nil {
+		t.Fatalf("NewBarcode() error = %v", err)
+	}
+
+	if barcode != "00193373700000001000500940144816060680935031" {
+		t.Errorf("NewBarcode() = %s", barcode)
+	}
+
+	want := "00190.50095 40144.816069 06809.350314 3 37370000000100"
+	if got := barcode.FormattedDigitableLine(); got != want {
+		t.Errorf("FormattedDigitableLine() = %s, want %s", got, want)
+	}
+
+	if got := barcode.DigitableLine(); len(got) != DigitableLineSize {
+		t.Errorf("DigitableLine() has %d digits", len(got))
+	}
+
+	parsed, err := ParseDigitableLine(want)
+	if err != nil || parsed != barcode {
+		t.Errorf("ParseDigitableLine() = %s, %v, want %s", parsed, err, barcode)
+	}
+
+	if _, err := ParseDigitableLine(strings.Replace(want, "3 3737", "4 3737", 1)); err != ErrCheckDigit {
+		t.Errorf("ParseDigitableLine() with a wrong check digit error = %v", err)
+	}
+}
+
+func TestNewBarcodeRejectsInvalidInput(t *testing.T) {
+	freeField := strings.Repeat("0", FreeFieldSize)
+
+	if _, err := NewBarcode("01", time.Time{}, money.FromCents(1), freeField); err != ErrBankCode {
+		t.Errorf("bank code error = %v", err)
+	}
+
+	if _, err := NewBarcode("001", time.Time{}, money.FromCents(1), "123"); err != ErrFreeField {
+		t.Errorf("free field error = %v", err)
+	}
+
+	if _, err := NewBarcode("001", time.Time{}, money.FromCents(10_000_000_000), freeField); err != ErrAmount {
+		t.Errorf("amount error = %v", err)
+	}
+
+	barcode, err := NewBarcode("001", time.Time{}, money.FromCents(1), freeField)
+	if err != nil || barcode[5:9] != "0000" {
+		t.Errorf("NewBarcode() without due date = %s, %v", barcode, err)
+	}
+}
+
+func TestBanks(t *testing.T) {
+	bb, err := NewBank(Account{Bank: BancoDoBrasilCode, Agency: "1234-5", Number: "12345-6", Wallet: "17", Agreement: "1234567"})
+	if err != nil {
+		t.Fatalf("NewBank(BB) error = %v", err)
+	}
+
+	freeField, _ := bb.FreeField(42)
+	if freeField != "0000001234567000000004217" {
+		t.Errorf("BB FreeField() = %s", freeField)
+	}
+
+	if got := bb.OurNumber(42); got != "12345670000000042" {
+		t.Errorf("BB OurNumber() = %s", got)
+	}
+
+	if _, err := bb.FreeField(10_000_000_000); err != ErrOurNumber {
+		t.Errorf("BB FreeField() out of range error = %v", err)
+	}
+
+	bradesco, err := NewBank(Account{Bank: BradescoCode, Agency: "1234-5", Number: "98765-4", Wallet: "19"})
+	if err != nil {
+		t.Fatalf("NewBank(Bradesco) error = %v", err)
+	}
+
+	freeField, _ = bradesco.FreeField(2)
+	if freeField != "1234190000000000200987650" {
+		t.Errorf("Bradesco FreeField() = %s", freeField)
+	}
+
+	if got := bradesco.OurNumber(2); got != "19/00000000002-8" {
+		t.Errorf("Bradesco OurNumber() = %s", got)
+	}
+
+	if _, err := NewBank(Account{Bank: "999"}); err != ErrUnsupportedBank {
+		t.Errorf("NewBank(999) error = %v", err)
+	}
+
+	if got := bankCodeDigit(BancoDoBrasilCode) + bankCodeDigit(BradescoCode); got != "92" {
+		t.Errorf("bankCodeDigit() = %s, want 92", got)
+	}
+}
+
+// decodeI2of5 reads the digits back from the bars of the image.
+func decodeI2of5(t *testing.T, img image.Image, scale int) string {
+	t.Helper()
+
+	var widths []int
+	black := false
+	run := 0
+	for x := img.Bounds().Min.X; x < img.Bounds().Max.X; x++ {
+		r, _, _, _ := img.At(x, 0).RGBA()
+		if (r == 0) != black {
+			if run > 0 && (black || len(widths) > 0) {
+				widths = append(widths, run/scale)
+			}
+			black, run = r == 0, 0
+		}
+		run++
+	}
+
+	if len(widths) < 4 || !equal(widths[:4], i2of5Start) {
+		t.Fatalf("start pattern not found in %v", widths)
+	}
+
+	var digits strings.Builder
+	body := widths[4 : len(widths)-2]
+	for i := 0; i+10 <= len(body); i += 10 {
+		var bars, spaces []int
+		for j := 0; j < 10; j += 2 {
+			bars = append(bars, body[i+j])
+			spaces = append(spaces, body[i+j+1])
+		}
+		digits.WriteByte(digitOf(t, bars))
+		digits.WriteByte(digitOf(t, spaces))
+	}
+
+	return digits.String()
+}
+
+func digitOf(t *testing.T, pattern []int) byte {
+	for digit, want := range i2of5Patterns {
+		if equal(pattern, want[:]) {
+			return byte('0' + digit)
+		}
+	}
+
+	t.Fatalf("unknown pattern %v", pattern)
+	return 0
+}
+
+func equal(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestBarcodeImage(t *testing.T) {
+	barcode := Barcode("00193373700000001000500940144816060680935031")
+
+	data, err := barcode.PNG(2, 50)
+	if err != nil {
+		t.Fatalf("PNG() error = %v", err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("png.Decode() error = %v", err)
+	}
+
+	if got := decodeI2of5(t, img, 2); got != string(barcode) {
+		t.Errorf("decoded %s, want %s", got, barcode)
+	}
+
+	if _, err := Interleaved2of5("123"); err != ErrOddDigits {
+		t.Errorf("Interleaved2of5() with odd digits error = %v", err)
+	}
+}
+
+func TestPDF(t *testing.T) {
+	bank, _ := NewBank(Account{Bank: BancoDoBrasilCode, Agency: "1234-5", Number: "12345-6", Wallet: "17", Agreement: "1234567"})
+	b := Boleto{
+		Bank:           bank,
+		OurNumber:      42,
+		DocumentNumber: "3/10",
+		IssuedAt:       time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC),
+		DueDate:        time.Date(2026, time.April, 10, 0, 0, 0, 0, time.UTC),
+		Amount:         money.FromCents(123450),
+		Beneficiary:    Beneficiary{Name: "Loja da Esquina", Document: "11222333000181"},
+		Payer: Payer{
+			Name:     "Padaria Pão Quente Ltda",
+			Document: "11444777000161",
+			Address:  Address{Street: "Rua das Flores, 10", Neighborhood: "Centro", City: "Salvador", State: "BA", ZipCode: "40000000"},
+		},
+		Instructions: []string{"Não receber após 30 dias do vencimento"},
+	}
+
+	data, err := b.PDF()
+	if err != nil {
+		t.Fatalf("PDF() error = %v", err)
+	}
+
+	barcode, _ := b.Barcode()
+	for _, want := range []string{"%PDF-1.4", barcode.FormattedDigitableLine(), "R$ 1.234,50", "11.444.777/0001-61", "40000-000 - Salvador/BA"} {
+		if !bytes.Contains(data, []byte(want)) {
+			t.Errorf("PDF() does not contain %q", want)
+		}
+	}
+
+	if _, err := (Boleto{}).PDF(); err != ErrNoBank {
+		t.Errorf("PDF() without bank error = %v", err)
+	}
+}
@@ -0,0 +1,34 @@
+package boleto
+
+// Mod10 returns the modulo 10 check digit of the digits: weighted by 2 and 1
+// from the right, with the digits of each product summed.
+func Mod10(digits string) int {
+	sum := 0
+	weight := 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		product := int(digits[i]-'0') * weight
+		sum += product/10 + product%10
+
+		weight = 3 - weight
+	}
+
+	return (10 - sum%10) % 10
+}
+
+// Mod11 returns the remainder by 11 of the digits weighted from 2 to
+// maxWeight, starting over from the right. Each bank makes its check digits
+// from the remainder its own way.
+func Mod11(digits string, maxWeight int) int {
+	sum := 0
+	weight := 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		sum += int(digits[i]-'0') * weight
+
+		weight++
+		if weight > maxWeight {
+			weight = 2
+		}
+	}
+
+	return sum % 11
+}
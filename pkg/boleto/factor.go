@@ -0,0 +1,27 @@
+package boleto
+
+import (
+	"fmt"
+	"time"
+)
+
+// The due date factor counts the days from the base date, starting over from
+// 1000 after reaching 9999 on 2025-02-21.
+var factorBase = time.Date(1997, time.October, 7, 0, 0, 0, 0, time.UTC)
+
+const (
+	minFactor = 1000
+	maxFactor = 9999
+)
+
+// DueDateFactor writes the 4 digits of the due date in the barcode.
+func DueDateFactor(dueDate time.Time) string {
+	date := time.Date(dueDate.Year(), dueDate.Month(), dueDate.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(date.Sub(factorBase).Hours() / 24)
+
+	if days > maxFactor {
+		days = (days-minFactor)%(maxFactor-minFactor+1) + minFactor
+	}
+
+	return fmt.Sprintf("%04d", days)
+}
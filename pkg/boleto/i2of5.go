@@ -0,0 +1,107 @@
+package boleto
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+)
+
+// The barcode of the boletos is an Interleaved 2 of 5: the digits go in
+// pairs, the first one in the bars and the second one in the spaces between
+// them, each written by 5 elements where 2 are wide.
+const (
+	narrow = 1
+	wide   = 3
+	// quietZone is the blank margin, in narrow elements, around the bars.
+	quietZone = 10
+)
+
+var ErrOddDigits = errors.New("boleto: interleaved 2 of 5 needs an even number of digits")
+
+var i2of5Patterns = [10][5]int{
+	{narrow, narrow, wide, wide, narrow},
+	{wide, narrow, narrow, narrow, wide},
+	{narrow, wide, narrow, narrow, wide},
+	{wide, wide, narrow, narrow, narrow},
+	{narrow, narrow, wide, narrow, wide},
+	{wide, narrow, wide, narrow, narrow},
+	{narrow, wide, wide, narrow, narrow},
+	{narrow, narrow, narrow, wide, wide},
+	{wide, narrow, narrow, wide, narrow},
+	{narrow, wide, narrow, wide, narrow},
+}
+
+var (
+	i2of5Start = []int{narrow, narrow, narrow, narrow}
+	i2of5Stop  = []int{wide, narrow, narrow}
+)
+
+// Interleaved2of5 returns the widths of the elements that write the digits,
+// in narrow units, starting with a bar and alternating bars and spaces.
+func Interleaved2of5(digits string) ([]int, error) {
+	if len(digits)%2 != 0 || !isDigits(digits) {
+		return nil, ErrOddDigits
+	}
+
+	widths := append([]int{}, i2of5Start...)
+	for i := 0; i < len(digits); i += 2 {
+		bars := i2of5Patterns[digits[i]-'0']
+		spaces := i2of5Patterns[digits[i+1]-'0']
+		for j := range 5 {
+			widths = append(widths, bars[j], spaces[j])
+		}
+	}
+
+	return append(widths, i2of5Stop...), nil
+}
+
+// Widths returns the elements of the bars of the barcode.
+func (b Barcode) Widths() []int {
+	widths, _ := Interleaved2of5(string(b))
+	return widths
+}
+
+// Image draws the bars of the barcode with scale pixels per narrow element,
+// between the quiet zones.
+func (b Barcode) Image(scale, height int) image.Image {
+	if scale < 1 {
+		scale = 1
+	}
+
+	widths := b.Widths()
+	total := 2 * quietZone
+	for _, width := range widths {
+		total += width
+	}
+
+	img := image.NewGray(image.Rect(0, 0, total*scale, height))
+	for i := range img.Pix {
+		img.Pix[i] = 0xFF
+	}
+
+	x := quietZone * scale
+	for i, width := range widths {
+		if i%2 == 0 {
+			for dx := 0; dx < width*scale; dx++ {
+				for y := 0; y < height; y++ {
+					img.SetGray(x+dx, y, color.Gray{Y: 0})
+				}
+			}
+		}
+		x += width * scale
+	}
+
+	return img
+}
+
+// PNG encodes the image of the barcode.
+func (b Barcode) PNG(scale, height int) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, b.Image(scale, height)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
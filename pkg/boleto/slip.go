@@ -0,0 +1,222 @@
+package boleto
+
+import (
+	"strings"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/pdf"
+)
+
+// Layout of the slip, in points: the payer's receipt on top and the
+// compensation form (ficha de compensação) with the bars below the cut.
+const (
+	margin       = 28.0
+	slipWidth    = pdf.PageWidth - 2*margin
+	rightColumn  = 140.0
+	leftColumn   = slipWidth - rightColumn
+	rowHeight    = 24.0
+	labelSize    = 6.0
+	valueSize    = 9.0
+	narrowBar    = 0.72
+	barHeight    = 37.0
+	paymentPlace = "Pagável em qualquer banco até o vencimento"
+	dateLayout   = "02/01/2006"
+)
+
+// PDF writes the printable slip of the boleto on an A4 page.
+func (b Boleto) PDF() ([]byte, error) {
+	barcode, err := b.Barcode()
+	if err != nil {
+		return nil, err
+	}
+
+	doc := pdf.New()
+	doc.SetTitle("Boleto " + b.Bank.OurNumber(b.OurNumber))
+	page := doc.AddPage()
+
+	s := slip{page: page, boleto: b, barcode: barcode}
+	s.receipt(30)
+	s.cut(168)
+	s.compensation(190)
+
+	return doc.Bytes(), nil
+}
+
+type slip struct {
+	page    *pdf.Page
+	boleto  Boleto
+	barcode Barcode
+}
+
+func (s slip) receipt(y float64) {
+	s.header(y)
+	y += 26
+
+	b := s.boleto
+	s.cell(margin, y, leftColumn, "Beneficiário", s.beneficiary(), false)
+	s.cell(margin+leftColumn, y, rightColumn, "Vencimento", s.dueDate(), true)
+	y += rowHeight
+
+	s.cell(margin, y, leftColumn, "Pagador", s.payer(), false)
+	s.cell(margin+leftColumn, y, rightColumn, "Nosso número", b.Bank.OurNumber(b.OurNumber), true)
+	y += rowHeight
+
+	s.cell(margin, y, leftColumn/2, "Agência / Código do beneficiário", b.Bank.BeneficiaryCode(), false)
+	s.cell(margin+leftColumn/2, y, leftColumn/2, "Número do documento", b.DocumentNumber, false)
+	s.cell(margin+leftColumn, y, rightColumn, "(=) Valor do documento", b.Amount.Format(), true)
+	y += rowHeight
+
+	s.page.TextRight(margin+slipWidth, y+10, pdf.Helvetica, labelSize, "Recibo do Pagador - Autenticação mecânica")
+}
+
+func (s slip) cut(y float64) {
+	for x := margin; x < margin+slipWidth; x += 6 {
+		s.page.Line(x, y, x+3, y, 0.5)
+	}
+	s.page.TextRight(margin+slipWidth, y-3, pdf.Helvetica, labelSize, "Corte na linha pontilhada")
+}
+
+func (s slip) compensation(y float64) {
+	s.header(y)
+	y += 26
+
+	b := s.boleto
+	s.cell(margin, y, leftColumn, "Local de pagamento", paymentPlace, false)
+	s.cell(margin+leftColumn, y, rightColumn, "Vencimento", s.dueDate(), true)
+	y += rowHeight
+
+	s.cell(margin, y, leftColumn, "Beneficiário", s.beneficiary(), false)
+	s.cell(margin+leftColumn, y, rightColumn, "Agência / Código do beneficiário", b.Bank.BeneficiaryCode(), true)
+	y += rowHeight
+
+	s.row(y, []float64{80, 110, 60, 40}, []string{"Data do documento", "Número do documento", "Espécie doc.", "Aceite", "Data processamento"},
+		[]string{b.IssuedAt.Format(dateLayout), b.DocumentNumber, "DM", "N", b.IssuedAt.Format(dateLayout)})
+	s.cell(margin+leftColumn, y, rightColumn, "Nosso número", b.Bank.OurNumber(b.OurNumber), true)
+	y += rowHeight
+
+	s.row(y, []float64{80, 60, 60, 90}, []string{"Uso do banco", "Carteira", "Espécie", "Quantidade", "Valor"},
+		[]string{"", b.Bank.Wallet(), "R$", "", ""})
+	s.cell(margin+leftColumn, y, rightColumn, "(=) Valor do documento", b.Amount.Format(), true)
+	y += rowHeight
+
+	s.instructions(y)
+	for _, label := range []string{"(-) Desconto / Abatimento", "(-) Outras deduções", "(+) Mora / Multa", "(+) Outros acréscimos", "(=) Valor cobrado"} {
+		s.cell(margin+leftColumn, y, rightColumn, label, "", true)
+		y += rowHeight
+	}
+
+	s.payerBox(y)
+	y += 2 * rowHeight
+
+	s.page.TextRight(margin+slipWidth, y+10, pdf.Helvetica, labelSize, "Ficha de Compensação - Autenticação mecânica")
+	s.bars(margin, y+16)
+}
+
+// header writes the bank, its code and the digitable line over a thick rule.
+func (s slip) header(y float64) {
+	bank := s.boleto.Bank
+	codeX := margin + 150
+
+	s.page.Text(margin, y+18, pdf.HelveticaBold, 12, fit(pdf.HelveticaBold, 12, bank.Name(), 145))
+	s.page.Line(codeX, y+4, codeX, y+24, 1)
+	s.page.Text(codeX+6, y+19, pdf.HelveticaBold, 14, bank.Code()+"-"+bankCodeDigit(bank.Code()))
+	s.page.Line(codeX+58, y+4, codeX+58, y+24, 1)
+	s.page.TextRight(margin+slipWidth, y+18, pdf.HelveticaBold, 10, s.barcode.FormattedDigitableLine())
+	s.page.Line(margin, y+24, margin+slipWidth, y+24, 1.5)
+}
+
+// row writes cells side by side over the left column, the last one taking
+// what is left of it.
+func (s slip) row(y float64, widths []float64, labels, values []string) {
+	x := margin
+	for i, label := range labels {
+		width := margin + leftColumn - x
+		if i < len(widths) {
+			width = widths[i]
+		}
+
+		s.cell(x, y, width, label, values[i], false)
+		x += width
+	}
+}
+
+func (s slip) cell(x, y, width float64, label, value string, right bool) {
+	s.page.Rect(x, y, width, rowHeight, 0.5)
+	s.page.Text(x+2, y+7, pdf.Helvetica, labelSize, label)
+
+	value = fit(pdf.Helvetica, valueSize, value, width-6)
+	if right {
+		s.page.TextRight(x+width-3, y+rowHeight-5, pdf.Helvetica, valueSize, value)
+		return
+	}
+
+	s.page.Text(x+3, y+rowHeight-5, pdf.Helvetica, valueSize, value)
+}
+
+func (s slip) instructions(y float64) {
+	height := 5 * rowHeight
+	s.page.Rect(margin, y, leftColumn, height, 0.5)
+	s.page.Text(margin+2, y+7, pdf.Helvetica, labelSize, "Instruções (texto de responsabilidade do beneficiário)")
+
+	lineY := y + 20
+	for _, instruction := range s.boleto.Instructions {
+		if lineY > y+height-4 {
+			break
+		}
+
+		s.page.Text(margin+3, lineY, pdf.Helvetica, valueSize, fit(pdf.Helvetica, valueSize, instruction, leftColumn-6))
+		lineY += 12
+	}
+}
+
+func (s slip) payerBox(y float64) {
+	payer := s.boleto.Payer
+	s.page.Rect(margin, y, slipWidth, 2*rowHeight, 0.5)
+	s.page.Text(margin+2, y+7, pdf.Helvetica, labelSize, "Pagador")
+
+	lines := append([]string{s.payer()}, payer.Address.Lines()...)
+	for i, line := range lines {
+		s.page.Text(margin+3, y+18+float64(i)*11, pdf.Helvetica, valueSize, fit(pdf.Helvetica, valueSize, line, slipWidth-6))
+	}
+}
+
+// bars draws the barcode with its top left corner at x, y.
+func (s slip) bars(x, y float64) {
+	for i, width := range s.barcode.Widths() {
+		if i%2 == 0 {
+			s.page.FillRect(x, y, float64(width)*narrowBar, barHeight, 0)
+		}
+		x += float64(width) * narrowBar
+	}
+}
+
+func (s slip) beneficiary() string {
+	beneficiary := s.boleto.Beneficiary
+	return joinNonEmpty(" - ", beneficiary.Name, formatDocument(beneficiary.Document))
+}
+
+func (s slip) payer() string {
+	payer := s.boleto.Payer
+	return joinNonEmpty(" - ", payer.Name, formatDocument(payer.Document))
+}
+
+func (s slip) dueDate() string {
+	if s.boleto.DueDate.IsZero() {
+		return "Contra apresentação"
+	}
+
+	return s.boleto.DueDate.Format(dateLayout)
+}
+
+// fit cuts the text to the width, ending it with "…" when cut.
+func fit(font pdf.Font, size float64, text string, width float64) string {
+	if pdf.TextWidth(font, size, text) <= width {
+		return text
+	}
+
+	runes := []rune(text)
+	for len(runes) > 0 && pdf.TextWidth(font, size, string(runes)+"…") > width {
+		runes = runes[:len(runes)-1]
+	}
+
+	return strings.TrimSpace(string(runes)) + "…"
+}
@@ -23,6 +23,39 @@ func (d *Document) Validate() error {
 	return ValidateCNPJ(string(*d))
 }
 
+// Digits returns the document without its separators.
+func (d Document) Digits() string {
+	return regexp.MustCompile(`\D`).ReplaceAllString(string(d), "")
+}
+
+// Format writes a CPF or a CNPJ with their separators, as in 529.982.247-25
+// and 11.222.333/0001-81. Other documents are written as they are.
+func (d Document) Format() string {
+	digits := d.Digits()
+
+	switch len(digits) {
+	case 11:
+		return digits[0:3] + "." + digits[3:6] + "." + digits[6:9] + "-" + digits[9:11]
+	case 14:
+		return digits[0:2] + "." + digits[2:5] + "." + digits[5:8] + "/" + digits[8:12] + "-" + digits[12:14]
+	}
+
+	return string(d)
+}
+
+// Labeled writes the document formatted and preceded by its kind, as in
+// "CPF 529.982.247-25". Documents that are neither are only formatted.
+func (d Document) Labeled() string {
+	switch {
+	case ValidateCPF(string(d)) == nil:
+		return "CPF " + d.Format()
+	case ValidateCNPJ(string(d)) == nil:
+		return "CNPJ " + d.Format()
+	}
+
+	return d.Format()
+}
+
 func ValidateCPF(cpf string) error {
 	cpf = regexp.MustCompile(`\D`).ReplaceAllString(cpf, "")
 
@@ -105,3 +105,38 @@ func BenchmarkValidateCNPJ(b *testing.B) {
 		ValidateCNPJ(cnpj)
 	}
 }
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		document Document
+		want     string
+	}{
+		{"52998224725", "529.982.247-25"},
+		{"11222333000181", "11.222.333/0001-81"},
+		{"11.222.333/0001-81", "11.222.333/0001-81"},
+		{"123", "123"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.document.Format(); got != tt.want {
+			t.Errorf("Format(%q) = %q, want %q", tt.document, got, tt.want)
+		}
+	}
+}
+
+func TestLabeled(t *testing.T) {
+	tests := []struct {
+		document Document
+		want     string
+	}{
+		{"52998224725", "CPF 529.982.247-25"},
+		{"11.222.333/0001-81", "CNPJ 11.222.333/0001-81"},
+		{"12345678900", "123.456.789-00"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.document.Labeled(); got != tt.want {
+			t.Errorf("Labeled(%q) = %q, want %q", tt.document, got, tt.want)
+		}
+	}
+}
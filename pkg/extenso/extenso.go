@@ -0,0 +1,147 @@
+// Package extenso writes numbers and amounts in reais in Portuguese words,
+// as they are written on receipts and checks.
+package extenso
+
+import (
+	"strings"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+)
+
+var (
+	units = [...]string{"zero", "um", "dois", "três", "quatro", "cinco", "seis", "sete", "oito", "nove",
+		"dez", "onze", "doze", "treze", "quatorze", "quinze", "dezesseis", "dezessete", "dezoito", "dezenove"}
+	tens     = [...]string{"", "", "vinte", "trinta", "quarenta", "cinquenta", "sessenta", "setenta", "oitenta", "noventa"}
+	hundreds = [...]string{"", "cento", "duzentos", "trezentos", "quatrocentos", "quinhentos", "seiscentos", "setecentos", "oitocentos", "novecentos"}
+)
+
+// scales are the names of the groups of three digits, from the thousands up,
+// in the singular and in the plural.
+var scales = [...][2]string{
+	{"mil", "mil"},
+	{"milhão", "milhões"},
+	{"bilhão", "bilhões"},
+	{"trilhão", "trilhões"},
+	{"quatrilhão", "quatrilhões"},
+	{"quintilhão", "quintilhões"},
+}
+
+// Number writes the number in words, as in "mil duzentos e trinta e quatro".
+func Number(n int64) string {
+	if n == 0 {
+		return units[0]
+	}
+
+	if n < 0 {
+		// The words of the lowest int64 are written from its unsigned value.
+		return "menos " + number(uint64(-(n+1))+1)
+	}
+
+	return number(uint64(n))
+}
+
+func number(n uint64) string {
+	var groups []uint64
+	for ; n > 0; n /= 1000 {
+		groups = append(groups, n%1000)
+	}
+
+	var parts []string
+	for i := len(groups) - 1; i >= 0; i-- {
+		group := groups[i]
+		if group == 0 {
+			continue
+		}
+
+		var part string
+		switch {
+		case i == 0:
+			part = belowThousand(group)
+		case i == 1 && group == 1:
+			part = scales[0][0]
+		case group == 1:
+			part = "um " + scales[i-1][0]
+		default:
+			part = belowThousand(group) + " " + scales[i-1][1]
+		}
+
+		// The last group is joined by "e" when it is below one hundred or a
+		// round hundred, as in "mil e cem" and "dois mil e vinte".
+		if i == 0 && len(parts) > 0 && (group < 100 || group%100 == 0) {
+			part = "e " + part
+		}
+
+		parts = append(parts, part)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func belowThousand(n uint64) string {
+	if n == 100 {
+		return "cem"
+	}
+
+	var parts []string
+	if n >= 100 {
+		parts = append(parts, hundreds[n/100])
+		n %= 100
+	}
+
+	switch {
+	case n >= 20:
+		parts = append(parts, tens[n/10])
+		if n%10 > 0 {
+			parts = append(parts, units[n%10])
+		}
+	case n > 0:
+		parts = append(parts, units[n])
+	}
+
+	return strings.Join(parts, " e ")
+}
+
+// Reais writes the amount in reais and centavos, as in "mil e cem reais e
+// cinquenta centavos".
+func Reais(amount money.Money) string {
+	cents := amount.Cents()
+
+	sign := ""
+	if cents < 0 {
+		sign = "menos "
+		cents = -cents
+	}
+
+	whole, fraction := cents/100, cents%100
+	if whole == 0 && fraction == 0 {
+		return "zero reais"
+	}
+
+	var parts []string
+	if whole > 0 {
+		parts = append(parts, Number(whole)+" "+currencyName(whole))
+	}
+
+	if fraction > 0 {
+		name := "centavos"
+		if fraction == 1 {
+			name = "centavo"
+		}
+		parts = append(parts, Number(fraction)+" "+name)
+	}
+
+	return sign + strings.Join(parts, " e ")
+}
+
+// currencyName is "real" for one, and "de reais" after the round millions
+// and above, as in "um milhão de reais".
+func currencyName(whole int64) string {
+	switch {
+	case whole == 1:
+		return "real"
+	case whole >= 1_000_000 && whole%1_000_000 == 0:
+		return "de reais"
+	default:
+		return "reais"
+	}
+}
@@ -0,0 +1,65 @@
+package extenso
+
+import (
+	"testing"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+)
+
+func TestNumber(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "zero"},
+		{1, "um"},
+		{16, "dezesseis"},
+		{21, "vinte e um"},
+		{100, "cem"},
+		{101, "cento e um"},
+		{115, "cento e quinze"},
+		{999, "novecentos e noventa e nove"},
+		{1000, "mil"},
+		{1001, "mil e um"},
+		{1100, "mil e cem"},
+		{1234, "mil duzentos e trinta e quatro"},
+		{2020, "dois mil e vinte"},
+		{21000, "vinte e um mil"},
+		{100000, "cem mil"},
+		{1000000, "um milhão"},
+		{1000500, "um milhão e quinhentos"},
+		{2300000, "dois milhões trezentos mil"},
+		{1234567, "um milhão duzentos e trinta e quatro mil quinhentos e sessenta e sete"},
+		{1000000000, "um bilhão"},
+		{-15, "menos quinze"},
+	}
+
+	for _, tt := range tests {
+		if got := Number(tt.n); got != tt.want {
+			t.Errorf("Number(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestReais(t *testing.T) {
+	tests := []struct {
+		cents int64
+		want  string
+	}{
+		{0, "zero reais"},
+		{1, "um centavo"},
+		{50, "cinquenta centavos"},
+		{100, "um real"},
+		{101, "um real e um centavo"},
+		{123450, "mil duzentos e trinta e quatro reais e cinquenta centavos"},
+		{200000000, "dois milhões de reais"},
+		{200000100, "dois milhões e um reais"},
+		{-250, "menos dois reais e cinquenta centavos"},
+	}
+
+	for _, tt := range tests {
+		if got := Reais(money.FromCents(tt.cents)); got != tt.want {
+			t.Errorf("Reais(%d) = %q, want %q", tt.cents, got, tt.want)
+		}
+	}
+}
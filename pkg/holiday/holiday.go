@@ -0,0 +1,105 @@
+package holiday
+
+import "time"
+
+type Holiday struct {
+	Date time.Time
+	Name string
+}
+
+// fixedHolidays are the national holidays that fall on the same day every
+// year (Leis 662/1949, 6.802/1980 and 14.759/2023), from the year they were
+// first kept on, when they are recent.
+var fixedHolidays = []struct {
+	month time.Month
+	day   int
+	name  string
+	since int
+}{
+	{time.January, 1, "Confraternização Universal", 0},
+	{time.April, 21, "Tiradentes", 0},
+	{time.May, 1, "Dia do Trabalho", 0},
+	{time.September, 7, "Independência do Brasil", 0},
+	{time.October, 12, "Nossa Senhora Aparecida", 0},
+	{time.November, 2, "Finados", 0},
+	{time.November, 15, "Proclamação da República", 0},
+	{time.November, 20, "Dia Nacional de Zumbi e da Consciência Negra", 2024},
+	{time.December, 25, "Natal", 0},
+}
+
+// Year returns the national holidays of the given year, including the ones
+// that move with Easter. Carnival and Corpus Christi are listed too because
+// banks do not open on them, so nothing can be paid on those days.
+func Year(year int) []Holiday {
+	var holidays []Holiday
+
+	for _, h := range fixedHolidays {
+		if year < h.since {
+			continue
+		}
+
+		holidays = append(holidays, Holiday{
+			Date: time.Date(year, h.month, h.day, 0, 0, 0, 0, time.UTC),
+			Name: h.name,
+		})
+	}
+
+	easter := Easter(year)
+	holidays = append(holidays,
+		Holiday{Date: easter.AddDate(0, 0, -48), Name: "Carnaval"},
+		Holiday{Date: easter.AddDate(0, 0, -47), Name: "Carnaval"},
+		Holiday{Date: easter.AddDate(0, 0, -2), Name: "Paixão de Cristo"},
+		Holiday{Date: easter.AddDate(0, 0, 60), Name: "Corpus Christi"},
+	)
+
+	return holidays
+}
+
+// Easter returns the Easter Sunday of the given year (Gregorian calendar,
+// Meeus/Jones/Butcher algorithm).
+func Easter(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+func IsHoliday(date time.Time) bool {
+	for _, h := range Year(date.Year()) {
+		if h.Date.Month() == date.Month() && h.Date.Day() == date.Day() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func IsBusinessDay(date time.Time) bool {
+	if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+		return false
+	}
+
+	return !IsHoliday(date)
+}
+
+// NextBusinessDay returns the date itself when it is a business day, or the
+// first business day after it.
+func NextBusinessDay(date time.Time) time.Time {
+	for !IsBusinessDay(date) {
+		date = date.AddDate(0, 0, 1)
+	}
+
+	return date
+}
@@ -0,0 +1,85 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEaster(t *testing.T) {
+	testCases := []struct {
+		year     int
+		expected string
+	}{
+		{year: 2024, expected: "2024-03-31"},
+		{year: 2025, expected: "2025-04-20"},
+		{year: 2026, expected: "2026-04-05"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expected, func(t *testing.T) {
+			result := Easter(tc.year).Format(time.DateOnly)
+			if result != tc.expected {
+				t.Errorf("Easter(%d) = %s, expected %s", tc.year, result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNextBusinessDay(t *testing.T) {
+	testCases := []struct {
+		name     string
+		date     string
+		expected string
+	}{
+		{
+			name:     "Business day stays the same",
+			date:     "2025-03-12",
+			expected: "2025-03-12",
+		},
+		{
+			name:     "Saturday moves to Monday",
+			date:     "2025-03-15",
+			expected: "2025-03-17",
+		},
+		{
+			name:     "Fixed holiday",
+			date:     "2025-04-21",
+			expected: "2025-04-22",
+		},
+		{
+			name:     "Good Friday and weekend",
+			date:     "2025-04-18",
+			expected: "2025-04-22",
+		},
+		{
+			name:     "Carnival",
+			date:     "2025-03-03",
+			expected: "2025-03-05",
+		},
+		{
+			name:     "Consciência Negra since 2024",
+			date:     "2025-11-20",
+			expected: "2025-11-21",
+		},
+		{
+			name:     "Consciência Negra before 2024",
+			date:     "2023-11-20",
+			expected: "2023-11-20",
+		},
+		{
+			name:     "Christmas and New Year",
+			date:     "2027-12-25",
+			expected: "2027-12-27",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			date, _ := time.Parse(time.DateOnly, tc.date)
+			result := NextBusinessDay(date).Format(time.DateOnly)
+			if result != tc.expected {
+				t.Errorf("NextBusinessDay(%s) = %s, expected %s", tc.date, result, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package jwt
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Now()
+
+	valid, _ := Sign(Claims{Subject: "user", TokenType: "access", IssuedAt: now.Unix(), ExpiresAt: now.Add(time.Minute).Unix()}, secret)
+	expired, _ := Sign(Claims{Subject: "user", TokenType: "access", IssuedAt: now.Unix(), ExpiresAt: now.Add(-time.Minute).Unix()}, secret)
+	parts := strings.Split(valid, ".")
+	forged, _ := Sign(Claims{Subject: "admin", TokenType: "access", ExpiresAt: now.Add(time.Minute).Unix()}, []byte("other"))
+	tampered := parts[0] + "." + strings.Split(forged, ".")[1] + "." + parts[2]
+
+	testCases := []struct {
+		name     string
+		token    string
+		expected error
+	}{
+		{name: "Valid token", token: valid, expected: nil},
+		{name: "Expired token", token: expired, expected: ErrExpiredToken},
+		{name: "Signed with another secret", token: forged, expected: ErrInvalidToken},
+		{name: "Tampered payload", token: tampered, expected: ErrInvalidToken},
+		{name: "Malformed token", token: "abc.def", expected: ErrInvalidToken},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			claims, err := Parse(tc.token, secret)
+			if !errors.Is(err, tc.expected) {
+				t.Errorf("Parse() error = %v, expected %v", err, tc.expected)
+			}
+
+			if tc.expected == nil && claims.Subject != "user" {
+				t.Errorf("Parse() subject = %s, expected user", claims.Subject)
+			}
+		})
+	}
+}
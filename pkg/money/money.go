@@ -0,0 +1,299 @@
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type Currency string
+
+const (
+	BRL Currency = "BRL"
+
+	DefaultCurrency = BRL
+)
+
+// Money is an exact monetary amount kept as integer cents. The zero value is
+// zero in the default currency.
+type Money struct {
+	cents int64
+	// currency is left empty for the default currency, so that equal
+	// amounts are also equal structs (reflect.DeepEqual, gomock matchers).
+	currency Currency
+}
+
+func New(cents int64, currency Currency) Money {
+	if currency == DefaultCurrency {
+		currency = ""
+	}
+
+	return Money{cents: cents, currency: currency}
+}
+
+func FromCents(cents int64) Money {
+	return New(cents, DefaultCurrency)
+}
+
+// Parse reads a decimal amount such as "1234.5", "1234,50" or "-10" into
+// money of the default currency. Non-zero digits past the cents are an error.
+func Parse(value string) (Money, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Money{}, errors.New("money: empty value")
+	}
+
+	negative := false
+	switch value[0] {
+	case '-':
+		negative = true
+		value = value[1:]
+	case '+':
+		value = value[1:]
+	}
+
+	value = strings.Replace(value, ",", ".", 1)
+	units, fraction, _ := strings.Cut(value, ".")
+
+	if units == "" && fraction == "" {
+		return Money{}, fmt.Errorf("money: invalid value %q", value)
+	}
+
+	if len(fraction) > 2 {
+		if strings.Trim(fraction[2:], "0") != "" {
+			return Money{}, fmt.Errorf("money: %q has more than two decimal places", value)
+		}
+		fraction = fraction[:2]
+	}
+
+	if units == "" {
+		units = "0"
+	}
+
+	for len(fraction) < 2 {
+		fraction += "0"
+	}
+
+	whole, err := strconv.ParseUint(units, 10, 63)
+	if err != nil {
+		return Money{}, fmt.Errorf("money: invalid value %q", value)
+	}
+
+	cents, err := strconv.ParseUint(fraction, 10, 8)
+	if err != nil {
+		return Money{}, fmt.Errorf("money: invalid value %q", value)
+	}
+
+	total := int64(whole)*100 + int64(cents)
+	if negative {
+		total = -total
+	}
+
+	return FromCents(total), nil
+}
+
+func MustParse(value string) Money {
+	m, err := Parse(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+func (m Money) Cents() int64 {
+	return m.cents
+}
+
+func (m Money) Currency() Currency {
+	if m.currency == "" {
+		return DefaultCurrency
+	}
+
+	return m.currency
+}
+
+func (m Money) IsZero() bool {
+	return m.cents == 0
+}
+
+func (m Money) IsPositive() bool {
+	return m.cents > 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.cents < 0
+}
+
+func (m Money) Add(other Money) Money {
+	return New(m.cents+other.cents, m.sameCurrency(other))
+}
+
+func (m Money) Sub(other Money) Money {
+	return New(m.cents-other.cents, m.sameCurrency(other))
+}
+
+func (m Money) Mul(factor int64) Money {
+	return New(m.cents*factor, m.Currency())
+}
+
+// Percent returns the given percentage of the amount, rounded half away
+// from zero to the nearest cent.
+func (m Money) Percent(percentage float64) Money {
+	return New(int64(math.Round(float64(m.cents)*percentage/100)), m.Currency())
+}
+
+func (m Money) Equal(other Money) bool {
+	return m.cents == other.cents && m.Currency() == other.Currency()
+}
+
+func (m Money) LessThan(other Money) bool {
+	m.sameCurrency(other)
+	return m.cents < other.cents
+}
+
+func (m Money) GreaterThan(other Money) bool {
+	m.sameCurrency(other)
+	return m.cents > other.cents
+}
+
+// Split divides the amount in n parts that add up exactly to it. The cents
+// that do not divide evenly go to the last part.
+func (m Money) Split(n int) []Money {
+	if n <= 0 {
+		return nil
+	}
+
+	base := m.cents / int64(n)
+	remainder := m.cents - base*int64(n)
+
+	parts := make([]Money, n)
+	for i := range parts {
+		parts[i] = New(base, m.Currency())
+	}
+
+	parts[n-1] = parts[n-1].Add(New(remainder, m.Currency()))
+
+	return parts
+}
+
+// String formats the amount with two decimal places, e.g. "-1234.50".
+func (m Money) String() string {
+	sign := ""
+	cents := m.cents
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// Format writes the amount the way it is shown to people in Brazil, with the
+// currency symbol and the local separators, e.g. "R$ 1.234,50".
+func (m Money) Format() string {
+	sign := ""
+	cents := m.cents
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	units := strconv.FormatInt(cents/100, 10)
+	var grouped strings.Builder
+	for i, digit := range units {
+		if i > 0 && (len(units)-i)%3 == 0 {
+			grouped.WriteByte('.')
+		}
+		grouped.WriteRune(digit)
+	}
+
+	symbol := "R$"
+	if m.currency != "" {
+		symbol = string(m.currency)
+	}
+
+	return fmt.Sprintf("%s%s %s,%02d", sign, symbol, grouped.String(), cents%100)
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+// UnmarshalJSON accepts both a decimal string ("10.50") and a plain JSON
+// number (10.5). Numbers are parsed from their literal text, never through
+// float64.
+func (m *Money) UnmarshalJSON(b []byte) error {
+	raw := string(b)
+	if raw == "null" {
+		return nil
+	}
+
+	if strings.HasPrefix(raw, `"`) {
+		if err := json.Unmarshal(b, &raw); err != nil {
+			return err
+		}
+	}
+
+	parsed, err := Parse(raw)
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+func (m *Money) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*m = FromCents(0)
+		return nil
+	case []byte:
+		return m.scanString(string(value))
+	case string:
+		return m.scanString(value)
+	case int64:
+		*m = FromCents(value * 100)
+		return nil
+	case float64:
+		*m = FromCents(int64(math.Round(value * 100)))
+		return nil
+	}
+
+	return fmt.Errorf("money: cannot scan %T", src)
+}
+
+func (m *Money) scanString(value string) error {
+	parsed, err := Parse(value)
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+func (m Money) sameCurrency(other Money) Currency {
+	if m.Currency() != other.Currency() {
+		panic(fmt.Sprintf("money: currency mismatch %s and %s", m.Currency(), other.Currency()))
+	}
+
+	return m.Currency()
+}
+
+func Min(a, b Money) Money {
+	if b.LessThan(a) {
+		return b
+	}
+
+	return a
+}
@@ -0,0 +1,233 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		expected int64
+		hasError bool
+	}{
+		{
+			name:     "Whole amount",
+			value:    "10",
+			expected: 1000,
+		},
+		{
+			name:     "Amount with cents",
+			value:    "1234.5",
+			expected: 123450,
+		},
+		{
+			name:     "Amount with comma separator",
+			value:    "1234,50",
+			expected: 123450,
+		},
+		{
+			name:     "Negative amount",
+			value:    "-0.01",
+			expected: -1,
+		},
+		{
+			name:     "Trailing zeros past the cents",
+			value:    "10.500",
+			expected: 1050,
+		},
+		{
+			name:     "More than two decimal places",
+			value:    "10.001",
+			hasError: true,
+		},
+		{
+			name:     "Invalid value",
+			value:    "abc",
+			hasError: true,
+		},
+		{
+			name:     "Empty value",
+			value:    "",
+			hasError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := Parse(tc.value)
+			if tc.hasError {
+				if err == nil {
+					t.Errorf("Parse(%q) expected an error", tc.value)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Parse(%q) unexpected error: %v", tc.value, err)
+			}
+
+			if m.Cents() != tc.expected {
+				t.Errorf("Parse(%q) = %d cents, expected %d", tc.value, m.Cents(), tc.expected)
+			}
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	parts := FromCents(10000).Split(3)
+
+	expected := []int64{3333, 3333, 3334}
+	total := Money{}
+	for i, part := range parts {
+		if part.Cents() != expected[i] {
+			t.Errorf("part %d = %d cents, expected %d", i, part.Cents(), expected[i])
+		}
+		total = total.Add(part)
+	}
+
+	if !total.Equal(FromCents(10000)) {
+		t.Errorf("parts add up to %s, expected 100.00", total)
+	}
+}
+
+func TestPercent(t *testing.T) {
+	testCases := []struct {
+		name       string
+		value      Money
+		percentage float64
+		expected   int64
+	}{
+		{
+			name:       "Exact percentage",
+			value:      FromCents(30000),
+			percentage: 2,
+			expected:   600,
+		},
+		{
+			name:       "Rounds half away from zero",
+			value:      FromCents(25),
+			percentage: 10,
+			expected:   3,
+		},
+		{
+			name:       "Fractional percentage",
+			value:      FromCents(30000),
+			percentage: 1.0 / 30 * 40,
+			expected:   400,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := tc.value.Percent(tc.percentage)
+			if result.Cents() != tc.expected {
+				t.Errorf("Percent(%v) = %d cents, expected %d", tc.percentage, result.Cents(), tc.expected)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    Money
+		expected string
+	}{
+		{
+			name:     "Cents only",
+			value:    FromCents(5),
+			expected: "R$ 0,05",
+		},
+		{
+			name:     "Thousands separator",
+			value:    FromCents(123450),
+			expected: "R$ 1.234,50",
+		},
+		{
+			name:     "Millions",
+			value:    FromCents(123456789),
+			expected: "R$ 1.234.567,89",
+		},
+		{
+			name:     "Negative amount",
+			value:    FromCents(-100000),
+			expected: "-R$ 1.000,00",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := tc.value.Format()
+			if result != tc.expected {
+				t.Errorf("Format() = %q, expected %q", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestJSON(t *testing.T) {
+	b, err := json.Marshal(FromCents(-123450))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(b) != `"-1234.50"` {
+		t.Errorf("Marshal = %s, expected \"-1234.50\"", b)
+	}
+
+	testCases := []struct {
+		name     string
+		payload  string
+		expected int64
+	}{
+		{name: "String payload", payload: `"1234.50"`, expected: 123450},
+		{name: "Number payload", payload: `0.1`, expected: 10},
+		{name: "Null payload", payload: `null`, expected: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var m Money
+			if err := json.Unmarshal([]byte(tc.payload), &m); err != nil {
+				t.Fatalf("Unmarshal(%s) unexpected error: %v", tc.payload, err)
+			}
+
+			if m.Cents() != tc.expected {
+				t.Errorf("Unmarshal(%s) = %d cents, expected %d", tc.payload, m.Cents(), tc.expected)
+			}
+		})
+	}
+}
+
+func TestScan(t *testing.T) {
+	testCases := []struct {
+		name     string
+		src      any
+		expected int64
+	}{
+		{name: "Numeric column", src: []byte("1050.25"), expected: 105025},
+		{name: "String", src: "0.30", expected: 30},
+		{name: "Integer", src: int64(7), expected: 700},
+		{name: "Null", src: nil, expected: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var m Money
+			if err := m.Scan(tc.src); err != nil {
+				t.Fatalf("Scan(%v) unexpected error: %v", tc.src, err)
+			}
+
+			if m.Cents() != tc.expected {
+				t.Errorf("Scan(%v) = %d cents, expected %d", tc.src, m.Cents(), tc.expected)
+			}
+		})
+	}
+
+	value, err := FromCents(105025).Value()
+	if err != nil || value != "1050.25" {
+		t.Errorf("Value() = %v, %v, expected 1050.25", value, err)
+	}
+}
@@ -0,0 +1,92 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// HTTPConfig is the endpoint and the token of a messaging gateway reached
+// over HTTP.
+type HTTPConfig struct {
+	URL   string
+	Token string
+}
+
+// SMSNotifier sends the messages through an SMS gateway, as
+// {"to": "...", "message": "..."}.
+type SMSNotifier struct {
+	config HTTPConfig
+	client *http.Client
+}
+
+func NewSMSNotifier(config HTTPConfig) *SMSNotifier {
+	return &SMSNotifier{config: config, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (s *SMSNotifier) Notify(ctx context.Context, msg Message) error {
+	if msg.To == "" {
+		return ErrNoRecipient
+	}
+
+	return postJSON(ctx, s.client, s.config, map[string]string{
+		"to":      msg.To,
+		"message": msg.Body,
+	})
+}
+
+// WhatsAppNotifier sends the messages as text through the WhatsApp Cloud
+// API, where URL is the messages endpoint of the sender phone number.
+type WhatsAppNotifier struct {
+	config HTTPConfig
+	client *http.Client
+}
+
+func NewWhatsAppNotifier(config HTTPConfig) *WhatsAppNotifier {
+	return &WhatsAppNotifier{config: config, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (w *WhatsAppNotifier) Notify(ctx context.Context, msg Message) error {
+	if msg.To == "" {
+		return ErrNoRecipient
+	}
+
+	return postJSON(ctx, w.client, w.config, map[string]any{
+		"messaging_product": "whatsapp",
+		"to":                msg.To,
+		"type":              "text",
+		"text":              map[string]string{"body": msg.Body},
+	})
+}
+
+func postJSON(ctx context.Context, client *http.Client, config HTTPConfig, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, config.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+config.Token)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("notify: gateway answered %d: %s", resp.StatusCode, bytes.TrimSpace(detail))
+	}
+
+	return nil
+}
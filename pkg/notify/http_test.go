@@ -0,0 +1,69 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPNotifiers(t *testing.T) {
+	testCases := []struct {
+		name     string
+		notifier func(config HTTPConfig) Notifier
+		expected string
+	}{
+		{
+			name:     "SMS gateway",
+			notifier: func(config HTTPConfig) Notifier { return NewSMSNotifier(config) },
+			expected: `{"message":"Sua parcela vence hoje","to":"+5571999990000"}`,
+		},
+		{
+			name:     "WhatsApp Cloud API",
+			notifier: func(config HTTPConfig) Notifier { return NewWhatsAppNotifier(config) },
+			expected: `{"messaging_product":"whatsapp","text":{"body":"Sua parcela vence hoje"},"to":"+5571999990000","type":"text"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var body, authorization string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var payload map[string]any
+				json.NewDecoder(r.Body).Decode(&payload)
+				encoded, _ := json.Marshal(payload)
+				body = string(encoded)
+				authorization = r.Header.Get("Authorization")
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			notifier := tc.notifier(HTTPConfig{URL: server.URL, Token: "secret"})
+			err := notifier.Notify(context.Background(), Message{To: "+5571999990000", Body: "Sua parcela vence hoje"})
+			if err != nil {
+				t.Fatalf("Notify() returned %v", err)
+			}
+
+			if body != tc.expected {
+				t.Errorf("Expected body %s, got %s", tc.expected, body)
+			}
+
+			if authorization != "Bearer secret" {
+				t.Errorf("Expected bearer token, got %q", authorization)
+			}
+		})
+	}
+}
+
+func TestHTTPNotifierGatewayError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid number", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	err := NewSMSNotifier(HTTPConfig{URL: server.URL}).Notify(context.Background(), Message{To: "123", Body: "hi"})
+	if err == nil || err.Error() != "notify: gateway answered 400: invalid number" {
+		t.Errorf("Expected gateway error, got %v", err)
+	}
+}
@@ -0,0 +1,85 @@
+// Package notify sends short messages to people through email, SMS or
+// WhatsApp. Every channel is a Notifier, so that callers do not depend on the
+// provider behind it.
+package notify
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+)
+
+var ErrNoRecipient = errors.New("notify: message without recipient")
+
+// Message is what is sent to a single recipient: an email address or a
+// phone number, depending on the channel. Subject is only used by email.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, msg Message) error
+}
+
+// LogNotifier only writes the messages to the log. It stands in for a channel
+// with no provider configured.
+type LogNotifier struct {
+	Channel string
+}
+
+func (l LogNotifier) Notify(ctx context.Context, msg Message) error {
+	if msg.To == "" {
+		return ErrNoRecipient
+	}
+
+	log.Printf("notify %s to %s: %s", l.Channel, msg.To, msg.Body)
+	return nil
+}
+
+// FakeNotifier keeps the messages in memory, or fails them when told to. It
+// stands in for a real channel in tests.
+type FakeNotifier struct {
+	mu       sync.Mutex
+	err      error
+	messages []Message
+}
+
+func NewFakeNotifier() *FakeNotifier {
+	return &FakeNotifier{}
+}
+
+// Fail makes the next messages fail with err, or be sent again when err is
+// nil.
+func (f *FakeNotifier) Fail(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.err = err
+}
+
+func (f *FakeNotifier) Notify(ctx context.Context, msg Message) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.err != nil {
+		return f.err
+	}
+
+	if msg.To == "" {
+		return ErrNoRecipient
+	}
+
+	f.messages = append(f.messages, msg)
+	return nil
+}
+
+// Messages returns the messages sent so far.
+func (f *FakeNotifier) Messages() []Message {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]Message(nil), f.messages...)
+}
@@ -0,0 +1,63 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+// SMTPNotifier sends the messages by email through an SMTP server. The
+// server is only authenticated against when a username is configured.
+type SMTPNotifier struct {
+	config SMTPConfig
+}
+
+func NewSMTPNotifier(config SMTPConfig) *SMTPNotifier {
+	return &SMTPNotifier{config: config}
+}
+
+func (s *SMTPNotifier) Notify(ctx context.Context, msg Message) error {
+	if msg.To == "" {
+		return ErrNoRecipient
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if s.config.Username != "" {
+		auth = smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)
+	}
+
+	addr := net.JoinHostPort(s.config.Host, s.config.Port)
+	return smtp.SendMail(addr, auth, s.config.From, []string{msg.To}, s.buildMail(msg))
+}
+
+func (s *SMTPNotifier) buildMail(msg Message) []byte {
+	var mail strings.Builder
+
+	fmt.Fprintf(&mail, "From: %s\r\n", s.config.From)
+	fmt.Fprintf(&mail, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&mail, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&mail, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	mail.WriteString("MIME-Version: 1.0\r\n")
+	mail.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	mail.WriteString("\r\n")
+	mail.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	mail.WriteString("\r\n")
+
+	return []byte(mail.String())
+}
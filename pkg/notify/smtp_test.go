@@ -0,0 +1,106 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+)
+
+// fakeSMTPServer speaks just enough SMTP to take one mail, which it sends to
+// the returned channel.
+func fakeSMTPServer(t *testing.T) (string, string, <-chan string) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	mails := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+		reply("220 localhost ESMTP")
+		var data strings.Builder
+		inData := false
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+
+			if inData {
+				if line == ".\r\n" {
+					inData = false
+					mails <- data.String()
+					reply("250 OK")
+					continue
+				}
+				data.WriteString(line)
+				continue
+			}
+
+			switch command := strings.ToUpper(strings.TrimSpace(line)); {
+			case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(command, "DATA"):
+				inData = true
+				reply("354 End data with <CR><LF>.<CR><LF>")
+			case strings.HasPrefix(command, "QUIT"):
+				reply("221 Bye")
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	return host, port, mails
+}
+
+func TestSMTPNotifier(t *testing.T) {
+	host, port, mails := fakeSMTPServer(t)
+	notifier := NewSMTPNotifier(SMTPConfig{Host: host, Port: port, From: "cobranca@example.com"})
+
+	err := notifier.Notify(context.Background(), Message{
+		To:      "cliente@example.com",
+		Subject: "Lembrete de cobrança",
+		Body:    "Olá João,\nsua parcela vence amanhã.",
+	})
+	if err != nil {
+		t.Fatalf("Notify() returned %v", err)
+	}
+
+	mail := <-mails
+	expected := []string{
+		"From: cobranca@example.com\r\n",
+		"To: cliente@example.com\r\n",
+		"Subject: =?utf-8?q?Lembrete_de_cobran=C3=A7a?=\r\n",
+		"Olá João,\r\nsua parcela vence amanhã.\r\n",
+	}
+	for _, part := range expected {
+		if !strings.Contains(mail, part) {
+			t.Errorf("mail %q does not contain %q", mail, part)
+		}
+	}
+}
+
+func TestSMTPNotifierWithoutRecipient(t *testing.T) {
+	notifier := NewSMTPNotifier(SMTPConfig{Host: "127.0.0.1", Port: "1"})
+
+	err := notifier.Notify(context.Background(), Message{Body: "hello"})
+	if err != ErrNoRecipient {
+		t.Errorf("Expected %v, got %v", ErrNoRecipient, err)
+	}
+}
@@ -0,0 +1,104 @@
+package pdf
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Widths of the printable ASCII characters, from space to tilde, in
+// thousandths of the font size, as in the font metrics of Adobe.
+var (
+	helveticaWidths = [95]int{
+		278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+		556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+		1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+		667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+		333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+		556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+	}
+
+	helveticaBoldWidths = [95]int{
+		278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+		556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+		975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+		667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+		333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+		611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+	}
+)
+
+// courierWidth is the width of every character of the Courier fonts.
+const courierWidth = 600
+
+// accents maps the accented letters of Portuguese to the letters they are
+// as wide as.
+var accents = map[rune]rune{
+	'á': 'a', 'à': 'a', 'â': 'a', 'ã': 'a', 'ä': 'a',
+	'é': 'e', 'ê': 'e', 'è': 'e', 'í': 'i', 'ì': 'i',
+	'ó': 'o', 'ô': 'o', 'õ': 'o', 'ò': 'o', 'ö': 'o',
+	'ú': 'u', 'ù': 'u', 'ü': 'u', 'ç': 'c',
+	'Á': 'A', 'À': 'A', 'Â': 'A', 'Ã': 'A', 'Ä': 'A',
+	'É': 'E', 'Ê': 'E', 'È': 'E', 'Í': 'I', 'Ì': 'I',
+	'Ó': 'O', 'Ô': 'O', 'Õ': 'O', 'Ò': 'O', 'Ö': 'O',
+	'Ú': 'U', 'Ù': 'U', 'Ü': 'U', 'Ç': 'C',
+}
+
+// TextWidth returns how wide the text is written with the font, in points.
+func TextWidth(font Font, size float64, text string) float64 {
+	total := 0
+	for _, r := range text {
+		total += charWidth(font, r)
+	}
+
+	return float64(total) * size / 1000
+}
+
+func charWidth(font Font, r rune) int {
+	if font == Courier || font == CourierBold {
+		return courierWidth
+	}
+
+	if base, ok := accents[r]; ok {
+		r = base
+	}
+
+	widths := &helveticaWidths
+	if font == HelveticaBold {
+		widths = &helveticaBoldWidths
+	}
+
+	if r >= ' ' && r <= '~' {
+		return widths[r-' ']
+	}
+
+	if unicode.IsUpper(r) {
+		return widths['O'-' ']
+	}
+
+	return widths['o'-' ']
+}
+
+// WrapText breaks the text in lines as wide as width at most, between words.
+// A word wider than width takes a line of its own.
+func WrapText(font Font, size float64, text string, width float64) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+
+		if line != "" && TextWidth(font, size, candidate) > width {
+			lines = append(lines, line)
+			candidate = word
+		}
+		line = candidate
+	}
+
+	if line != "" {
+		lines = append(lines, line)
+	}
+
+	return lines
+}
@@ -0,0 +1,281 @@
+// Package pdf writes simple PDF documents: A4 pages with text in the
+// standard fonts, lines, rectangles and images. Positions are in points
+// (1/72 inch) from the top left corner of the page.
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Font is one of the standard fonts every PDF reader has, so that no font is
+// embedded in the documents.
+type Font int
+
+const (
+	Helvetica Font = iota
+	HelveticaBold
+	Courier
+	CourierBold
+)
+
+var fontNames = [...]string{"Helvetica", "Helvetica-Bold", "Courier", "Courier-Bold"}
+
+// Document is a PDF document being written.
+type Document struct {
+	title  string
+	pages  []*Page
+	images []*imageObject
+}
+
+// Page is a page of the document. Its content is drawn in the order the
+// methods are called.
+type Page struct {
+	doc     *Document
+	content bytes.Buffer
+}
+
+type imageObject struct {
+	width, height int
+	data          []byte
+}
+
+func New() *Document {
+	return &Document{}
+}
+
+// SetTitle sets the title shown by the readers.
+func (d *Document) SetTitle(title string) {
+	d.title = title
+}
+
+func (d *Document) AddPage() *Page {
+	page := &Page{doc: d}
+	d.pages = append(d.pages, page)
+
+	return page
+}
+
+// Pages returns the pages added so far, in order.
+func (d *Document) Pages() []*Page {
+	return d.pages
+}
+
+// Text writes the text with its baseline at y.
+func (p *Page) Text(x, y float64, font Font, size float64, text string) {
+	fmt.Fprintf(&p.content, "BT /F%d %s Tf %s %s Td (%s) Tj ET\n",
+		font+1, num(size), num(x), num(PageHeight-y), escape(text))
+}
+
+// TextRight writes the text ending at x.
+func (p *Page) TextRight(x, y float64, font Font, size float64, text string) {
+	p.Text(x-TextWidth(font, size, text), y, font, size, text)
+}
+
+// Paragraph writes the text wrapped to the width, leading points apart, with
+// the baseline of the first line at y. It returns the baseline of the line
+// that would come next.
+func (p *Page) Paragraph(x, y, width float64, font Font, size, leading float64, text string) float64 {
+	for _, line := range WrapText(font, size, text, width) {
+		p.Text(x, y, font, size, line)
+		y += leading
+	}
+
+	return y
+}
+
+func (p *Page) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s m %s %s l S\n",
+		num(width), num(x1), num(PageHeight-y1), num(x2), num(PageHeight-y2))
+}
+
+// Rect strokes the rectangle with its top left corner at x, y.
+func (p *Page) Rect(x, y, w, h, width float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s %s %s re S\n",
+		num(width), num(x), num(PageHeight-y-h), num(w), num(h))
+}
+
+// FillRect fills the rectangle with a gray from 0, black, to 1, white.
+func (p *Page) FillRect(x, y, w, h, gray float64) {
+	fmt.Fprintf(&p.content, "q %s g %s %s %s %s re f Q\n",
+		num(gray), num(x), num(PageHeight-y-h), num(w), num(h))
+}
+
+// Image draws the image in gray scale, stretched to the rectangle.
+func (p *Page) Image(img image.Image, x, y, w, h float64) error {
+	object, err := newImageObject(img)
+	if err != nil {
+		return err
+	}
+
+	p.doc.images = append(p.doc.images, object)
+	fmt.Fprintf(&p.content, "q %s 0 0 %s %s %s cm /Im%d Do Q\n",
+		num(w), num(h), num(x), num(PageHeight-y-h), len(p.doc.images))
+
+	return nil
+}
+
+// Bytes writes the document.
+func (d *Document) Bytes() []byte {
+	var w writer
+	w.buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Objects 1 and 2 are the catalog and the page tree, followed by the
+	// resources, the fonts, the images and then the pages with their
+	// contents.
+	resources := 3
+	firstFont := resources + 1
+	firstImage := firstFont + len(fontNames)
+	firstPage := firstImage + len(d.images)
+	info := firstPage + 2*len(d.pages)
+
+	w.object("<< /Type /Catalog /Pages 2 0 R >>")
+
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+2*i)
+	}
+	w.object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+
+	var fonts, xObjects strings.Builder
+	for i := range fontNames {
+		fmt.Fprintf(&fonts, " /F%d %d 0 R", i+1, firstFont+i)
+	}
+	for i := range d.images {
+		fmt.Fprintf(&xObjects, " /Im%d %d 0 R", i+1, firstImage+i)
+	}
+	w.object(fmt.Sprintf("<< /Font <<%s >> /XObject <<%s >> >>", fonts.String(), xObjects.String()))
+
+	for _, name := range fontNames {
+		w.object(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", name))
+	}
+
+	for _, img := range d.images {
+		w.stream(fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceGray /BitsPerComponent 8 /Filter /FlateDecode",
+			img.width, img.height), img.data)
+	}
+
+	for i, page := range d.pages {
+		w.object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources %d 0 R /Contents %d 0 R >>",
+			num(PageWidth), num(PageHeight), resources, firstPage+2*i+1))
+		w.stream("", page.content.Bytes())
+	}
+
+	w.object(fmt.Sprintf("<< /Title (%s) /Producer (quem-me-deve) >>", escape(d.title)))
+
+	xref := w.buf.Len()
+	fmt.Fprintf(&w.buf, "xref\n0 %d\n0000000000 65535 f \n", len(w.offsets)+1)
+	for _, offset := range w.offsets {
+		fmt.Fprintf(&w.buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&w.buf, "trailer\n<< /Size %d /Root 1 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(w.offsets)+1, info, xref)
+
+	return w.buf.Bytes()
+}
+
+// WriteTo writes the document to out.
+func (d *Document) WriteTo(out io.Writer) (int64, error) {
+	n, err := out.Write(d.Bytes())
+	return int64(n), err
+}
+
+// writer numbers the objects in the order they are written, keeping where
+// each one starts for the cross reference table.
+type writer struct {
+	buf     bytes.Buffer
+	offsets []int
+}
+
+func (w *writer) object(body string) {
+	w.offsets = append(w.offsets, w.buf.Len())
+	fmt.Fprintf(&w.buf, "%d 0 obj\n%s\nendobj\n", len(w.offsets), body)
+}
+
+func (w *writer) stream(dict string, data []byte) {
+	w.offsets = append(w.offsets, w.buf.Len())
+	fmt.Fprintf(&w.buf, "%d 0 obj\n<< %s /Length %d >>\nstream\n", len(w.offsets), dict, len(data))
+	w.buf.Write(data)
+	w.buf.WriteString("\nendstream\nendobj\n")
+}
+
+func newImageObject(img image.Image) (*imageObject, error) {
+	bounds := img.Bounds()
+
+	var data bytes.Buffer
+	z := zlib.NewWriter(&data)
+	row := make([]byte, bounds.Dx())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			row[x-bounds.Min.X] = color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y
+		}
+		if _, err := z.Write(row); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := z.Close(); err != nil {
+		return nil, err
+	}
+
+	return &imageObject{width: bounds.Dx(), height: bounds.Dy(), data: data.Bytes()}, nil
+}
+
+// num writes a number with up to two decimals, as PDF operands.
+func num(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+}
+
+// escape writes the text as the body of a PDF string in WinAnsiEncoding.
+// Characters out of the encoding are written as "?".
+func escape(text string) string {
+	var out strings.Builder
+	for _, r := range text {
+		b, ok := winAnsi(r)
+		if !ok {
+			b = '?'
+		}
+
+		switch b {
+		case '(', ')', '\\':
+			out.WriteByte('\\')
+			out.WriteByte(b)
+		case '\n', '\r':
+			out.WriteByte(' ')
+		default:
+			out.WriteByte(b)
+		}
+	}
+
+	return out.String()
+}
+
+// winAnsiExtra holds the characters of WinAnsiEncoding out of Latin-1.
+var winAnsiExtra = map[rune]byte{
+	'€': 0x80, '…': 0x85, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94,
+	'•': 0x95, '–': 0x96, '—': 0x97,
+}
+
+func winAnsi(r rune) (byte, bool) {
+	if b, ok := winAnsiExtra[r]; ok {
+		return b, true
+	}
+
+	if r < 0x80 || (r >= 0xA0 && r <= 0xFF) {
+		return byte(r), true
+	}
+
+	return 0, false
+}
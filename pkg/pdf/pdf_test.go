@@ -0,0 +1,148 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// checkStructure checks that the cross reference table points to every
+// object of the document and that the trailer points to the table.
+func checkStructure(t *testing.T, doc []byte) {
+	t.Helper()
+
+	if !bytes.HasPrefix(doc, []byte("%PDF-1.4\n")) {
+		t.Fatalf("document starts with %q", doc[:9])
+	}
+
+	if !bytes.HasSuffix(doc, []byte("%%EOF\n")) {
+		t.Fatal("document does not end with the EOF marker")
+	}
+
+	match := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(doc)
+	if match == nil {
+		t.Fatal("startxref not found")
+	}
+
+	xref, _ := strconv.Atoi(string(match[1]))
+	if !bytes.HasPrefix(doc[xref:], []byte("xref\n")) {
+		t.Fatalf("startxref %d does not point to the xref table", xref)
+	}
+
+	lines := strings.Split(string(doc[xref:]), "\n")
+	var count int
+	fmt.Sscanf(lines[1], "0 %d", &count)
+
+	for i := 1; i < count; i++ {
+		var offset int
+		fmt.Sscanf(lines[2+i], "%d", &offset)
+
+		want := fmt.Sprintf("%d 0 obj\n", i)
+		if !bytes.HasPrefix(doc[offset:], []byte(want)) {
+			t.Errorf("object %d: offset %d points to %q", i, offset, doc[offset:offset+10])
+		}
+	}
+
+	if !bytes.Contains(doc, []byte(fmt.Sprintf("/Size %d", count))) {
+		t.Errorf("trailer without /Size %d", count)
+	}
+}
+
+func TestDocument(t *testing.T) {
+	doc := New()
+	doc.SetTitle("Recibo (teste)")
+
+	page := doc.AddPage()
+	page.Text(40, 50, HelveticaBold, 14, "Recibo nº 1")
+	page.TextRight(555, 50, Courier, 10, "R$ 10,00")
+	page.Line(40, 60, 555, 60, 0.5)
+	page.Rect(40, 70, 100, 20, 1)
+	page.FillRect(40, 100, 100, 20, 0.9)
+
+	img := image.NewGray(image.Rect(0, 0, 2, 2))
+	img.Set(0, 0, color.White)
+	if err := page.Image(img, 40, 130, 20, 20); err != nil {
+		t.Fatalf("Image() error = %v", err)
+	}
+
+	doc.AddPage().Text(40, 50, Helvetica, 10, "Página 2")
+
+	out := doc.Bytes()
+	checkStructure(t, out)
+
+	for _, want := range []string{
+		"/Count 2",
+		"/BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding",
+		"/Title (Recibo \\(teste\\))",
+		"BT /F2 14 Tf 40 791.89 Td (Recibo n\xba 1) Tj ET",
+		"BT /F3 10 Tf 507 791.89 Td (R$ 10,00) Tj ET",
+		"0.5 w 40 781.89 m 555 781.89 l S",
+		"1 w 40 751.89 100 20 re S",
+		"q 0.9 g 40 721.89 100 20 re f Q",
+		"/Width 2 /Height 2 /ColorSpace /DeviceGray",
+		"q 20 0 0 20 40 691.89 cm /Im1 Do Q",
+		"(P\xe1gina 2) Tj",
+	} {
+		if !bytes.Contains(out, []byte(want)) {
+			t.Errorf("document without %q", want)
+		}
+	}
+}
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"plain", "plain"},
+		{`a (b) \c`, `a \(b\) \\c`},
+		{"São João", "S\xe3o Jo\xe3o"},
+		{"R$ 1,00 – € 2", "R$ 1,00 \x96 \x80 2"},
+		{"linha\nnova", "linha nova"},
+		{"日本", "??"},
+	}
+
+	for _, tt := range tests {
+		if got := escape(tt.text); got != tt.want {
+			t.Errorf("escape(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestTextWidth(t *testing.T) {
+	tests := []struct {
+		font Font
+		text string
+		want float64
+	}{
+		{Courier, "R$ 10,00", 8 * 6},
+		{Helvetica, "Hello", (722 + 556 + 222 + 222 + 556) / 100.0},
+		{HelveticaBold, "Hello", (722 + 556 + 278 + 278 + 611) / 100.0},
+		{Helvetica, "ação", (556 + 500 + 556 + 556) / 100.0},
+	}
+
+	for _, tt := range tests {
+		if got := TextWidth(tt.font, 10, tt.text); got != tt.want {
+			t.Errorf("TextWidth(%d, %q) = %v, want %v", tt.font, tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	// Courier is 6 points wide at size 10, so 10 characters fit in 60 points.
+	got := WrapText(Courier, 10, "recebi a quantia  de cem reais extraordinariamente", 60)
+	want := []string{"recebi a", "quantia de", "cem reais", "extraordinariamente"}
+
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("WrapText() = %q, want %q", got, want)
+	}
+
+	if got := WrapText(Helvetica, 10, "   ", 60); len(got) != 0 {
+		t.Errorf("WrapText() of blank text = %q", got)
+	}
+}
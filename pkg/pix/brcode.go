@@ -0,0 +1,255 @@
+// Package pix writes Pix charges as BR Codes, the EMV merchant presented QR
+// code payload (EMV QRCPS-MPM) defined by the Banco Central do Brasil, that
+// is printed as a QR code or pasted in the bank app ("Pix copia e cola").
+package pix
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/qrcode"
+)
+
+const (
+	gui = "br.gov.bcb.pix"
+
+	MaxMerchantName = 25
+	MaxMerchantCity = 15
+	MaxTxId         = 25
+
+	// noTxId is the reference label of the payloads without a txid of their
+	// own, the dynamic ones included: their txid is kept by the PSP.
+	noTxId = "***"
+)
+
+// Field ids of the payload, in the order they are written.
+const (
+	idPayloadFormat    = "00"
+	idInitiationMethod = "01"
+	idMerchantAccount  = "26"
+	idCategoryCode     = "52"
+	idCurrency         = "53"
+	idAmount           = "54"
+	idCountryCode      = "58"
+	idMerchantName     = "59"
+	idMerchantCity     = "60"
+	idAdditionalData   = "62"
+	idCRC              = "63"
+	idAccountGUI       = "00"
+	idAccountKey       = "01"
+	idAccountInfo      = "02"
+	idAccountURL       = "25"
+	idAdditionalTxId   = "05"
+)
+
+const (
+	payloadFormat = "01"
+	singleUse     = "12"
+	categoryCode  = "0000"
+	currencyBRL   = "986"
+	countryCode   = "BR"
+	// crcField opens the CRC, which covers the payload up to its own length.
+	crcField = idCRC + "04"
+)
+
+var (
+	ErrNoReceiver = errors.New("pix: either the key or the URL of the charge is required")
+	ErrTxId       = errors.New("pix: the txid must have up to 25 letters and digits")
+	ErrAmount     = errors.New("pix: the amount can not be negative")
+
+	txIdPattern = regexp.MustCompile(`^[A-Za-z0-9]{1,25}$`)
+)
+
+// Payload is a Pix charge. A static one carries the key of the receiver and
+// the txid; a dynamic one carries instead the URL where the PSP serves the
+// charge, written without the scheme.
+type Payload struct {
+	Key          string
+	URL          string
+	MerchantName string
+	MerchantCity string
+	// Amount left zero is typed by the payer.
+	Amount      money.Money
+	TxId        string
+	Description string
+}
+
+// Encode writes the payload followed by its CRC16.
+func (p Payload) Encode() (string, error) {
+	if err := p.validate(); err != nil {
+		return "", err
+	}
+
+	account, err := p.merchantAccount()
+	if err != nil {
+		return "", err
+	}
+
+	txId := noTxId
+	if p.URL == "" && p.TxId != "" {
+		txId = p.TxId
+	}
+
+	var fields tlv
+	fields.add(idPayloadFormat, payloadFormat)
+	if p.URL != "" {
+		fields.add(idInitiationMethod, singleUse)
+	}
+	fields.add(idMerchantAccount, account)
+	fields.add(idCategoryCode, categoryCode)
+	fields.add(idCurrency, currencyBRL)
+	if p.Amount.IsPositive() {
+		fields.add(idAmount, p.Amount.String())
+	}
+	fields.add(idCountryCode, countryCode)
+	fields.add(idMerchantName, normalize(p.MerchantName))
+	fields.add(idMerchantCity, normalize(p.MerchantCity))
+	fields.add(idAdditionalData, tlvOf(idAdditionalTxId, txId))
+
+	if fields.err != nil {
+		return "", fields.err
+	}
+
+	payload := fields.String() + crcField
+
+	return payload + fmt.Sprintf("%04X", CRC16([]byte(payload))), nil
+}
+
+// QRCode encodes the payload as a PNG image with scale pixels per module.
+func QRCode(payload string, scale int) ([]byte, error) {
+	code, err := qrcode.Encode([]byte(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	return code.PNG(scale)
+}
+
+func (p Payload) validate() error {
+	if (p.Key == "") == (p.URL == "") {
+		return ErrNoReceiver
+	}
+
+	if p.Key != "" {
+		if _, err := ParseKey(p.Key); err != nil {
+			return err
+		}
+	}
+
+	if p.TxId != "" && p.TxId != noTxId && !txIdPattern.MatchString(p.TxId) {
+		return ErrTxId
+	}
+
+	if p.Amount.IsNegative() {
+		return ErrAmount
+	}
+
+	return ValidateMerchant(p.MerchantName, p.MerchantCity)
+}
+
+// ValidateMerchant checks the name and the city of the receiver against the
+// sizes of the payload, accents apart.
+func ValidateMerchant(name, city string) error {
+	name, city = normalize(name), normalize(city)
+
+	if name == "" || len(name) > MaxMerchantName {
+		return fmt.Errorf("pix: the merchant name must have from 1 to %d characters", MaxMerchantName)
+	}
+
+	if city == "" || len(city) > MaxMerchantCity {
+		return fmt.Errorf("pix: the merchant city must have from 1 to %d characters", MaxMerchantCity)
+	}
+
+	return nil
+}
+
+func (p Payload) merchantAccount() (string, error) {
+	var account tlv
+	account.add(idAccountGUI, gui)
+
+	if p.URL != "" {
+		account.add(idAccountURL, p.URL)
+	} else {
+		account.add(idAccountKey, p.Key)
+		if p.Description != "" {
+			account.add(idAccountInfo, p.Description)
+		}
+	}
+
+	return account.String(), account.err
+}
+
+// normalize writes the text with the plain letters the payload allows.
+func normalize(text string) string {
+	var result strings.Builder
+	for _, r := range strings.TrimSpace(text) {
+		if plain, ok := accents[r]; ok {
+			r = plain
+		}
+		if r < 0x20 || r > 0x7E {
+			continue
+		}
+		result.WriteRune(r)
+	}
+
+	return result.String()
+}
+
+var accents = map[rune]rune{
+	'á': 'a', 'à': 'a', 'â': 'a', 'ã': 'a', 'ä': 'a',
+	'Á': 'A', 'À': 'A', 'Â': 'A', 'Ã': 'A', 'Ä': 'A',
+	'é': 'e', 'è': 'e', 'ê': 'e', 'ë': 'e',
+	'É': 'E', 'È': 'E', 'Ê': 'E', 'Ë': 'E',
+	'í': 'i', 'ì': 'i', 'î': 'i', 'ï': 'i',
+	'Í': 'I', 'Ì': 'I', 'Î': 'I', 'Ï': 'I',
+	'ó': 'o', 'ò': 'o', 'ô': 'o', 'õ': 'o', 'ö': 'o',
+	'Ó': 'O', 'Ò': 'O', 'Ô': 'O', 'Õ': 'O', 'Ö': 'O',
+	'ú': 'u', 'ù': 'u', 'û': 'u', 'ü': 'u',
+	'Ú': 'U', 'Ù': 'U', 'Û': 'U', 'Ü': 'U',
+	'ç': 'c', 'Ç': 'C', 'ñ': 'n', 'Ñ': 'N',
+}
+
+// tlv writes the fields as id, two digit length and value. The first value
+// longer than 99 characters is kept as the error.
+type tlv struct {
+	strings.Builder
+	err error
+}
+
+func (t *tlv) add(id, value string) {
+	if len(value) > 99 {
+		if t.err == nil {
+			t.err = fmt.Errorf("pix: field %s longer than 99 characters", id)
+		}
+		return
+	}
+
+	fmt.Fprintf(t, "%s%02d%s", id, len(value), value)
+}
+
+func tlvOf(id, value string) string {
+	var field tlv
+	field.add(id, value)
+	return field.String()
+}
+
+// CRC16 is the CRC-16/CCITT-FALSE of the payload: polynomial 0x1021 and
+// initial value 0xFFFF.
+func CRC16(data []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for range 8 {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return crc
+}
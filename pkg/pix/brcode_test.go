@@ -0,0 +1,188 @@
+package pix
+
+import (
+	"bytes"
+	"image/png"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+)
+
+func TestCRC16(t *testing.T) {
+	if got := CRC16([]byte("123456789")); got != 0x29B1 {
+		t.Errorf("CRC16() = %04X, want 29B1", got)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload Payload
+		want    string
+	}{
+		{
+			name: "static without amount, example of the BR Code manual",
+			payload: Payload{
+				Key:          "123e4567-e12b-12d1-a456-426655440000",
+				MerchantName: "Fulano de Tal",
+				MerchantCity: "BRASILIA",
+			},
+			want: "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D",
+		},
+		{
+			name: "static with amount, txid and description",
+			payload: Payload{
+				Key:          "+5571999990000",
+				MerchantName: "José Conceição",
+				MerchantCity: "Salvador",
+				Amount:       money.FromCents(12345),
+				TxId:         "1JQ8X9V2C3B4N5M6K7L8P9R0S",
+				Description:  "Parcela 1",
+			},
+			want: "00020126490014br.gov.bcb.pix0114+55719999900000209Parcela 1" +
+				"52040000530398654061" + "23.455802BR5914Jose Conceicao6008Salvador" +
+				"62290525" + "1JQ8X9V2C3B4N5M6K7L8P9R0S6304",
+		},
+		{
+			name: "dynamic",
+			payload: Payload{
+				URL:          "pix.example.com/qr/v2/cobv/9d36b84f",
+				MerchantName: "Fulano de Tal",
+				MerchantCity: "BRASILIA",
+				Amount:       money.FromCents(1000),
+				TxId:         "ignored",
+			},
+			want: "000201010212" + "26570014br.gov.bcb.pix2535pix.example.com/qr/v2/cobv/9d36b84f" +
+				"520400005303986540510.005802BR5913Fulano de Tal6008BRASILIA62070503***6304",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.payload.Encode()
+			if err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+
+			if !strings.HasPrefix(got, tt.want) {
+				t.Errorf("Encode() = %q, want prefix %q", got, tt.want)
+			}
+
+			body, crc := got[:len(got)-4], got[len(got)-4:]
+			want, _ := strconv.ParseUint(crc, 16, 16)
+			if uint16(want) != CRC16([]byte(body)) {
+				t.Errorf("Encode() CRC = %s, want %04X", crc, CRC16([]byte(body)))
+			}
+
+			fields, err := parseTLV(body[:len(body)-4])
+			if err != nil {
+				t.Fatalf("Encode() wrote an invalid TLV: %v", err)
+			}
+
+			if fields[idCountryCode] != countryCode {
+				t.Errorf("Encode() country = %q, want %q", fields[idCountryCode], countryCode)
+			}
+		})
+	}
+}
+
+func TestEncodeRefusesInvalidPayload(t *testing.T) {
+	valid := Payload{Key: "maria@example.com", MerchantName: "Maria", MerchantCity: "Salvador"}
+
+	tests := []struct {
+		name   string
+		change func(p *Payload)
+	}{
+		{"without key or URL", func(p *Payload) { p.Key = "" }},
+		{"with key and URL", func(p *Payload) { p.URL = "pix.example.com/cob/1" }},
+		{"invalid key", func(p *Payload) { p.Key = "Maria@Example" }},
+		{"long txid", func(p *Payload) { p.TxId = strings.Repeat("A", 26) }},
+		{"txid with symbols", func(p *Payload) { p.TxId = "abc-123" }},
+		{"negative amount", func(p *Payload) { p.Amount = money.FromCents(-1) }},
+		{"long merchant name", func(p *Payload) { p.MerchantName = strings.Repeat("a", 26) }},
+		{"without city", func(p *Payload) { p.MerchantCity = " " }},
+		{"long description", func(p *Payload) { p.Description = strings.Repeat("a", 80) }},
+	}
+
+	if _, err := valid.Encode(); err != nil {
+		t.Fatalf("Encode() of the valid payload error = %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := valid
+			tt.change(&payload)
+
+			if _, err := payload.Encode(); err == nil {
+				t.Errorf("Encode() error = nil, want an error")
+			}
+		})
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	tests := []struct {
+		key     string
+		want    KeyType
+		wantErr bool
+	}{
+		{"52998224725", CPFKey, false},
+		{"52998224726", "", true},
+		{"11222333000181", CNPJKey, false},
+		{"+5571999990000", PhoneKey, false},
+		{"71999990000", "", true},
+		{"maria@example.com", EmailKey, false},
+		{"Maria@example.com", "", true},
+		{"123e4567-e12b-12d1-a456-426655440000", RandomKey, false},
+		{"123E4567-E12B-12D1-A456-426655440000", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseKey(tt.key)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseKey(%q) error = %v, wantErr %v", tt.key, err, tt.wantErr)
+		}
+
+		if got != tt.want {
+			t.Errorf("ParseKey(%q) = %q, want %q", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestQRCode(t *testing.T) {
+	payload, err := Payload{Key: "maria@example.com", MerchantName: "Maria", MerchantCity: "Salvador"}.Encode()
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	image, err := QRCode(payload, 4)
+	if err != nil {
+		t.Fatalf("QRCode() error = %v", err)
+	}
+
+	if _, err := png.Decode(bytes.NewReader(image)); err != nil {
+		t.Errorf("QRCode() is not a PNG: %v", err)
+	}
+}
+
+func parseTLV(payload string) (map[string]string, error) {
+	fields := make(map[string]string)
+	for len(payload) > 0 {
+		if len(payload) < 4 {
+			return nil, strconv.ErrSyntax
+		}
+
+		length, err := strconv.Atoi(payload[2:4])
+		if err != nil || len(payload) < 4+length {
+			return nil, strconv.ErrSyntax
+		}
+
+		fields[payload[:2]] = payload[4 : 4+length]
+		payload = payload[4+length:]
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,61 @@
+package pix
+
+import (
+	"errors"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/document"
+)
+
+// KeyType is the kind of a Pix key (chave) in the DICT.
+type KeyType string
+
+const (
+	CPFKey    KeyType = "cpf"
+	CNPJKey   KeyType = "cnpj"
+	PhoneKey  KeyType = "phone"
+	EmailKey  KeyType = "email"
+	RandomKey KeyType = "random"
+)
+
+var ErrInvalidKey = errors.New("pix: invalid key")
+
+var (
+	phoneKey  = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+	randomKey = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+	digitsKey = regexp.MustCompile(`^\d+$`)
+)
+
+const maxEmailKey = 77
+
+// ParseKey tells the type of the key, written as the DICT keeps it: only the
+// digits of a CPF or CNPJ, the phone with + and the country code, the email
+// in lower case and the random key (EVP) as a lower case UUID.
+func ParseKey(key string) (KeyType, error) {
+	switch {
+	case phoneKey.MatchString(key):
+		return PhoneKey, nil
+	case randomKey.MatchString(key):
+		return RandomKey, nil
+	case digitsKey.MatchString(key) && len(key) == 11:
+		if document.ValidateCPF(key) != nil {
+			return "", ErrInvalidKey
+		}
+		return CPFKey, nil
+	case digitsKey.MatchString(key) && len(key) == 14:
+		if document.ValidateCNPJ(key) != nil {
+			return "", ErrInvalidKey
+		}
+		return CNPJKey, nil
+	case strings.Contains(key, "@"):
+		address, err := mail.ParseAddress(key)
+		if err != nil || address.Address != key || len(key) > maxEmailKey || strings.ToLower(key) != key {
+			return "", ErrInvalidKey
+		}
+		return EmailKey, nil
+	}
+
+	return "", ErrInvalidKey
+}
@@ -0,0 +1,73 @@
+package pix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+)
+
+// FakePSP posts signed webhook notifications of Pix payments, the way a PSP
+// does once a charge is paid. It stands in for a real PSP in tests and
+// development.
+type FakePSP struct {
+	mu     sync.Mutex
+	url    string
+	secret []byte
+	client *http.Client
+	paid   int
+}
+
+func NewFakePSP(url string, secret []byte) *FakePSP {
+	return &FakePSP{url: url, secret: secret, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// Pay notifies a new payment of amount to the charge with the txid and
+// returns it, so that it can be delivered again.
+func (f *FakePSP) Pay(ctx context.Context, txId string, amount money.Money) (Received, error) {
+	f.mu.Lock()
+	f.paid++
+	now := time.Now().UTC()
+	payment := Received{
+		EndToEndId: fmt.Sprintf("E00000000%s%011d", now.Format("200601021504"), f.paid),
+		TxId:       txId,
+		Amount:     amount,
+		PaidAt:     now,
+	}
+	f.mu.Unlock()
+
+	return payment, f.Deliver(ctx, payment)
+}
+
+// Deliver posts the notification of the payments, signed with the secret.
+func (f *FakePSP) Deliver(ctx context.Context, payments ...Received) error {
+	body, err := json.Marshal(Webhook{Pix: payments})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(f.secret, body))
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("pix: webhook answered %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package pix
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+)
+
+// SignatureHeader carries the HMAC-SHA256 of the body of the webhook
+// notifications, written as "sha256=" followed by the hex digest.
+const SignatureHeader = "X-Pix-Signature"
+
+const signaturePrefix = "sha256="
+
+// Webhook is the notification a PSP posts once Pix charges are paid, in the
+// layout of the Pix API of the Banco Central do Brasil.
+type Webhook struct {
+	Pix []Received `json:"pix"`
+}
+
+// Received is a Pix payment notified by the PSP. EndToEndId identifies the
+// transfer across every institution, and the same payment keeps it when the
+// notification is delivered again.
+type Received struct {
+	EndToEndId string      `json:"endToEndId"`
+	TxId       string      `json:"txid"`
+	Amount     money.Money `json:"valor"`
+	PaidAt     time.Time   `json:"horario"`
+}
+
+// Sign returns the signature of the body with the secret shared with the
+// PSP.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether the signature was made from the body with
+// the secret. Nothing is verified without a secret.
+func VerifySignature(secret, body []byte, signature string) bool {
+	if len(secret) == 0 || !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
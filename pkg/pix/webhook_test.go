@@ -0,0 +1,104 @@
+package pix
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/henriquerocha2004/quem-me-deve-api/pkg/money"
+)
+
+func TestSign(t *testing.T) {
+	// HMAC-SHA256 test case 2 of RFC 4231.
+	got := Sign([]byte("Jefe"), []byte("what do ya want for nothing?"))
+	want := "sha256=5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"
+
+	if got != want {
+		t.Errorf("Sign() = %s, want %s", got, want)
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	secret := []byte("secret")
+	body := []byte(`{"pix":[]}`)
+	signature := Sign(secret, body)
+
+	tests := []struct {
+		name      string
+		secret    []byte
+		body      []byte
+		signature string
+		want      bool
+	}{
+		{"valid", secret, body, signature, true},
+		{"tampered body", secret, []byte(`{"pix":[{}]}`), signature, false},
+		{"other secret", []byte("other"), body, signature, false},
+		{"without prefix", secret, body, signature[len(signaturePrefix):], false},
+		{"without signature", secret, body, "", false},
+		{"without secret", nil, body, Sign(nil, body), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifySignature(tt.secret, tt.body, tt.signature); got != tt.want {
+				t.Errorf("VerifySignature() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFakePSP(t *testing.T) {
+	secret := []byte("secret")
+
+	var delivered []Webhook
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !VerifySignature(secret, body, r.Header.Get(SignatureHeader)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var webhook Webhook
+		if err := json.Unmarshal(body, &webhook); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		delivered = append(delivered, webhook)
+	}))
+	defer server.Close()
+
+	psp := NewFakePSP(server.URL, secret)
+
+	payment, err := psp.Pay(context.Background(), "TX1", money.MustParse("10.50"))
+	if err != nil {
+		t.Fatalf("Pay() error = %v", err)
+	}
+
+	if len(payment.EndToEndId) != 32 {
+		t.Errorf("EndToEndId = %q, want 32 characters", payment.EndToEndId)
+	}
+
+	if err := psp.Deliver(context.Background(), payment); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+
+	if len(delivered) != 2 {
+		t.Fatalf("delivered %d notifications, want 2", len(delivered))
+	}
+
+	for _, webhook := range delivered {
+		got := webhook.Pix[0]
+		if got.EndToEndId != payment.EndToEndId || got.TxId != "TX1" || !got.Amount.Equal(money.MustParse("10.50")) {
+			t.Errorf("delivered %+v, want %+v", got, payment)
+		}
+	}
+
+	other := NewFakePSP(server.URL, []byte("other"))
+	if _, err := other.Pay(context.Background(), "TX2", money.MustParse("1.00")); err == nil {
+		t.Error("Pay() with another secret error = nil, want rejected")
+	}
+}
@@ -0,0 +1,148 @@
+package qrcode
+
+// eccCodewordsPerBlock and eccBlocks are the error correction layout of the
+// medium level, by version.
+var eccCodewordsPerBlock = [maxVersion + 1]int{
+	0, 10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26,
+	26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
+}
+
+var eccBlocks = [maxVersion + 1]int{
+	0, 1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16,
+	17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49,
+}
+
+// rawDataModules counts the modules left for data and error correction once
+// the function patterns are drawn.
+func rawDataModules(version int) int {
+	result := (16*version+128)*version + 64
+	if version >= 2 {
+		numAlign := version/7 + 2
+		result -= (25*numAlign-10)*numAlign - 55
+		if version >= 7 {
+			result -= 36
+		}
+	}
+
+	return result
+}
+
+func dataCodewords(version int) int {
+	return rawDataModules(version)/8 - eccCodewordsPerBlock[version]*eccBlocks[version]
+}
+
+// countBits is the size of the character count of the byte mode.
+func countBits(version int) int {
+	if version < 10 {
+		return 8
+	}
+
+	return 16
+}
+
+func fitVersion(length int) (int, error) {
+	for version := minVersion; version <= maxVersion; version++ {
+		if 4+countBits(version)+length*8 <= dataCodewords(version)*8 {
+			return version, nil
+		}
+	}
+
+	return 0, ErrTooLong
+}
+
+func alignmentPositions(version int) []int {
+	if version == 1 {
+		return nil
+	}
+
+	numAlign := version/7 + 2
+	step := (version*8 + numAlign*3 + 5) / (numAlign*4 - 4) * 2
+	size := version*4 + 17
+
+	positions := make([]int, numAlign)
+	positions[0] = 6
+	for i, pos := numAlign-1, size-7; i >= 1; i, pos = i-1, pos-step {
+		positions[i] = pos
+	}
+
+	return positions
+}
+
+// encodeData writes the byte mode segment, the terminator and the padding
+// up to the data capacity of the version.
+func encodeData(version int, data []byte) []byte {
+	var bits bitBuffer
+	bits.append(0b0100, 4)
+	bits.append(len(data), countBits(version))
+	for _, b := range data {
+		bits.append(int(b), 8)
+	}
+
+	capacity := dataCodewords(version) * 8
+	bits.append(0, min(4, capacity-len(bits)))
+	bits.append(0, (8-len(bits)%8)%8)
+
+	for pad := 0xEC; len(bits) < capacity; pad ^= 0xEC ^ 0x11 {
+		bits.append(pad, 8)
+	}
+
+	return bits.bytes()
+}
+
+// addEccAndInterleave splits the data in the blocks of the version, adds
+// the error correction of each block and interleaves them.
+func addEccAndInterleave(version int, data []byte) []byte {
+	numBlocks := eccBlocks[version]
+	blockEccLen := eccCodewordsPerBlock[version]
+	rawCodewords := rawDataModules(version) / 8
+	numShortBlocks := numBlocks - rawCodewords%numBlocks
+	shortBlockLen := rawCodewords / numBlocks
+
+	divisor := reedSolomonDivisor(blockEccLen)
+	blocks := make([][]byte, numBlocks)
+	for i, k := 0, 0; i < numBlocks; i++ {
+		length := shortBlockLen - blockEccLen
+		if i >= numShortBlocks {
+			length++
+		}
+
+		block := append([]byte{}, data[k:k+length]...)
+		k += length
+		ecc := reedSolomonRemainder(block, divisor)
+		if i < numShortBlocks {
+			block = append(block, 0)
+		}
+		blocks[i] = append(block, ecc...)
+	}
+
+	result := make([]byte, 0, rawCodewords)
+	for i := range blocks[0] {
+		for j, block := range blocks {
+			// The padding byte of the short blocks is not a codeword.
+			if i != shortBlockLen-blockEccLen || j >= numShortBlocks {
+				result = append(result, block[i])
+			}
+		}
+	}
+
+	return result
+}
+
+type bitBuffer []bool
+
+func (b *bitBuffer) append(value, length int) {
+	for i := length - 1; i >= 0; i-- {
+		*b = append(*b, (value>>i)&1 != 0)
+	}
+}
+
+func (b bitBuffer) bytes() []byte {
+	result := make([]byte, len(b)/8)
+	for i, set := range b {
+		if set {
+			result[i/8] |= 1 << (7 - i%8)
+		}
+	}
+
+	return result
+}
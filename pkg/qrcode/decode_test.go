@@ -0,0 +1,136 @@
+package qrcode
+
+import (
+	"errors"
+	"fmt"
+)
+
+// decode reads the data back from the modules of a code, checking the
+// format information and the error correction of every block, so that the
+// tests cover what a scanner does.
+func decode(code *Code) ([]byte, error) {
+	format := 0
+	for i := 14; i >= 9; i-- {
+		format = format<<1 | boolBit(code.Dark(14-i, 8))
+	}
+	format = format<<1 | boolBit(code.Dark(7, 8))
+	format = format<<1 | boolBit(code.Dark(8, 8))
+	format = format<<1 | boolBit(code.Dark(8, 7))
+	for i := 5; i >= 0; i-- {
+		format = format<<1 | boolBit(code.Dark(8, i))
+	}
+
+	mask := -1
+	for candidate := 0; candidate < 8; candidate++ {
+		if formatBits(candidate) == format {
+			mask = candidate
+		}
+	}
+	if mask < 0 {
+		return nil, fmt.Errorf("invalid format bits %015b", format)
+	}
+
+	// A clean copy tells the function modules apart.
+	reference := newCode(code.version)
+	reference.drawFunctionPatterns()
+
+	var bits bitBuffer
+	size := code.size
+	for right := size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+
+		for vert := 0; vert < size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = size - 1 - vert
+				}
+
+				if !reference.function[y][x] {
+					bits = append(bits, code.Dark(x, y) != masked(mask, x, y))
+				}
+			}
+		}
+	}
+
+	codewords := bits.bytes()[:rawDataModules(code.version)/8]
+	data, err := deinterleave(code.version, codewords)
+	if err != nil {
+		return nil, err
+	}
+
+	var reader bitReader = bitReader{data: data}
+	if reader.read(4) != 0b0100 {
+		return nil, errors.New("not a byte mode segment")
+	}
+
+	length := reader.read(countBits(code.version))
+	result := make([]byte, length)
+	for i := range result {
+		result[i] = byte(reader.read(8))
+	}
+
+	return result, nil
+}
+
+func deinterleave(version int, codewords []byte) ([]byte, error) {
+	numBlocks := eccBlocks[version]
+	blockEccLen := eccCodewordsPerBlock[version]
+	rawCodewords := len(codewords)
+	numShortBlocks := numBlocks - rawCodewords%numBlocks
+	shortBlockLen := rawCodewords / numBlocks
+
+	blocks := make([][]byte, numBlocks)
+	k := 0
+	// The short blocks have no codeword at the end of the data.
+	for i := 0; i < shortBlockLen+1; i++ {
+		for j := range blocks {
+			if i == shortBlockLen-blockEccLen && j < numShortBlocks {
+				continue
+			}
+			blocks[j] = append(blocks[j], codewords[k])
+			k++
+		}
+	}
+
+	divisor := reedSolomonDivisor(blockEccLen)
+	var data []byte
+	for j, block := range blocks {
+		dataLen := len(block) - blockEccLen
+		ecc := reedSolomonRemainder(block[:dataLen], divisor)
+		for i := range ecc {
+			if ecc[i] != block[dataLen+i] {
+				return nil, fmt.Errorf("block %d has an invalid error correction", j)
+			}
+		}
+		data = append(data, block[:dataLen]...)
+	}
+
+	return data, nil
+}
+
+type bitReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *bitReader) read(length int) int {
+	value := 0
+	for range length {
+		value = value<<1 | int(r.data[r.pos/8]>>(7-r.pos%8)&1)
+		r.pos++
+	}
+
+	return value
+}
+
+func boolBit(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,90 @@
+package qrcode
+
+const (
+	penaltyRun     = 3
+	penaltyBlock   = 3
+	penaltyFinder  = 40
+	penaltyBalance = 10
+)
+
+// finderLike are the 1:1:3:1:1 patterns next to four light modules that
+// scanners could take for a finder pattern.
+var finderLike = [][]bool{
+	{true, false, true, true, true, false, true, false, false, false, false},
+	{false, false, false, false, true, false, true, true, true, false, true},
+}
+
+// penalty scores the masked code by the rules of the standard: the lower,
+// the easier to scan.
+func (c *Code) penalty() int {
+	result := 0
+
+	for i := 0; i < c.size; i++ {
+		row := make([]bool, c.size)
+		column := make([]bool, c.size)
+		for j := 0; j < c.size; j++ {
+			row[j] = c.modules[i][j]
+			column[j] = c.modules[j][i]
+		}
+		result += linePenalty(row) + linePenalty(column)
+	}
+
+	dark := 0
+	for y := 0; y < c.size; y++ {
+		for x := 0; x < c.size; x++ {
+			if c.modules[y][x] {
+				dark++
+			}
+
+			if x+1 < c.size && y+1 < c.size {
+				color := c.modules[y][x]
+				if color == c.modules[y][x+1] && color == c.modules[y+1][x] && color == c.modules[y+1][x+1] {
+					result += penaltyBlock
+				}
+			}
+		}
+	}
+
+	total := c.size * c.size
+	k := (abs(dark*20-total*10)+total-1)/total - 1
+	result += k * penaltyBalance
+
+	return result
+}
+
+func linePenalty(line []bool) int {
+	result := 0
+
+	run := 1
+	for i := 1; i <= len(line); i++ {
+		if i < len(line) && line[i] == line[i-1] {
+			run++
+			continue
+		}
+
+		if run >= 5 {
+			result += penaltyRun + run - 5
+		}
+		run = 1
+	}
+
+	for i := 0; i+len(finderLike[0]) <= len(line); i++ {
+		for _, pattern := range finderLike {
+			if matches(line[i:], pattern) {
+				result += penaltyFinder
+			}
+		}
+	}
+
+	return result
+}
+
+func matches(line, pattern []bool) bool {
+	for i, dark := range pattern {
+		if line[i] != dark {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,308 @@
+// Package qrcode encodes data as a QR code (ISO/IEC 18004) in byte mode with
+// the medium error correction level, the one payment slips and Pix codes are
+// printed with.
+package qrcode
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+)
+
+const (
+	minVersion = 1
+	maxVersion = 40
+
+	// quietZone is the light border, in modules, required around the code.
+	quietZone = 4
+)
+
+var ErrTooLong = errors.New("qrcode: data too long")
+
+// Code is the module matrix of an encoded QR code.
+type Code struct {
+	version int
+	size    int
+	modules [][]bool
+	// function marks the modules of the patterns, left out of the data and
+	// of the masking.
+	function [][]bool
+}
+
+// Encode picks the smallest version that holds the data and the mask with
+// the lowest penalty.
+func Encode(data []byte) (*Code, error) {
+	version, err := fitVersion(len(data))
+	if err != nil {
+		return nil, err
+	}
+
+	code := newCode(version)
+	code.drawFunctionPatterns()
+	code.drawCodewords(addEccAndInterleave(version, encodeData(version, data)))
+
+	best, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		code.applyMask(mask)
+		code.drawFormatBits(mask)
+		if penalty := code.penalty(); bestPenalty < 0 || penalty < bestPenalty {
+			best, bestPenalty = mask, penalty
+		}
+		// Masking is its own inverse.
+		code.applyMask(mask)
+	}
+
+	code.applyMask(best)
+	code.drawFormatBits(best)
+
+	return code, nil
+}
+
+func (c *Code) Version() int {
+	return c.version
+}
+
+// Size is the width and height of the code in modules, quiet zone apart.
+func (c *Code) Size() int {
+	return c.size
+}
+
+// Dark reports whether the module at column x and row y is dark.
+func (c *Code) Dark(x, y int) bool {
+	return c.modules[y][x]
+}
+
+// Image draws the code with scale pixels per module, quiet zone included.
+func (c *Code) Image(scale int) image.Image {
+	if scale < 1 {
+		scale = 1
+	}
+
+	width := (c.size + 2*quietZone) * scale
+	img := image.NewGray(image.Rect(0, 0, width, width))
+	for i := range img.Pix {
+		img.Pix[i] = 0xFF
+	}
+
+	for y := 0; y < c.size; y++ {
+		for x := 0; x < c.size; x++ {
+			if !c.modules[y][x] {
+				continue
+			}
+
+			for dy := 0; dy < scale; dy++ {
+				for dx := 0; dx < scale; dx++ {
+					img.SetGray((x+quietZone)*scale+dx, (y+quietZone)*scale+dy, color.Gray{Y: 0})
+				}
+			}
+		}
+	}
+
+	return img
+}
+
+// PNG encodes the image of the code with scale pixels per module.
+func (c *Code) PNG(scale int) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, c.Image(scale)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func newCode(version int) *Code {
+	size := version*4 + 17
+	code := &Code{
+		version:  version,
+		size:     size,
+		modules:  make([][]bool, size),
+		function: make([][]bool, size),
+	}
+
+	for i := range size {
+		code.modules[i] = make([]bool, size)
+		code.function[i] = make([]bool, size)
+	}
+
+	return code
+}
+
+func (c *Code) set(x, y int, dark bool) {
+	c.modules[y][x] = dark
+	c.function[y][x] = true
+}
+
+func (c *Code) drawFunctionPatterns() {
+	for i := 0; i < c.size; i++ {
+		c.set(6, i, i%2 == 0)
+		c.set(i, 6, i%2 == 0)
+	}
+
+	c.drawFinderPattern(3, 3)
+	c.drawFinderPattern(c.size-4, 3)
+	c.drawFinderPattern(3, c.size-4)
+
+	positions := alignmentPositions(c.version)
+	last := len(positions) - 1
+	for i, x := range positions {
+		for j, y := range positions {
+			// The corners taken by the finder patterns.
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			c.drawAlignmentPattern(x, y)
+		}
+	}
+
+	// Reserves the format area, drawn for real once the mask is chosen.
+	c.drawFormatBits(0)
+	c.drawVersion()
+}
+
+func (c *Code) drawFinderPattern(x, y int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			xx, yy := x+dx, y+dy
+			if xx < 0 || xx >= c.size || yy < 0 || yy >= c.size {
+				continue
+			}
+
+			dist := max(abs(dx), abs(dy))
+			c.set(xx, yy, dist != 2 && dist != 4)
+		}
+	}
+}
+
+func (c *Code) drawAlignmentPattern(x, y int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			c.set(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+// drawFormatBits writes both copies of the error correction level and the
+// mask, protected by a BCH code.
+func (c *Code) drawFormatBits(mask int) {
+	bits := formatBits(mask)
+
+	for i := 0; i <= 5; i++ {
+		c.set(8, i, bit(bits, i))
+	}
+	c.set(8, 7, bit(bits, 6))
+	c.set(8, 8, bit(bits, 7))
+	c.set(7, 8, bit(bits, 8))
+	for i := 9; i < 15; i++ {
+		c.set(14-i, 8, bit(bits, i))
+	}
+
+	for i := 0; i < 8; i++ {
+		c.set(c.size-1-i, 8, bit(bits, i))
+	}
+	for i := 8; i < 15; i++ {
+		c.set(8, c.size-15+i, bit(bits, i))
+	}
+	c.set(8, c.size-8, true)
+}
+
+// formatBits of the medium level, whose indicator is 00.
+func formatBits(mask int) int {
+	data := mask
+	rem := data
+	for range 10 {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+
+	return (data<<10 | rem) ^ 0x5412
+}
+
+func (c *Code) drawVersion() {
+	if c.version < 7 {
+		return
+	}
+
+	rem := c.version
+	for range 12 {
+		rem = (rem << 1) ^ ((rem >> 11) * 0x1F25)
+	}
+	bits := c.version<<12 | rem
+
+	for i := range 18 {
+		dark := bit(bits, i)
+		a, b := c.size-11+i%3, i/3
+		c.set(a, b, dark)
+		c.set(b, a, dark)
+	}
+}
+
+// drawCodewords places the bits in the zigzag of column pairs, from the
+// bottom right corner, skipping the function modules.
+func (c *Code) drawCodewords(data []byte) {
+	i := 0
+	for right := c.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+
+		for vert := 0; vert < c.size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = c.size - 1 - vert
+				}
+
+				if !c.function[y][x] && i < len(data)*8 {
+					c.modules[y][x] = bit(int(data[i>>3]), 7-i&7)
+					i++
+				}
+			}
+		}
+	}
+}
+
+func (c *Code) applyMask(mask int) {
+	for y := 0; y < c.size; y++ {
+		for x := 0; x < c.size; x++ {
+			if !c.function[y][x] && masked(mask, x, y) {
+				c.modules[y][x] = !c.modules[y][x]
+			}
+		}
+	}
+}
+
+func masked(mask, x, y int) bool {
+	switch mask {
+	case 0:
+		return (x+y)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (x+y)%3 == 0
+	case 4:
+		return (x/3+y/2)%2 == 0
+	case 5:
+		return x*y%2+x*y%3 == 0
+	case 6:
+		return (x*y%2+x*y%3)%2 == 0
+	default:
+		return ((x+y)%2+x*y%3)%2 == 0
+	}
+}
+
+func bit(value, i int) bool {
+	return (value>>i)&1 != 0
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
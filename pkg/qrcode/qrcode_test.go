@@ -0,0 +1,169 @@
+package qrcode
+
+import (
+	"bytes"
+	"image/png"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReedSolomonRemainder(t *testing.T) {
+	// "HELLO WORLD" in alphanumeric mode, version 1 at the medium level.
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	got := reedSolomonRemainder(data, reedSolomonDivisor(10))
+	if !bytes.Equal(got, want) {
+		t.Errorf("reedSolomonRemainder() = %v, want %v", got, want)
+	}
+}
+
+func TestFormatAndVersionBits(t *testing.T) {
+	if got := formatBits(0); got != 0b101010000010010 {
+		t.Errorf("formatBits(0) = %015b, want 101010000010010", got)
+	}
+
+	if got := formatBits(7); got != 0b100101010100000 {
+		t.Errorf("formatBits(7) = %015b, want 100101010100000", got)
+	}
+
+	code := newCode(7)
+	code.drawVersion()
+	bits := 0
+	for i := 17; i >= 0; i-- {
+		bits <<= 1
+		if code.modules[i/3][code.size-11+i%3] {
+			bits |= 1
+		}
+	}
+	if bits != 0x07C94 {
+		t.Errorf("version 7 bits = %018b, want 000111110010010100", bits)
+	}
+}
+
+func TestCapacity(t *testing.T) {
+	tests := []struct {
+		version int
+		data    int
+	}{
+		{1, 16},
+		{2, 28},
+		{5, 86},
+		{10, 216},
+		{40, 2334},
+	}
+
+	for _, tt := range tests {
+		if got := dataCodewords(tt.version); got != tt.data {
+			t.Errorf("dataCodewords(%d) = %d, want %d", tt.version, got, tt.data)
+		}
+	}
+
+	for version := minVersion; version <= maxVersion; version++ {
+		if rawDataModules(version)%8 > 7 || dataCodewords(version) <= 0 {
+			t.Errorf("version %d has an invalid capacity", version)
+		}
+	}
+}
+
+func TestAlignmentPositions(t *testing.T) {
+	tests := []struct {
+		version int
+		want    []int
+	}{
+		{1, nil},
+		{2, []int{6, 18}},
+		{7, []int{6, 22, 38}},
+		{32, []int{6, 34, 60, 86, 112, 138}},
+		{40, []int{6, 30, 58, 86, 114, 142, 170}},
+	}
+
+	for _, tt := range tests {
+		if got := alignmentPositions(tt.version); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("alignmentPositions(%d) = %v, want %v", tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestEncodeFitsSmallestVersion(t *testing.T) {
+	tests := []struct {
+		length  int
+		version int
+	}{
+		{14, 1},
+		{15, 2},
+		{213, 10},
+		{2331, 40},
+	}
+
+	for _, tt := range tests {
+		code, err := Encode(bytes.Repeat([]byte("a"), tt.length))
+		if err != nil {
+			t.Fatalf("Encode(%d bytes) error = %v", tt.length, err)
+		}
+
+		if code.Version() != tt.version {
+			t.Errorf("Encode(%d bytes) version = %d, want %d", tt.length, code.Version(), tt.version)
+		}
+	}
+
+	if _, err := Encode(bytes.Repeat([]byte("a"), 2332)); err != ErrTooLong {
+		t.Errorf("Encode(2332 bytes) error = %v, want %v", err, ErrTooLong)
+	}
+}
+
+func TestEncodeDecodes(t *testing.T) {
+	tests := []string{
+		"",
+		"HELLO WORLD",
+		"00020126360014br.gov.bcb.pix0114+55719999900005204000053039865406100.005802BR5913Maria Souza6008Salvador62070503***6304ABCD",
+		strings.Repeat("0123456789abcdefghijklmnopqrstuvwxyz", 30),
+	}
+
+	for _, data := range tests {
+		code, err := Encode([]byte(data))
+		if err != nil {
+			t.Fatalf("Encode() error = %v", err)
+		}
+
+		got, err := decode(code)
+		if err != nil {
+			t.Fatalf("decode() of version %d error = %v", code.Version(), err)
+		}
+
+		if string(got) != data {
+			t.Errorf("decode() = %q, want %q", got, data)
+		}
+	}
+}
+
+func TestPNG(t *testing.T) {
+	code, err := Encode([]byte("HELLO WORLD"))
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	image, err := code.PNG(4)
+	if err != nil {
+		t.Fatalf("PNG() error = %v", err)
+	}
+
+	decoded, err := png.Decode(bytes.NewReader(image))
+	if err != nil {
+		t.Fatalf("png.Decode() error = %v", err)
+	}
+
+	width := (code.Size() + 2*quietZone) * 4
+	if decoded.Bounds().Dx() != width || decoded.Bounds().Dy() != width {
+		t.Errorf("PNG() size = %v, want %dx%d", decoded.Bounds(), width, width)
+	}
+
+	// The top left module of the finder pattern, past the quiet zone.
+	if r, _, _, _ := decoded.At(quietZone*4, quietZone*4).RGBA(); r != 0 {
+		t.Errorf("PNG() finder pattern is not dark")
+	}
+	if r, _, _, _ := decoded.At(0, 0).RGBA(); r == 0 {
+		t.Errorf("PNG() quiet zone is not light")
+	}
+}